@@ -23,42 +23,59 @@ func GetProposalPayloadString(rp *rocketpool.RocketPool, daoName string, payload
 	getProposalPayloadStringLock.Lock()
 	defer getProposalPayloadStringLock.Unlock()
 
+	// Decode the payload
+	method, args, err := DecodeProposalPayload(rp, daoName, payload, opts)
+	if err != nil {
+		return "", err
+	}
+
+	// Format argument values as strings
+	argStrs := []string{}
+	for ai, arg := range args {
+		argStrs = append(argStrs, FormatProposalPayloadArgument(method.Inputs[ai].Type, arg))
+	}
+
+	// Build & return payload string
+	return strutils.Sanitize(fmt.Sprintf("%s(%s)", method.RawName, strings.Join(argStrs, ","))), nil
+
+}
+
+// Decode a proposal payload into the DAO contract method it calls and the method's argument values
+func DecodeProposalPayload(rp *rocketpool.RocketPool, daoName string, payload []byte, opts *bind.CallOpts) (*abi.Method, []any, error) {
+
 	// Get proposal DAO contract ABI
 	daoContractAbi, err := rp.GetABI(daoName, opts)
 	if err != nil {
-		return "", fmt.Errorf("error getting '%s' DAO contract ABI: %w", daoName, err)
+		return nil, nil, fmt.Errorf("error getting '%s' DAO contract ABI: %w", daoName, err)
 	}
 
 	// Get proposal payload method
 	method, err := daoContractAbi.MethodById(payload)
 	if err != nil {
-		return "", fmt.Errorf("error getting proposal payload method: %w", err)
+		return nil, nil, fmt.Errorf("error getting proposal payload method: %w", err)
 	}
 
 	// Get proposal payload argument values
 	args, err := method.Inputs.UnpackValues(payload[4:])
 	if err != nil {
-		return "", fmt.Errorf("error getting proposal payload arguments: %w", err)
+		return nil, nil, fmt.Errorf("error getting proposal payload arguments: %w", err)
 	}
+	return method, args, nil
 
-	// Format argument values as strings
-	argStrs := []string{}
-	for ai, arg := range args {
-		switch method.Inputs[ai].Type.T {
-		case abi.AddressTy:
-			argStrs = append(argStrs, arg.(common.Address).Hex())
-		case abi.HashTy:
-			argStrs = append(argStrs, arg.(common.Hash).Hex())
-		case abi.FixedBytesTy:
-			fallthrough
-		case abi.BytesTy:
-			argStrs = append(argStrs, hex.EncodeToString(arg.([]byte)))
-		default:
-			argStrs = append(argStrs, fmt.Sprintf("%v", arg))
-		}
-	}
-
-	// Build & return payload string
-	return strutils.Sanitize(fmt.Sprintf("%s(%s)", method.RawName, strings.Join(argStrs, ","))), nil
+}
 
+// Format a decoded proposal payload argument as a string
+func FormatProposalPayloadArgument(argType abi.Type, arg any) string {
+	switch argType.T {
+	case abi.AddressTy:
+		return arg.(common.Address).Hex()
+	case abi.HashTy:
+		return arg.(common.Hash).Hex()
+	case abi.FixedBytesTy:
+		fallthrough
+	case abi.BytesTy:
+		return hex.EncodeToString(arg.([]byte))
+	default:
+		return fmt.Sprintf("%v", arg)
+	}
 }
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"golang.org/x/sync/errgroup"
@@ -511,21 +512,11 @@ func GetProposalPayloadString(rp *rocketpool.RocketPool, payload []byte, opts *b
 }
 
 func ParseProposalPayload(rp *rocketpool.RocketPool, payload []byte, opts *bind.CallOpts) (string, []DecodedProposalSetting, error) {
-	rocketDAOProtocolProposals, err := getRocketDAOProtocolProposals(rp, nil)
+	method, args, err := DecodeProposalPayload(rp, payload)
 	if err != nil {
 		return "", nil, err
 	}
 
-	method, err := rocketDAOProtocolProposals.ABI.MethodById(payload)
-	if err != nil {
-		return "", nil, fmt.Errorf("error getting proposal payload method: %w", err)
-	}
-
-	args, err := method.Inputs.UnpackValues(payload[4:])
-	if err != nil {
-		return "", nil, fmt.Errorf("error getting proposal payload arguments: %w", err)
-	}
-
 	if method.RawName == proposalSettingMultiMethod {
 		settings, err := decodeProposalSettingMultiArgs(args)
 		if err != nil {
@@ -537,6 +528,25 @@ func ParseProposalPayload(rp *rocketpool.RocketPool, payload []byte, opts *bind.
 	return formatGenericProposalPayload(method, args), nil, nil
 }
 
+// Decode a proposal's payload into the rocketDAOProtocolProposals method it calls and the arguments it passes
+func DecodeProposalPayload(rp *rocketpool.RocketPool, payload []byte) (*abi.Method, []any, error) {
+	rocketDAOProtocolProposals, err := getRocketDAOProtocolProposals(rp, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	method, err := rocketDAOProtocolProposals.ABI.MethodById(payload)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting proposal payload method: %w", err)
+	}
+
+	args, err := method.Inputs.UnpackValues(payload[4:])
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting proposal payload arguments: %w", err)
+	}
+	return method, args, nil
+}
+
 // Get the proposal's state
 func GetProposalState(rp *rocketpool.RocketPool, proposalId uint64, opts *bind.CallOpts) (types.ProtocolDaoProposalState, error) {
 	rocketDAOProtocolProposal, err := getRocketDAOProtocolProposal(rp, nil)
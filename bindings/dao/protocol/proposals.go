@@ -17,36 +17,37 @@ import (
 
 // Estimate the gas of ProposeSetMulti
 func EstimateProposeSetMultiGas(rp *rocketpool.RocketPool, message string, contractNames []string, settingPaths []string, settingTypes []types.ProposalSettingType, values []any, blockNumber uint32, treeNodes []types.VotingTreeNode, opts *bind.TransactOpts) (gaslimit.Limits, error) {
-	rocketDAOProtocolProposals, err := getRocketDAOProtocolProposals(rp, nil)
+	_, payload, err := GetProposeSetMultiPayload(rp, contractNames, settingPaths, settingTypes, values)
 	if err != nil {
 		return gaslimit.Limits{}, err
 	}
-	encodedValues, err := abiEncodeMultiValues(settingTypes, values)
-	if err != nil {
-		return gaslimit.Limits{}, fmt.Errorf("error ABI encoding values: %w", err)
-	}
-	payload, err := rocketDAOProtocolProposals.ABI.Pack("proposalSettingMulti", contractNames, settingPaths, settingTypes, encodedValues)
-	if err != nil {
-		return gaslimit.Limits{}, fmt.Errorf("error setting multi-set proposal payload: %w", err)
-	}
 	return estimateProposalGas(rp, message, payload, blockNumber, treeNodes, opts)
 }
 
 // Submit a proposal to update multiple Protocol DAO settings at once
 func ProposeSetMulti(rp *rocketpool.RocketPool, message string, contractNames []string, settingPaths []string, settingTypes []types.ProposalSettingType, values []any, blockNumber uint32, treeNodes []types.VotingTreeNode, opts *bind.TransactOpts) (uint64, common.Hash, error) {
-	rocketDAOProtocolProposals, err := getRocketDAOProtocolProposals(rp, nil)
+	_, payload, err := GetProposeSetMultiPayload(rp, contractNames, settingPaths, settingTypes, values)
 	if err != nil {
 		return 0, common.Hash{}, err
 	}
+	return submitProposal(rp, message, payload, blockNumber, treeNodes, opts)
+}
+
+// Get the call a multi-setting proposal makes when it's executed, as the target contract address and its calldata
+func GetProposeSetMultiPayload(rp *rocketpool.RocketPool, contractNames []string, settingPaths []string, settingTypes []types.ProposalSettingType, values []any) (common.Address, []byte, error) {
+	rocketDAOProtocolProposals, err := getRocketDAOProtocolProposals(rp, nil)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
 	encodedValues, err := abiEncodeMultiValues(settingTypes, values)
 	if err != nil {
-		return 0, common.Hash{}, fmt.Errorf("error ABI encoding values: %w", err)
+		return common.Address{}, nil, fmt.Errorf("error ABI encoding values: %w", err)
 	}
 	payload, err := rocketDAOProtocolProposals.ABI.Pack("proposalSettingMulti", contractNames, settingPaths, settingTypes, encodedValues)
 	if err != nil {
-		return 0, common.Hash{}, fmt.Errorf("error setting multi-set proposal payload: %w", err)
+		return common.Address{}, nil, fmt.Errorf("error setting multi-set proposal payload: %w", err)
 	}
-	return submitProposal(rp, message, payload, blockNumber, treeNodes, opts)
+	return *rocketDAOProtocolProposals.Address, payload, nil
 }
 
 // Estimate the gas of ProposeSetBool
@@ -155,28 +156,33 @@ func ProposeSetAddressList(rp *rocketpool.RocketPool, message, contractName, set
 
 // Estimate the gas of ProposeSetRewardsPercentage
 func EstimateProposeSetRewardsPercentageGas(rp *rocketpool.RocketPool, message string, odaoPercentage *big.Int, pdaoPercentage *big.Int, nodePercentage *big.Int, blockNumber uint32, treeNodes []types.VotingTreeNode, opts *bind.TransactOpts) (gaslimit.Limits, error) {
-	rocketDAOProtocolProposals, err := getRocketDAOProtocolProposals(rp, nil)
+	_, payload, err := GetProposeSetRewardsPercentagePayload(rp, odaoPercentage, pdaoPercentage, nodePercentage)
 	if err != nil {
 		return gaslimit.Limits{}, err
 	}
-	payload, err := rocketDAOProtocolProposals.ABI.Pack("proposalSettingRewardsClaimers", odaoPercentage, pdaoPercentage, nodePercentage)
-	if err != nil {
-		return gaslimit.Limits{}, fmt.Errorf("error encoding set rewards-claimers percent proposal payload: %w", err)
-	}
 	return estimateProposalGas(rp, message, payload, blockNumber, treeNodes, opts)
 }
 
 // Submit a proposal to update the allocations of RPL rewards
 func ProposeSetRewardsPercentage(rp *rocketpool.RocketPool, message string, odaoPercentage *big.Int, pdaoPercentage *big.Int, nodePercentage *big.Int, blockNumber uint32, treeNodes []types.VotingTreeNode, opts *bind.TransactOpts) (uint64, common.Hash, error) {
-	rocketDAOProtocolProposals, err := getRocketDAOProtocolProposals(rp, nil)
+	_, payload, err := GetProposeSetRewardsPercentagePayload(rp, odaoPercentage, pdaoPercentage, nodePercentage)
 	if err != nil {
 		return 0, common.Hash{}, err
 	}
+	return submitProposal(rp, message, payload, blockNumber, treeNodes, opts)
+}
+
+// Get the call a rewards percentages proposal makes when it's executed, as the target contract address and its calldata
+func GetProposeSetRewardsPercentagePayload(rp *rocketpool.RocketPool, odaoPercentage *big.Int, pdaoPercentage *big.Int, nodePercentage *big.Int) (common.Address, []byte, error) {
+	rocketDAOProtocolProposals, err := getRocketDAOProtocolProposals(rp, nil)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
 	payload, err := rocketDAOProtocolProposals.ABI.Pack("proposalSettingRewardsClaimers", odaoPercentage, pdaoPercentage, nodePercentage)
 	if err != nil {
-		return 0, common.Hash{}, fmt.Errorf("error encoding set rewards-claimers percent proposal payload: %w", err)
+		return common.Address{}, nil, fmt.Errorf("error encoding set rewards-claimers percent proposal payload: %w", err)
 	}
-	return submitProposal(rp, message, payload, blockNumber, treeNodes, opts)
+	return *rocketDAOProtocolProposals.Address, payload, nil
 }
 
 // Estimate the gas of ProposeOneTimeTreasurySpend
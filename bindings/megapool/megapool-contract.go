@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -12,7 +14,9 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/rocket-pool/smartnode/bindings/logs"
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/bindings/storage"
 	"github.com/rocket-pool/smartnode/bindings/transactions/gaslimit"
 	rptypes "github.com/rocket-pool/smartnode/bindings/types"
 )
@@ -603,6 +607,9 @@ func (mp *megapoolV1) DelegateUpgrade(opts *bind.TransactOpts) (common.Hash, err
 
 var ValidatorBatchSize = uint32(50)
 
+// The number of blocks to query at a time when scanning backwards for validator events
+const ValidatorEventScanInterval = 10000
+
 func (mp *megapoolV1) GetMegapoolPubkeys(opts *bind.CallOpts) ([]rptypes.ValidatorPubkey, error) {
 	validatorCount, err := mp.GetValidatorCount(opts)
 	if err != nil {
@@ -645,6 +652,143 @@ func (mp *megapoolV1) GetMegapoolPubkeys(opts *bind.CallOpts) ([]rptypes.Validat
 	return pubkeys, nil
 }
 
+// A lifecycle event emitted by a megapool for one of its validators
+type ValidatorEvent struct {
+	Event       string
+	ValidatorId uint32
+	Time        time.Time
+	BlockNumber uint64
+	TxHash      common.Hash
+	TxIndex     uint
+	LogIndex    uint
+}
+
+// The megapool events that track a validator's lifecycle
+var ValidatorEventNames = []string{
+	"MegapoolValidatorEnqueued",
+	"MegapoolValidatorDequeued",
+	"MegapoolValidatorAssigned",
+	"MegapoolValidatorStaked",
+	"MegapoolValidatorDissolved",
+	"MegapoolValidatorLocked",
+	"MegapoolValidatorUnlocked",
+	"MegapoolValidatorExiting",
+	"MegapoolValidatorExited",
+}
+
+// Get the topic ID of one of the megapool validator lifecycle events, for filtering logs across all megapools
+func GetValidatorEventId(name string) (common.Hash, error) {
+	megapoolAbi := megapoolV1Abi
+	if megapoolAbi == nil {
+		var err error
+		megapoolAbi, err = rocketpool.DecodeAbi(megapoolV1EncodedAbi)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("error decoding megapool ABI: %w", err)
+		}
+	}
+	event, exists := megapoolAbi.Events[name]
+	if !exists {
+		return common.Hash{}, fmt.Errorf("megapools do not have a %s event", name)
+	}
+	return event.ID, nil
+}
+
+// Get the lifecycle events for one of the megapool's validators, oldest first
+func (mp *megapoolV1) GetValidatorEvents(validatorId uint32, intervalSize *big.Int, opts *bind.CallOpts) ([]ValidatorEvent, error) {
+
+	eventIds := make([]common.Hash, 0, len(ValidatorEventNames))
+	eventNames := make(map[common.Hash]string, len(ValidatorEventNames))
+	for _, name := range ValidatorEventNames {
+		event, exists := mp.Contract.ABI.Events[name]
+		if !exists {
+			return nil, fmt.Errorf("megapool %s does not have a %s event", mp.Address.Hex(), name)
+		}
+		eventIds = append(eventIds, event.ID)
+		eventNames[event.ID] = name
+	}
+	addressFilter := []common.Address{mp.Address}
+	topicFilter := [][]common.Hash{eventIds, {common.BigToHash(big.NewInt(int64(validatorId)))}}
+
+	// Grab the latest block number
+	var currentBlock uint64
+	if opts != nil && opts.BlockNumber != nil {
+		currentBlock = opts.BlockNumber.Uint64()
+	} else {
+		var err error
+		currentBlock, err = mp.RocketPool.Client.BlockNumber(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("error getting current block: %w", err)
+		}
+	}
+
+	// Grab the lowest block number worth querying from (should never have to go back this far in practice)
+	fromBlockBig, err := storage.GetDeployBlock(mp.RocketPool)
+	if err != nil {
+		return nil, fmt.Errorf("error getting deploy block: %w", err)
+	}
+	fromBlock := fromBlockBig.Uint64()
+
+	// Backwards scan through blocks until the validator's deposit is found
+	events := []ValidatorEvent{}
+	for i := currentBlock; i >= fromBlock; i -= ValidatorEventScanInterval {
+		from := fromBlock
+		if i >= fromBlock+ValidatorEventScanInterval {
+			from = i - ValidatorEventScanInterval + 1
+		}
+		fromBig := big.NewInt(0).SetUint64(from)
+		toBig := big.NewInt(0).SetUint64(i)
+
+		logs, err := logs.GetLogs(mp.RocketPool, addressFilter, topicFilter, intervalSize, fromBig, toBig, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error getting events for validator %d of megapool %s: %w", validatorId, mp.Address.Hex(), err)
+		}
+
+		enqueued := false
+		for _, log := range logs {
+			name := eventNames[log.Topics[0]]
+			values := map[string]interface{}{}
+			err = mp.Contract.Contract.UnpackLogIntoMap(values, name, log)
+			if err != nil {
+				return nil, fmt.Errorf("error unpacking %s event: %w", name, err)
+			}
+			eventTime, ok := values["time"].(*big.Int)
+			if !ok {
+				return nil, fmt.Errorf("%s event is missing its time", name)
+			}
+			events = append(events, ValidatorEvent{
+				Event:       name,
+				ValidatorId: validatorId,
+				Time:        time.Unix(eventTime.Int64(), 0),
+				BlockNumber: log.BlockNumber,
+				TxHash:      log.TxHash,
+				TxIndex:     log.TxIndex,
+				LogIndex:    log.Index,
+			})
+			if name == "MegapoolValidatorEnqueued" {
+				enqueued = true
+			}
+		}
+		if enqueued || from == fromBlock {
+			break
+		}
+	}
+
+	// Sort the events by the order they were emitted
+	sort.Slice(events, func(i int, j int) bool {
+		first := events[i]
+		second := events[j]
+		if first.BlockNumber != second.BlockNumber {
+			return first.BlockNumber < second.BlockNumber
+		}
+		if first.TxIndex != second.TxIndex {
+			return first.TxIndex < second.TxIndex
+		}
+		return first.LogIndex < second.LogIndex
+	})
+	return events, nil
+
+}
+
 // Create a megapool contract directly from its ABI
 func createMegapoolContractFromAbi(rp *rocketpool.RocketPool, address common.Address, abi *abi.ABI) (*rocketpool.Contract, error) {
 	// Create and return
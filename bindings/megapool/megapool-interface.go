@@ -63,4 +63,5 @@ type Megapool interface {
 	EstimateDelegateUpgradeGas(opts *bind.TransactOpts) (gaslimit.Limits, error)
 	DelegateUpgrade(opts *bind.TransactOpts) (common.Hash, error)
 	GetMegapoolPubkeys(opts *bind.CallOpts) ([]rptypes.ValidatorPubkey, error)
+	GetValidatorEvents(validatorId uint32, intervalSize *big.Int, opts *bind.CallOpts) ([]ValidatorEvent, error)
 }
@@ -0,0 +1,69 @@
+package minipool
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/rocket-pool/smartnode/bindings/logs"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+)
+
+// Scrub events are emitted by each minipool rather than a network contract, so they're matched by signature alone
+var (
+	scrubVotedEventId       = crypto.Keccak256Hash([]byte("ScrubVoted(address,uint256)"))
+	minipoolScrubbedEventId = crypto.Keccak256Hash([]byte("MinipoolScrubbed(uint256)"))
+)
+
+// A scrub vote cast by a trusted node, or a minipool being scrubbed once enough trusted nodes voted for it
+type ScrubEvent struct {
+	Member     common.Address `json:"member"`
+	Minipool   common.Address `json:"minipool"`
+	EventBlock uint64         `json:"eventBlock"`
+}
+
+// Returns the scrub votes cast between fromBlock and toBlock, optionally only the ones cast by the given trusted node;
+// if toBlock is nil, the latest block is used
+func GetScrubVotedEvents(rp *rocketpool.RocketPool, member *common.Address, fromBlock uint64, toBlock *big.Int, intervalSize *big.Int) ([]ScrubEvent, error) {
+	topicFilter := [][]common.Hash{{scrubVotedEventId}}
+	if member != nil {
+		topicFilter = append(topicFilter, []common.Hash{common.BytesToHash(member.Bytes())})
+	}
+	logs, err := logs.GetLogs(rp, nil, topicFilter, intervalSize, big.NewInt(int64(fromBlock)), toBlock, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]ScrubEvent, 0, len(logs))
+	for _, log := range logs {
+		// Topic 0 is the event, topic 1 is the member
+		if len(log.Topics) < 2 {
+			continue
+		}
+		events = append(events, ScrubEvent{
+			Member:     common.BytesToAddress(log.Topics[1].Bytes()),
+			Minipool:   log.Address,
+			EventBlock: log.BlockNumber,
+		})
+	}
+	return events, nil
+}
+
+// Returns the minipools that were scrubbed between fromBlock and toBlock; if toBlock is nil, the latest block is used
+func GetMinipoolScrubbedEvents(rp *rocketpool.RocketPool, fromBlock uint64, toBlock *big.Int, intervalSize *big.Int) ([]ScrubEvent, error) {
+	topicFilter := [][]common.Hash{{minipoolScrubbedEventId}}
+	logs, err := logs.GetLogs(rp, nil, topicFilter, intervalSize, big.NewInt(int64(fromBlock)), toBlock, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]ScrubEvent, len(logs))
+	for i, log := range logs {
+		events[i] = ScrubEvent{
+			Minipool:   log.Address,
+			EventBlock: log.BlockNumber,
+		}
+	}
+	return events, nil
+}
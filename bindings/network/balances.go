@@ -24,6 +24,17 @@ type BalancesUpdatedEvent struct {
 	BlockTimestamp *big.Int `json:"blockTimestamp"`
 }
 
+// A balances report for a block, either one submitted by a trusted node or the one they reached consensus on
+type BalancesReport struct {
+	Member        common.Address `json:"member"`
+	Block         uint64         `json:"block"`
+	SlotTimestamp *big.Int       `json:"slotTimestamp"`
+	TotalEth      *big.Int       `json:"totalEth"`
+	StakingEth    *big.Int       `json:"stakingEth"`
+	RethSupply    *big.Int       `json:"rethSupply"`
+	EventBlock    uint64         `json:"eventBlock"`
+}
+
 // Get the block number which network balances are current for
 func GetBalancesBlock(rp *rocketpool.RocketPool, opts *bind.CallOpts) (uint64, error) {
 	rocketNetworkBalances, err := getRocketNetworkBalances(rp, opts)
@@ -221,6 +232,64 @@ func GetBalancesUpdatedEvent(rp *rocketpool.RocketPool, blockNumber uint64, opts
 	return true, eventData, nil
 }
 
+// Returns every balances report submitted by a trusted node between fromBlock and toBlock; if toBlock is nil, the latest block is used
+func GetBalancesSubmittedEvents(rp *rocketpool.RocketPool, fromBlock uint64, toBlock *big.Int, intervalSize *big.Int, opts *bind.CallOpts) ([]BalancesReport, error) {
+	return getBalancesReports(rp, "BalancesSubmitted", fromBlock, toBlock, intervalSize, opts)
+}
+
+// Returns every balances update the trusted nodes reached consensus on between fromBlock and toBlock; if toBlock is nil, the latest block is used
+func GetBalancesUpdatedEvents(rp *rocketpool.RocketPool, fromBlock uint64, toBlock *big.Int, intervalSize *big.Int, opts *bind.CallOpts) ([]BalancesReport, error) {
+	return getBalancesReports(rp, "BalancesUpdated", fromBlock, toBlock, intervalSize, opts)
+}
+
+// Get the reports from the BalancesSubmitted or BalancesUpdated events
+func getBalancesReports(rp *rocketpool.RocketPool, eventName string, fromBlock uint64, toBlock *big.Int, intervalSize *big.Int, opts *bind.CallOpts) ([]BalancesReport, error) {
+	// Get contracts
+	rocketNetworkBalances, err := getRocketNetworkBalances(rp, opts)
+	if err != nil {
+		return nil, err
+	}
+	event, exists := rocketNetworkBalances.ABI.Events[eventName]
+	if !exists {
+		return nil, fmt.Errorf("rocketNetworkBalances does not have a %s event", eventName)
+	}
+
+	// Get the event logs
+	addressFilter := []common.Address{*rocketNetworkBalances.Address}
+	topicFilter := [][]common.Hash{{event.ID}}
+	logs, err := logs.GetLogs(rp, addressFilter, topicFilter, intervalSize, big.NewInt(int64(fromBlock)), toBlock, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]BalancesReport, 0, len(logs))
+	for _, log := range logs {
+		values := make(map[string]interface{})
+		if err := event.Inputs.UnpackIntoMap(values, log.Data); err != nil {
+			return nil, fmt.Errorf("error unpacking %s event data: %w", eventName, err)
+		}
+		report := BalancesReport{
+			EventBlock:    log.BlockNumber,
+			SlotTimestamp: getBigValue(values, "slotTimestamp"),
+			TotalEth:      getBigValue(values, "totalEth"),
+			StakingEth:    getBigValue(values, "stakingEth"),
+			RethSupply:    getBigValue(values, "rethSupply"),
+		}
+
+		// Submissions index the member, updates index the block
+		if eventName == "BalancesSubmitted" && len(log.Topics) > 1 {
+			report.Member = common.BytesToAddress(log.Topics[1].Bytes())
+		}
+		if block, exists := values["block"]; exists {
+			report.Block = block.(*big.Int).Uint64()
+		} else if len(log.Topics) > 1 {
+			report.Block = log.Topics[1].Big().Uint64()
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
 // Get contracts
 var rocketNetworkBalancesLock sync.Mutex
 
@@ -22,6 +22,15 @@ type PriceUpdatedEvent struct {
 	Time          *big.Int `json:"time"`
 }
 
+// A prices report for a block, either one submitted by a trusted node or the one they reached consensus on
+type PricesReport struct {
+	Member        common.Address `json:"member"`
+	Block         uint64         `json:"block"`
+	SlotTimestamp *big.Int       `json:"slotTimestamp"`
+	RplPrice      *big.Int       `json:"rplPrice"`
+	EventBlock    uint64         `json:"eventBlock"`
+}
+
 // Get the block number which network prices are current for
 func GetPricesBlock(rp *rocketpool.RocketPool, opts *bind.CallOpts) (uint64, error) {
 	rocketNetworkPrices, err := getRocketNetworkPrices(rp, opts)
@@ -182,6 +191,71 @@ func GetPriceUpdatedEvent(rp *rocketpool.RocketPool, blockNumber uint64, opts *b
 	return true, eventData, nil
 }
 
+// Returns every prices report submitted by a trusted node between fromBlock and toBlock; if toBlock is nil, the latest block is used
+func GetPricesSubmittedEvents(rp *rocketpool.RocketPool, fromBlock uint64, toBlock *big.Int, intervalSize *big.Int, opts *bind.CallOpts) ([]PricesReport, error) {
+	return getPricesReports(rp, "PricesSubmitted", fromBlock, toBlock, intervalSize, opts)
+}
+
+// Returns every prices update the trusted nodes reached consensus on between fromBlock and toBlock; if toBlock is nil, the latest block is used
+func GetPricesUpdatedEvents(rp *rocketpool.RocketPool, fromBlock uint64, toBlock *big.Int, intervalSize *big.Int, opts *bind.CallOpts) ([]PricesReport, error) {
+	return getPricesReports(rp, "PricesUpdated", fromBlock, toBlock, intervalSize, opts)
+}
+
+// Get the reports from the PricesSubmitted or PricesUpdated events
+func getPricesReports(rp *rocketpool.RocketPool, eventName string, fromBlock uint64, toBlock *big.Int, intervalSize *big.Int, opts *bind.CallOpts) ([]PricesReport, error) {
+	// Get contracts
+	rocketNetworkPrices, err := getRocketNetworkPrices(rp, opts)
+	if err != nil {
+		return nil, err
+	}
+	event, exists := rocketNetworkPrices.ABI.Events[eventName]
+	if !exists {
+		return nil, fmt.Errorf("rocketNetworkPrices does not have a %s event", eventName)
+	}
+
+	// Get the event logs
+	addressFilter := []common.Address{*rocketNetworkPrices.Address}
+	topicFilter := [][]common.Hash{{event.ID}}
+	logs, err := logs.GetLogs(rp, addressFilter, topicFilter, intervalSize, big.NewInt(int64(fromBlock)), toBlock, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]PricesReport, 0, len(logs))
+	for _, log := range logs {
+		values := make(map[string]interface{})
+		if err := event.Inputs.UnpackIntoMap(values, log.Data); err != nil {
+			return nil, fmt.Errorf("error unpacking %s event data: %w", eventName, err)
+		}
+		report := PricesReport{
+			EventBlock:    log.BlockNumber,
+			SlotTimestamp: getBigValue(values, "slotTimestamp"),
+			RplPrice:      getBigValue(values, "rplPrice"),
+		}
+
+		// Submissions index the member, updates index the block
+		if eventName == "PricesSubmitted" && len(log.Topics) > 1 {
+			report.Member = common.BytesToAddress(log.Topics[1].Bytes())
+		}
+		if block, exists := values["block"]; exists {
+			report.Block = block.(*big.Int).Uint64()
+		} else if len(log.Topics) > 1 {
+			report.Block = log.Topics[1].Big().Uint64()
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// Get a uint256 event value, or zero if the event doesn't have it
+func getBigValue(values map[string]interface{}, name string) *big.Int {
+	value, ok := values[name].(*big.Int)
+	if !ok {
+		return big.NewInt(0)
+	}
+	return value
+}
+
 // Get contracts
 var rocketNetworkPricesLock sync.Mutex
 
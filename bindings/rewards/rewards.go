@@ -3,6 +3,7 @@ package rewards
 import (
 	"fmt"
 	"math/big"
+	"reflect"
 	"slices"
 	"sync"
 	"time"
@@ -55,6 +56,14 @@ type RewardSubmission struct {
 	NodeETH          []*big.Int `json:"nodeETH"`
 }
 
+// A rewards snapshot for an interval, either one submitted by a trusted node or the one they reached consensus on
+type RewardSnapshotReport struct {
+	Member     common.Address `json:"member"`
+	Index      uint64         `json:"index"`
+	MerkleRoot common.Hash    `json:"merkleRoot"`
+	EventBlock uint64         `json:"eventBlock"`
+}
+
 // Internal struct - this is the structure of what gets returned by the RewardSnapshot event
 type rewardSnapshot struct {
 	RewardIndex       *big.Int         `json:"rewardIndex"`
@@ -323,6 +332,72 @@ func GetRewardsEvent(rp *rocketpool.RocketPool, index uint64, rocketRewardsPoolA
 	return true, eventData, nil
 }
 
+// Returns every rewards snapshot submitted by a trusted node between fromBlock and toBlock; if toBlock is nil, the latest block is used
+func GetRewardSnapshotSubmittedEvents(rp *rocketpool.RocketPool, fromBlock uint64, toBlock *big.Int, intervalSize *big.Int, opts *bind.CallOpts) ([]RewardSnapshotReport, error) {
+	return getRewardSnapshotReports(rp, "RewardSnapshotSubmitted", fromBlock, toBlock, intervalSize, opts)
+}
+
+// Returns every rewards snapshot the trusted nodes reached consensus on between fromBlock and toBlock; if toBlock is nil, the latest block is used
+func GetRewardSnapshotEvents(rp *rocketpool.RocketPool, fromBlock uint64, toBlock *big.Int, intervalSize *big.Int, opts *bind.CallOpts) ([]RewardSnapshotReport, error) {
+	return getRewardSnapshotReports(rp, "RewardSnapshot", fromBlock, toBlock, intervalSize, opts)
+}
+
+// Get the reports from the RewardSnapshotSubmitted or RewardSnapshot events
+func getRewardSnapshotReports(rp *rocketpool.RocketPool, eventName string, fromBlock uint64, toBlock *big.Int, intervalSize *big.Int, opts *bind.CallOpts) ([]RewardSnapshotReport, error) {
+	// Get contracts
+	rocketRewardsPool, err := getRocketRewardsPool(rp, opts)
+	if err != nil {
+		return nil, err
+	}
+	event, exists := rocketRewardsPool.ABI.Events[eventName]
+	if !exists {
+		return nil, fmt.Errorf("rocketRewardsPool does not have a %s event", eventName)
+	}
+
+	// Get the event logs
+	addressFilter := []common.Address{*rocketRewardsPool.Address}
+	topicFilter := [][]common.Hash{{event.ID}}
+	logs, err := logs.GetLogs(rp, addressFilter, topicFilter, intervalSize, big.NewInt(int64(fromBlock)), toBlock, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]RewardSnapshotReport, 0, len(logs))
+	for _, log := range logs {
+		values := make(map[string]interface{})
+		if err := event.Inputs.UnpackIntoMap(values, log.Data); err != nil {
+			return nil, fmt.Errorf("error unpacking %s event data: %w", eventName, err)
+		}
+		report := RewardSnapshotReport{
+			EventBlock: log.BlockNumber,
+		}
+
+		// The submission is decoded into an anonymous struct, so read the root by name
+		submission := reflect.ValueOf(values["submission"])
+		if submission.Kind() == reflect.Struct {
+			if field := submission.FieldByName("MerkleRoot"); field.IsValid() {
+				if root, ok := field.Interface().([32]byte); ok {
+					report.MerkleRoot = root
+				}
+			}
+		}
+
+		// Submissions index the member and the interval, snapshots only index the interval
+		indexTopic := 1
+		if eventName == "RewardSnapshotSubmitted" {
+			if len(log.Topics) > 1 {
+				report.Member = common.BytesToAddress(log.Topics[1].Bytes())
+			}
+			indexTopic = 2
+		}
+		if len(log.Topics) > indexTopic {
+			report.Index = log.Topics[indexTopic].Big().Uint64()
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
 // Get contracts
 var rocketRewardsPoolLock sync.Mutex
 
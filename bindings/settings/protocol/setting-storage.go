@@ -0,0 +1,42 @@
+package protocol
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// The namespace each protocol DAO settings contract stores its settings under
+var pdaoSettingNamespaces = map[string]string{
+	AuctionSettingsContractName:   "auction",
+	DepositSettingsContractName:   "deposit",
+	InflationSettingsContractName: "inflation",
+	MegapoolSettingsContractName:  "megapool",
+	MinipoolSettingsContractName:  "minipool",
+	NetworkSettingsContractName:   "network",
+	NodeSettingsContractName:      "node",
+	ProposalsSettingsContractName: "proposals",
+	RewardsSettingsContractName:   "rewards",
+	SecuritySettingsContractName:  "security",
+}
+
+// Get the RocketStorage key a protocol DAO setting's value is stored under
+func GetSettingStorageKey(contract string, setting string) (common.Hash, error) {
+	namespace, ok := pdaoSettingNamespaces[contract]
+	if !ok {
+		return common.Hash{}, fmt.Errorf("%w: [%s - %s]", ErrUnknownPDAOSetting, contract, setting)
+	}
+	namespaceKey := crypto.Keccak256([]byte("dao.protocol.setting." + namespace))
+	return crypto.Keccak256Hash(namespaceKey, []byte(setting)), nil
+}
+
+// Get the name of the protocol DAO settings contract that stores settings under a namespace
+func GetSettingContractName(namespace string) (string, error) {
+	for contract, contractNamespace := range pdaoSettingNamespaces {
+		if contractNamespace == namespace {
+			return contract, nil
+		}
+	}
+	return "", fmt.Errorf("%w: no settings contract uses the namespace [%s]", ErrUnknownPDAOSetting, namespace)
+}
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
 	"math/big"
 	"sort"
@@ -59,35 +60,15 @@ func GetDeposits(rp *rocketpool.RocketPool, pubkeys map[rptypes.ValidatorPubkey]
 
 	// Process each event
 	for _, log := range logs {
-		depositEvent := new(BeaconDepositEvent)
-		err = casperDeposit.Contract.UnpackLog(depositEvent, "DepositEvent", log)
+		depositData, err := unpackDeposit(casperDeposit, log)
 		if err != nil {
 			return nil, err
 		}
 
 		// Check if this is a deposit for one of the pubkeys we're looking for
-		pubkey := rptypes.BytesToValidatorPubkey(depositEvent.Pubkey)
-		_, exists := pubkeys[pubkey]
+		_, exists := pubkeys[depositData.Pubkey]
 		if exists {
-			// Convert the deposit amount from little-endian binary to a uint64
-			var amount uint64
-			buf := bytes.NewReader(depositEvent.Amount)
-			err = binary.Read(buf, binary.LittleEndian, &amount)
-			if err != nil {
-				return nil, err
-			}
-
-			// Create the deposit data wrapper and add it to this pubkey's collection
-			depositData := DepositData{
-				Pubkey:                pubkey,
-				WithdrawalCredentials: common.BytesToHash(depositEvent.WithdrawalCredentials),
-				Amount:                amount,
-				Signature:             rptypes.BytesToValidatorSignature(depositEvent.Signature),
-				TxHash:                log.TxHash,
-				BlockNumber:           log.BlockNumber,
-				TxIndex:               log.TxIndex,
-			}
-			depositMap[pubkey] = append(depositMap[pubkey], depositData)
+			depositMap[depositData.Pubkey] = append(depositMap[depositData.Pubkey], depositData)
 		}
 	}
 
@@ -101,6 +82,68 @@ func GetDeposits(rp *rocketpool.RocketPool, pubkeys map[rptypes.ValidatorPubkey]
 	return depositMap, nil
 }
 
+// Gets the deposit contract's deposit events for the provided pubkey that were emitted by a single transaction
+func GetDepositsFromTransaction(rp *rocketpool.RocketPool, txHash common.Hash, pubkey rptypes.ValidatorPubkey, opts *bind.CallOpts) ([]DepositData, error) {
+
+	// Get the deposit contract wrapper
+	casperDeposit, err := getCasperDeposit(rp, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the transaction receipt
+	receipt, err := rp.Client.TransactionReceipt(context.Background(), txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	// Process each deposit event in the transaction
+	depositEventId := casperDeposit.ABI.Events["DepositEvent"].ID
+	deposits := []DepositData{}
+	for _, log := range receipt.Logs {
+		if log.Address != *casperDeposit.Address || len(log.Topics) == 0 || log.Topics[0] != depositEventId {
+			continue
+		}
+		depositData, err := unpackDeposit(casperDeposit, *log)
+		if err != nil {
+			return nil, err
+		}
+		if depositData.Pubkey == pubkey {
+			deposits = append(deposits, depositData)
+		}
+	}
+	return deposits, nil
+
+}
+
+// Decodes a deposit contract DepositEvent log
+func unpackDeposit(casperDeposit *rocketpool.Contract, log types.Log) (DepositData, error) {
+	depositEvent := new(BeaconDepositEvent)
+	err := casperDeposit.Contract.UnpackLog(depositEvent, "DepositEvent", log)
+	if err != nil {
+		return DepositData{}, err
+	}
+
+	// Convert the deposit amount from little-endian binary to a uint64
+	var amount uint64
+	buf := bytes.NewReader(depositEvent.Amount)
+	err = binary.Read(buf, binary.LittleEndian, &amount)
+	if err != nil {
+		return DepositData{}, err
+	}
+
+	// Create the deposit data wrapper
+	return DepositData{
+		Pubkey:                rptypes.BytesToValidatorPubkey(depositEvent.Pubkey),
+		WithdrawalCredentials: common.BytesToHash(depositEvent.WithdrawalCredentials),
+		Amount:                amount,
+		Signature:             rptypes.BytesToValidatorSignature(depositEvent.Signature),
+		TxHash:                log.TxHash,
+		BlockNumber:           log.BlockNumber,
+		TxIndex:               log.TxIndex,
+	}, nil
+}
+
 // Sorts a slice of deposit data entries - lower blocks come first, and if multiple transactions occur
 // in the same block, lower transaction indices come first
 func sortDepositData(data []DepositData) {
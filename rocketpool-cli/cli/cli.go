@@ -2,14 +2,19 @@ package cli
 
 import (
 	"fmt"
+	"math/big"
 	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/urfave/cli/v3"
 
 	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/color"
+	"github.com/rocket-pool/smartnode/shared/math"
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
 	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
 )
 
@@ -148,3 +153,111 @@ func PrintNetwork(currentNetwork cfgtypes.Network, isNew bool) error {
 
 	return nil
 }
+
+// The flag for writing a command's transaction unsigned to a file instead of sending it
+func ExportUnsignedFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:  "export-unsigned",
+		Usage: "Write the transaction unsigned to this file instead of sending it, so it can be signed offline with `rocketpool wallet sign-tx` and submitted with `rocketpool node broadcast`",
+	}
+}
+
+// Apply the --export-unsigned flag, if the command has it set
+func ApplyExportUnsignedFlag(c *cli.Command) {
+	if path := c.String("export-unsigned"); path != "" {
+		rocketpool.SetExportUnsignedPath(path)
+	}
+}
+
+// Print the details of a transaction that was written to a file instead of being sent
+func PrintUnsignedTransaction(tx api.UnsignedTransaction) {
+	to := "(contract creation)"
+	if tx.To != nil {
+		to = tx.To.Hex()
+	}
+	fmt.Printf("From:            %s\n", tx.From.Hex())
+	fmt.Printf("To:              %s\n", to)
+	fmt.Printf("Chain ID:        %s\n", tx.ChainID.String())
+	fmt.Printf("Nonce:           %d\n", tx.Nonce)
+	fmt.Printf("Value:           %.6f ETH\n", math.RoundDown(math.WeiToEth(tx.Value), 6))
+	fmt.Printf("Gas limit:       %d\n", tx.GasLimit)
+	fmt.Printf("Max fee:         %.2f gwei (%.2f gwei priority)\n", math.WeiToGwei(tx.MaxFeePerGas), math.WeiToGwei(tx.MaxPriorityFeePerGas))
+	fmt.Printf("Max total cost:  %.6f ETH\n", math.RoundDown(math.WeiToEth(tx.MaxCost), 6))
+}
+
+// Print the fields of a decoded transaction that's about to be signed
+func PrintTransactionToSign(from common.Address, tx *types.Transaction) {
+	to := "(contract creation)"
+	if tx.To() != nil {
+		to = tx.To().Hex()
+	}
+	selector := "(none)"
+	if len(tx.Data()) >= 4 {
+		selector = hexutil.Encode(tx.Data()[:4])
+	}
+	maxCost := new(big.Int).Mul(new(big.Int).SetUint64(tx.Gas()), tx.GasFeeCap())
+	maxCost.Add(maxCost, tx.Value())
+	fmt.Printf("From:            %s\n", from.Hex())
+	fmt.Printf("To:              %s\n", to)
+	fmt.Printf("Chain ID:        %s\n", tx.ChainId().String())
+	fmt.Printf("Nonce:           %d\n", tx.Nonce())
+	fmt.Printf("Value:           %.6f ETH\n", math.RoundDown(math.WeiToEth(tx.Value()), 6))
+	fmt.Printf("Function:        %s (%d bytes of calldata)\n", selector, len(tx.Data()))
+	fmt.Printf("Gas limit:       %d\n", tx.Gas())
+	fmt.Printf("Max fee:         %.2f gwei (%.2f gwei priority)\n", math.WeiToGwei(tx.GasFeeCap()), math.WeiToGwei(tx.GasTipCap()))
+	fmt.Printf("Max total cost:  %.6f ETH\n", math.RoundDown(math.WeiToEth(maxCost), 6))
+}
+
+// Print the decoded payload of an Oracle DAO or security council proposal, with the changes it makes
+func PrintDAOProposalDiff(diff api.DAOProposalDiff) {
+	fmt.Printf("Action:               %s\n", diff.Method)
+	for _, arg := range diff.Arguments {
+		if len(arg.Value) > 80 {
+			// Long arguments like compressed ABIs aren't readable, so just show their size
+			fmt.Printf("  %-20s%s (%d characters)\n", arg.Name+":", arg.Type, len(arg.Value))
+			continue
+		}
+		fmt.Printf("  %-20s%s\n", arg.Name+":", arg.Value)
+	}
+
+	if diff.SettingChange != nil {
+		change := diff.SettingChange
+		width := max(len("Current"), len(change.CurrentValue))
+		fmt.Printf("\nSetting %s (%s):\n", color.LightBlue(change.Setting), change.Contract)
+		fmt.Printf("  %-*s    %s\n", width, "Current", "Proposed")
+		fmt.Printf("  %-*s    %s\n", width, strings.Repeat("-", width), strings.Repeat("-", max(len("Proposed"), len(change.ProposedValue))))
+		if change.CurrentValue == change.ProposedValue {
+			fmt.Printf("  %-*s    %s\n", width, change.CurrentValue, change.ProposedValue)
+			color.YellowPrintln("The proposal doesn't change the setting's current value.")
+		} else {
+			fmt.Printf("  %-*s -> %s\n", width, change.CurrentValue, color.Green(change.ProposedValue))
+		}
+	}
+
+	if diff.Upgrade != nil {
+		upgrade := diff.Upgrade
+		fmt.Printf("\nUpgrade %s (%s):\n", color.LightBlue(upgrade.ContractName), upgrade.Type)
+		if upgrade.CurrentAddress != nil {
+			fmt.Printf("  Current contract:   %s (code hash %s)\n", upgrade.CurrentAddress.Hex(), upgrade.CurrentCodeHash.Hex())
+		} else {
+			fmt.Println("  Current contract:   none")
+		}
+		if upgrade.ProposedAddress != (common.Address{}) {
+			fmt.Printf("  Proposed contract:  %s (code hash %s)\n", upgrade.ProposedAddress.Hex(), upgrade.ProposedCodeHash.Hex())
+			if upgrade.ProposedCodeSize == 0 {
+				color.RedPrintln("  WARNING: there is no contract code deployed at the proposed address.")
+			} else if upgrade.CurrentCodeHash != nil && *upgrade.CurrentCodeHash == upgrade.ProposedCodeHash {
+				color.YellowPrintln("  The proposed contract has the same code as the current one.")
+			}
+		}
+		if len(upgrade.AddedAbiEntries) == 0 && len(upgrade.RemovedAbiEntries) == 0 {
+			fmt.Println("  The ABI doesn't change.")
+		}
+		for _, entry := range upgrade.AddedAbiEntries {
+			color.GreenPrintf("  + %s\n", entry)
+		}
+		for _, entry := range upgrade.RemovedAbiEntries {
+			color.RedPrintf("  - %s\n", entry)
+		}
+	}
+}
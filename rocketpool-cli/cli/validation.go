@@ -37,6 +37,14 @@ func ValidateArgCount(c *cli.Command, count int) error {
 	return nil
 }
 
+// Validate that a command has at least a minimum number of arguments
+func ValidateMinArgCount(c *cli.Command, count int) error {
+	if c.Args().Len() < count {
+		return fmt.Errorf("Incorrect argument count; usage: %s", c.UsageText)
+	}
+	return nil
+}
+
 // Validate a big int
 func ValidateBigInt(name, value string) (*big.Int, error) {
 	val, success := big.NewInt(0).SetString(value, 0)
@@ -76,6 +84,20 @@ func ValidateUint32(name, value string) (uint32, error) {
 	return uint32(val), nil
 }
 
+// Validate a list of comma-separated unsigned 32-bit integer values
+func ValidateUint32s(name, value string) ([]uint32, error) {
+	elements := strings.Split(value, ",")
+	vals := make([]uint32, len(elements))
+	for i, element := range elements {
+		val, err := ValidateUint32(name, strings.TrimSpace(element))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid %s '%s' - element %d (%s) could not be parsed: %w", name, value, i, element, err)
+		}
+		vals[i] = val
+	}
+	return vals, nil
+}
+
 // Validate an address
 func ValidateAddress(name, value string) (common.Address, error) {
 	if !common.IsHexAddress(value) {
@@ -340,6 +362,20 @@ func ValidatePubkey(name, value string) (types.ValidatorPubkey, error) {
 	return pubkey, nil
 }
 
+// Validate a collection of validator pubkeys
+func ValidatePubkeys(name, value string) ([]types.ValidatorPubkey, error) {
+	elements := strings.Split(value, ",")
+	pubkeys := make([]types.ValidatorPubkey, len(elements))
+	for i, element := range elements {
+		pubkey, err := ValidatePubkey(name, strings.TrimSpace(element))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid pubkey %d in %s: %w", i, name, err)
+		}
+		pubkeys[i] = pubkey
+	}
+	return pubkeys, nil
+}
+
 // Validate a hex-encoded byte array
 func ValidateByteArray(name, value string) ([]byte, error) {
 	// Remove a 0x prefix if present
@@ -0,0 +1,45 @@
+package governance
+
+import (
+	"context"
+
+	"github.com/urfave/cli/v3"
+
+	cliutils "github.com/rocket-pool/smartnode/rocketpool-cli/cli"
+)
+
+// Register commands
+func RegisterCommands(app *cli.Command, name string, aliases []string) {
+	app.Commands = append(app.Commands, &cli.Command{
+		Name:    name,
+		Aliases: aliases,
+		Usage:   "View Rocket Pool governance across the pDAO, oDAO, Security Council and Snapshot",
+		Commands: []*cli.Command{
+
+			{
+				Name:      "upcoming",
+				Aliases:   []string{"u"},
+				Usage:     "List the next phase change of every open proposal, soonest first",
+				UsageText: "rocketpool governance upcoming [options]",
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:    "needs-vote",
+						Aliases: []string{"n"},
+						Usage:   "Only show proposals the node still needs to vote on",
+					},
+				},
+				Action: func(ctx context.Context, c *cli.Command) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return getUpcoming(c.Bool("needs-vote"))
+
+				},
+			},
+		},
+	})
+}
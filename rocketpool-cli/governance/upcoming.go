@@ -0,0 +1,64 @@
+package governance
+
+import (
+	"fmt"
+	"time"
+
+	cliutils "github.com/rocket-pool/smartnode/rocketpool-cli/cli"
+	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/color"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+)
+
+func getUpcoming(needsVoteOnly bool) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClient().WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get the upcoming events
+	response, err := rp.GovernanceUpcoming()
+	if err != nil {
+		return err
+	}
+	if response.SnapshotError != "" {
+		color.YellowPrintf("Unable to fetch the latest proposals from snapshot.org: %s\n\n", response.SnapshotError)
+	}
+
+	// Print & return
+	count := 0
+	for _, event := range response.Events {
+		if needsVoteOnly && !event.NeedsVote {
+			continue
+		}
+		count++
+
+		fmt.Printf("%s %s: %s\n", color.LightBlue(string(event.DAO)), event.ProposalID, event.Title)
+		fmt.Printf("State:      %s\n", event.State)
+		if event.Time.Before(time.Now()) {
+			fmt.Printf("Next phase: %s (%s, awaiting an update)\n", event.NextPhase, cliutils.GetDateTimeString(uint64(event.Time.Unix())))
+		} else {
+			fmt.Printf("Next phase: %s (%s, in %s)\n", event.NextPhase, cliutils.GetDateTimeString(uint64(event.Time.Unix())), time.Until(event.Time).Round(time.Minute))
+		}
+		if event.Voted {
+			color.GreenPrintln("The node has voted on this proposal.")
+		} else if event.NeedsVote {
+			color.YellowPrintln("The node has not voted on this proposal yet.")
+		}
+		if event.Link != "" {
+			fmt.Printf("Link:       %s\n", event.Link)
+		}
+		fmt.Println()
+	}
+	if count == 0 {
+		if needsVoteOnly {
+			fmt.Println("There are no open proposals the node needs to vote on.")
+		} else {
+			fmt.Println("There are no open governance proposals.")
+		}
+	}
+	return nil
+
+}
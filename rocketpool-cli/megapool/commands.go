@@ -38,6 +38,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 						Usage:   "Number of deposits to make",
 						Value:   0,
 					},
+					cliutils.ExportUnsignedFlag(),
 				},
 				Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -46,6 +47,8 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 						return err
 					}
 
+					cliutils.ApplyExportUnsignedFlag(c)
+
 					// Run
 					return nodeMegapoolDeposit(c.Uint64("count"), c.Int64("express-tickets"), c.Bool("yes"))
 
@@ -158,16 +161,16 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 				Name:      "stake",
 				Aliases:   []string{"k"},
 				Usage:     "Stake a megapool validator",
-				UsageText: "rocketpool megapool stake",
+				UsageText: "rocketpool megapool stake [--validator-id <ids|all>]",
 				Flags: []cli.Flag{
 					&cli.BoolFlag{
 						Name:    "yes",
 						Aliases: []string{"y"},
 						Usage:   "Automatically confirm the action",
 					},
-					&cli.Uint64Flag{
+					&cli.StringFlag{
 						Name:  "validator-id",
-						Usage: "The validator id to stake",
+						Usage: "The validator id(s) to stake: a comma-separated list of ids and ranges (e.g. 1,4-7), or 'all' for every validator ready to stake",
 					},
 				},
 				Action: func(ctx context.Context, c *cli.Command) error {
@@ -177,23 +180,26 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 						return err
 					}
 
-					var validatorId uint64
+					var validatorIds []uint64
 					if !c.IsSet("validator-id") {
-						var err error
-						var found bool
-						validatorId, found, err = getStakableValidator()
+						validatorId, found, err := getStakableValidator()
 						if err != nil {
 							return err
 						}
 						if !found {
 							return nil
 						}
+						validatorIds = []uint64{validatorId}
 					} else {
-						validatorId = c.Uint64("validator-id")
+						var err error
+						validatorIds, err = selectStakableValidators(c.String("validator-id"))
+						if err != nil {
+							return err
+						}
 					}
 
 					// Run
-					return stake(validatorId, c.Bool("yes"))
+					return stake(validatorIds, c.Bool("yes"))
 				},
 			},
 			{
@@ -208,7 +214,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 					},
 					&cli.StringFlag{
 						Name:  "validator-id",
-						Usage: "The validator id to exit",
+						Usage: "The validator id(s) to exit: a comma-separated list of ids and ranges (e.g. 1,4-7), or 'all' for every queued validator",
 					},
 					&cli.BoolFlag{
 						Name:  "express",
@@ -316,15 +322,15 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 				Name:      "notify-validator-exit",
 				Aliases:   []string{"n"},
 				Usage:     "Notify that a validator exit is in progress",
-				UsageText: "rocketpool megapool notify-validator-exit",
+				UsageText: "rocketpool megapool notify-validator-exit [--validator-id <ids|all>]",
 				Flags: []cli.Flag{
 					&cli.BoolFlag{
 						Name:  "yes",
 						Usage: "Automatically confirm the action",
 					},
-					&cli.Uint64Flag{
+					&cli.StringFlag{
 						Name:  "validator-id",
-						Usage: "The validator id for which the exit is being notified",
+						Usage: "The validator id(s) for which the exit is being notified: a comma-separated list of ids and ranges (e.g. 1,4-7), or 'all' for every exited validator",
 					},
 				},
 				Action: func(ctx context.Context, c *cli.Command) error {
@@ -334,42 +340,45 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 						return err
 					}
 
-					var validatorId uint64
+					var validatorIds []uint64
 					if !c.IsSet("validator-id") {
-						var err error
-						var found bool
-						validatorId, found, err = getExitedValidator()
+						validatorId, found, err := getExitedValidator()
 						if err != nil {
 							return err
 						}
 						if !found {
 							return nil
 						}
+						validatorIds = []uint64{validatorId}
 					} else {
-						validatorId = c.Uint64("validator-id")
+						var err error
+						validatorIds, err = selectExitedValidators(c.String("validator-id"))
+						if err != nil {
+							return err
+						}
 					}
 
 					// Run
-					return notifyValidatorExit(validatorId, c.Bool("yes"))
+					return notifyValidatorExit(validatorIds, c.Bool("yes"))
 				},
 			},
 			{
 				Name:      "notify-final-balance",
 				Aliases:   []string{"f"},
 				Usage:     "Notify that a validator exit has completed and the final balance has been withdrawn",
-				UsageText: "rocketpool megapool notify-final-balance",
+				UsageText: "rocketpool megapool notify-final-balance [--validator-id <ids|all>] [--slot N]",
 				Flags: []cli.Flag{
 					&cli.BoolFlag{
 						Name:  "yes",
 						Usage: "Automatically confirm the action",
 					},
-					&cli.Uint64Flag{
+					&cli.StringFlag{
 						Name:  "validator-id",
-						Usage: "The validator id for which the final balance is being notified",
+						Usage: "The validator id(s) for which the final balance is being notified: a comma-separated list of ids and ranges (e.g. 1,4-7), or 'all' for every fully withdrawn validator",
 					},
 					&cli.Uint64Flag{
 						Name:  "slot",
-						Usage: "The withdrawal slot",
+						Usage: "The withdrawal slot (only when notifying a single validator)",
 					},
 				},
 				Action: func(ctx context.Context, c *cli.Command) error {
@@ -379,16 +388,28 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 						return err
 					}
 
-					validatorId, validatorIndex, found, err := getNotifiableValidator()
-					if err != nil {
-						return err
-					}
-					if !found {
-						return nil
+					var validatorIds []uint64
+					var validatorIndex uint64
+					if !c.IsSet("validator-id") {
+						validatorId, index, found, err := getNotifiableValidator()
+						if err != nil {
+							return err
+						}
+						if !found {
+							return nil
+						}
+						validatorIds = []uint64{validatorId}
+						validatorIndex = index
+					} else {
+						var err error
+						validatorIds, err = selectNotifiableValidators(c.String("validator-id"))
+						if err != nil {
+							return err
+						}
 					}
 
 					// Run
-					return notifyFinalBalance(validatorId, validatorIndex, c.Uint64("slot"), c.Bool("yes"))
+					return notifyFinalBalance(validatorIds, validatorIndex, c.Uint64("slot"), c.Bool("yes"))
 				},
 			},
 			{
@@ -462,6 +483,95 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 					return setUseLatestDelegateMegapool(useLatest, c.Bool("yes"))
 				},
 			},
+			{
+				Name:    "deposit-data",
+				Aliases: []string{"dd"},
+				Usage:   "Generate or verify standard deposit data for the megapool's validators",
+				Commands: []*cli.Command{
+					{
+						Name:      "generate",
+						Aliases:   []string{"g"},
+						Usage:     "Derive the keys for the node's next megapool validators and create their deposit_data.json entries, without saving the keys or making a deposit",
+						UsageText: "rocketpool megapool deposit-data generate --count N [options]",
+						Flags: []cli.Flag{
+							&cli.Uint64Flag{
+								Name:    "count",
+								Aliases: []string{"c"},
+								Usage:   "The number of validators to generate deposit data for",
+								Value:   1,
+							},
+							&cli.StringFlag{
+								Name:    "output",
+								Aliases: []string{"o"},
+								Usage:   "The file to write the deposit data to; defaults to deposit_data-<timestamp>.json",
+							},
+							&cli.BoolFlag{
+								Name:    "dry-run",
+								Aliases: []string{"d"},
+								Usage:   "Print the deposit data instead of writing it to a file",
+							},
+						},
+						Action: func(ctx context.Context, c *cli.Command) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 0); err != nil {
+								return err
+							}
+
+							// Run
+							return generateDepositData(c.Uint64("count"), c.String("output"), c.Bool("dry-run"))
+
+						},
+					},
+					{
+						Name:      "verify",
+						Aliases:   []string{"v"},
+						Usage:     "Check a deposit_data.json file against the node's megapool withdrawal credentials and the network's deposit contract",
+						UsageText: "rocketpool megapool deposit-data verify file",
+						Action: func(ctx context.Context, c *cli.Command) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 1); err != nil {
+								return err
+							}
+
+							// Run
+							return verifyDepositData(c.Args().Get(0))
+
+						},
+					},
+				},
+			},
+			{
+				Name:  "validator",
+				Usage: "Inspect a single megapool validator",
+				Commands: []*cli.Command{
+					{
+						Name:      "history",
+						Aliases:   []string{"h"},
+						Usage:     "Show the full timeline of a megapool validator, from its deposit to the return of its capital",
+						UsageText: "rocketpool megapool validator history --validator-id N",
+						Flags: []cli.Flag{
+							&cli.Uint64Flag{
+								Name:     "validator-id",
+								Usage:    "The ID of the validator to show the history of",
+								Required: true,
+							},
+						},
+						Action: func(ctx context.Context, c *cli.Command) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 0); err != nil {
+								return err
+							}
+
+							// Run
+							return getValidatorHistory(c.Uint64("validator-id"))
+
+						},
+					},
+				},
+			},
 		},
 	})
 }
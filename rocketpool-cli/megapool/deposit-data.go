@@ -0,0 +1,159 @@
+package megapool
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/mitchellh/go-homedir"
+
+	cliutils "github.com/rocket-pool/smartnode/rocketpool-cli/cli"
+	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/color"
+	hexutils "github.com/rocket-pool/smartnode/shared/hex"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func generateDepositData(count uint64, outputPath string, dryRun bool) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClient().WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check the count
+	if count == 0 || count > maxCount {
+		return fmt.Errorf("count must be between 1 and %d", maxCount)
+	}
+
+	// Make sure ETH2 is on the correct chain
+	if ok, err := checkDepositContract(rp); !ok || err != nil {
+		return err
+	}
+
+	// Generate the deposit data
+	response, err := rp.GetMegapoolDepositData(count)
+	if err != nil {
+		return err
+	}
+	depositDataBytes, err := json.MarshalIndent(response.DepositData, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error serializing deposit data: %w", err)
+	}
+
+	fmt.Printf("Generated deposit data for validator key indices %d to %d of the node wallet.\n", response.StartIndex, response.StartIndex+uint(len(response.DepositData))-1)
+	fmt.Printf("Withdrawal credentials: %s (megapool %s)\n", response.WithdrawalCredentials.Hex(), color.LightBlue(response.MegapoolAddress.Hex()))
+	fmt.Println("The keys have not been saved and no deposit has been made. Your next megapool deposit will use these same keys, as long as no other validators are created first.")
+	fmt.Println()
+
+	if dryRun {
+		fmt.Println(string(depositDataBytes))
+		return nil
+	}
+
+	// Write the file
+	if outputPath == "" {
+		outputPath = fmt.Sprintf("deposit_data-%d.json", time.Now().Unix())
+	}
+	outputPath, err = homedir.Expand(outputPath)
+	if err != nil {
+		return fmt.Errorf("error expanding output path: %w", err)
+	}
+	if err := os.WriteFile(outputPath, depositDataBytes, 0644); err != nil {
+		return fmt.Errorf("error writing deposit data to %s: %w", outputPath, err)
+	}
+	color.GreenPrintf("Wrote %d deposit(s) to %s.\n", len(response.DepositData), outputPath)
+	return nil
+
+}
+
+func verifyDepositData(path string) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClient().WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Load the file
+	path, err = homedir.Expand(path)
+	if err != nil {
+		return fmt.Errorf("error expanding path: %w", err)
+	}
+	depositDataBytes, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading %s: %w", path, err)
+	}
+	var entries []api.DepositDataEntry
+	if err := json.Unmarshal(depositDataBytes, &entries); err != nil {
+		return fmt.Errorf("error parsing %s as deposit data: %w", path, err)
+	}
+	if len(entries) == 0 {
+		fmt.Printf("%s does not contain any deposits.\n", path)
+		return nil
+	}
+
+	// Make sure ETH2 is on the correct chain, failing the verification if it isn't
+	ok, err := checkDepositContract(rp)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("deposit contract mismatch: the deposits can't be verified against the Beacon client's network")
+	}
+
+	// Verify the deposits
+	response, err := rp.VerifyMegapoolDepositData(entries)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Checking %d deposit(s) against withdrawal credentials %s (megapool %s) and fork version 0x%s", len(entries), response.WithdrawalCredentials.Hex(), color.LightBlue(response.MegapoolAddress.Hex()), response.ForkVersion)
+	if response.NetworkName != "" {
+		fmt.Printf(" (%s)", response.NetworkName)
+	}
+	fmt.Println(".")
+	fmt.Println()
+
+	invalidCount := 0
+	for _, deposit := range response.Deposits {
+		if len(deposit.Errors) == 0 {
+			color.GreenPrintf("%s: valid\n", hexutils.AddPrefix(deposit.Pubkey))
+			continue
+		}
+		invalidCount++
+		color.RedPrintf("%s: invalid\n", hexutils.AddPrefix(deposit.Pubkey))
+		for _, problem := range deposit.Errors {
+			fmt.Printf("\t%s\n", problem)
+		}
+	}
+	fmt.Println()
+
+	if invalidCount > 0 {
+		return fmt.Errorf("%d of %d deposit(s) are invalid", invalidCount, len(response.Deposits))
+	}
+	color.GreenPrintln("All deposits are valid.")
+	return nil
+
+}
+
+// Check that the Beacon client is using the same deposit contract as Rocket Pool, printing an alert if it isn't
+func checkDepositContract(rp *rocketpool.Client) (bool, error) {
+	depositContractInfo, err := rp.DepositContractInfo()
+	if err != nil {
+		return false, err
+	}
+	if depositContractInfo.RPNetwork != depositContractInfo.BeaconNetwork ||
+		depositContractInfo.RPDepositContract != depositContractInfo.BeaconDepositContract {
+		cliutils.PrintDepositMismatchError(
+			depositContractInfo.RPNetwork,
+			depositContractInfo.BeaconNetwork,
+			depositContractInfo.RPDepositContract,
+			depositContractInfo.BeaconDepositContract)
+		return false, nil
+	}
+	return true, nil
+}
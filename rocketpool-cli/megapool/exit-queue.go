@@ -48,14 +48,12 @@ func exitQueue(validatorId string, yes bool) error {
 			}
 			selectedValidators = validatorsInQueue
 		} else {
-			// Parse comma-separated validator IDs
-			ids := strings.SplitSeq(validatorId, ",")
-			for idStr := range ids {
-				idStr = strings.TrimSpace(idStr)
-				validatorId, err := strconv.ParseUint(idStr, 10, 64)
-				if err != nil {
-					return fmt.Errorf("Invalid validator id '%s': %w", idStr, err)
-				}
+			// Parse comma-separated validator IDs and ranges
+			validatorIds, err := parseValidatorIds(validatorId)
+			if err != nil {
+				return err
+			}
+			for _, validatorId := range validatorIds {
 				found := false
 				for _, v := range validatorsInQueue {
 					if uint64(v.ValidatorId) == validatorId {
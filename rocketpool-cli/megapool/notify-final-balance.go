@@ -6,12 +6,13 @@ import (
 	"strconv"
 	"time"
 
-	cliutils "github.com/rocket-pool/smartnode/rocketpool-cli/cli"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/bindings/transactions/gaslimit"
 	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/color"
 	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/prompt"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
-	"github.com/rocket-pool/smartnode/shared/services/gas"
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
@@ -32,6 +33,28 @@ func getNotifiableValidator() (uint64, uint64, bool, error) {
 		return 0, 0, false, err
 	}
 
+	readyValidators, pendingValidators, needsExitNotify := getNotifiableValidators(status)
+	if len(readyValidators) > 0 {
+		sort.Sort(ByIndex(readyValidators))
+		options := make([]string, len(readyValidators))
+		for vi, v := range readyValidators {
+			options[vi] = fmt.Sprintf("ID: %d - Index: %d - Pubkey: 0x%s", v.ValidatorId, v.ValidatorIndex, v.PubKey.String())
+		}
+		selected, _ := prompt.Select("Please select a validator to notify the final balance:", options)
+
+		// Get validators
+		return uint64(readyValidators[selected].ValidatorId), uint64(readyValidators[selected].ValidatorIndex), true, nil
+
+	}
+
+	fmt.Println("No validators at the state where the full withdrawal can be proved.")
+	printPendingFinalBalanceValidators(pendingValidators, needsExitNotify, status)
+	return 0, 0, false, nil
+}
+
+// Split the megapool's exiting validators into those that are ready for a final balance proof, those still waiting
+// for the full withdrawal, and those whose exit hasn't been notified yet
+func getNotifiableValidators(status api.MegapoolStatusResponse) ([]api.MegapoolValidatorDetails, []api.MegapoolValidatorDetails, []api.MegapoolValidatorDetails) {
 	readyValidators := []api.MegapoolValidatorDetails{}
 	pendingValidators := []api.MegapoolValidatorDetails{}
 	// Beacon exit started, but notify-validator-exit has not been submitted yet.
@@ -57,22 +80,26 @@ func getNotifiableValidator() (uint64, uint64, bool, error) {
 			needsExitNotify = append(needsExitNotify, validator)
 		}
 	}
-	if len(readyValidators) > 0 {
-		sort.Sort(ByIndex(readyValidators))
-		options := make([]string, len(readyValidators))
-		for vi, v := range readyValidators {
-			options[vi] = fmt.Sprintf("ID: %d - Index: %d - Pubkey: 0x%s", v.ValidatorId, v.ValidatorIndex, v.PubKey.String())
-		}
-		selected, _ := prompt.Select("Please select a validator to notify the final balance:", options)
-
-		// Get validators
-		return uint64(readyValidators[selected].ValidatorId), uint64(readyValidators[selected].ValidatorIndex), true, nil
-
-	}
+	return readyValidators, pendingValidators, needsExitNotify
+}
 
-	fmt.Println("No validators at the state where the full withdrawal can be proved.")
-	printPendingFinalBalanceValidators(pendingValidators, needsExitNotify, status)
-	return 0, 0, false, nil
+// Resolve a --validator-id selection for final balance notifications
+func selectNotifiableValidators(selection string) ([]uint64, error) {
+	return selectValidators(selection, func() ([]api.MegapoolValidatorDetails, error) {
+		rp, err := rocketpool.NewClient().WithReady()
+		if err != nil {
+			return nil, err
+		}
+		defer rp.Close()
+		fmt.Println("Loading megapool validators at the finalized beacon state...")
+		status, err := rp.MegapoolStatus(true)
+		if err != nil {
+			return nil, err
+		}
+		readyValidators, _, _ := getNotifiableValidators(status)
+		sort.Sort(ByIndex(readyValidators))
+		return readyValidators, nil
+	})
 }
 
 func printPendingFinalBalanceValidators(pending, needsExitNotify []api.MegapoolValidatorDetails, status api.MegapoolStatusResponse) {
@@ -164,7 +191,7 @@ func epochTimingSuffix(targetEpoch, currentEpoch, secondsPerEpoch uint64) string
 	return fmt.Sprintf(" (in %d epochs, ~%s)", remaining, wait)
 }
 
-func notifyFinalBalance(validatorId, validatorIndex, slot uint64, yes bool) error {
+func notifyFinalBalance(validatorIds []uint64, validatorIndex, slot uint64, yes bool) error {
 
 	// Get RP client
 	rp, err := rocketpool.NewClient().WithReady()
@@ -173,13 +200,22 @@ func notifyFinalBalance(validatorId, validatorIndex, slot uint64, yes bool) erro
 	}
 	defer rp.Close()
 
+	if len(validatorIds) == 0 {
+		fmt.Println("No validators at the state where the full withdrawal can be proved.")
+		return nil
+	}
+	if len(validatorIds) > 1 && slot != 0 {
+		return fmt.Errorf("the withdrawal slot can only be provided when notifying the final balance of a single validator")
+	}
+
 	// Get the config
 	cfg, _, err := rp.LoadConfig()
 	if err != nil {
 		return fmt.Errorf("Error loading configuration: %w", err)
 	}
 
-	if slot == 0 {
+	if slot == 0 && len(validatorIds) == 1 {
+		validatorId := validatorIds[0]
 		fmt.Println("The Smart Node needs to find the slot containing the validator withdrawal. This may take a while. You can speed up the final balance proof generation by submitting the withdrawal slot for your validator.")
 		fmt.Println()
 
@@ -198,45 +234,28 @@ func notifyFinalBalance(validatorId, validatorIndex, slot uint64, yes bool) erro
 		}
 	}
 
-	color.YellowPrintln("Fetching the beacon state to craft a final balance proof. This process can take several minutes and is CPU and memory intensive.")
+	color.YellowPrintln("Fetching the beacon state to craft a final balance proof. This process can take several minutes per validator and is CPU and memory intensive.")
 	fmt.Println()
 
-	response, err := rp.CanNotifyFinalBalance(validatorId, slot)
-	if err != nil {
-		return err
-	}
-
-	if !response.CanExit {
-		return nil
-	}
-
-	// Assign max fees
-	err = gas.AssignMaxFeeAndLimit(response.GasLimits, rp, yes)
-	if err != nil {
-		return err
-	}
-
-	// Prompt for confirmation
-	if prompt.Declined(yes, "Are you sure you want to notify the final balance for validator id %d exit?", validatorId) {
-		fmt.Println("Cancelled.")
-		return nil
-	}
-
-	// Exit the validator
-	resp, err := rp.NotifyFinalBalance(validatorId, slot)
-	if err != nil {
-		return err
-	}
-
-	fmt.Printf("Notifying validator final balance...\n")
-	cliutils.PrintTransactionHash(rp, resp.TxHash)
-	if _, err = rp.WaitForTransaction(resp.TxHash); err != nil {
-		return err
-	}
-
-	// Log & return
-	fmt.Printf("Successfully notified final balance for validator id %d.\n", validatorId)
-	return nil
+	// Check and notify each validator
+	return runValidatorOperation(rp, validatorOperation{
+		action:         "notify the final balance of",
+		successMessage: "Successfully notified final balance for validator id %d.",
+		check: func(validatorId uint64) (bool, gaslimit.Limits, error) {
+			response, err := rp.CanNotifyFinalBalance(validatorId, slot)
+			if err != nil {
+				return false, gaslimit.Limits{}, err
+			}
+			return response.CanExit, response.GasLimits, nil
+		},
+		submit: func(validatorId uint64) (common.Hash, error) {
+			response, err := rp.NotifyFinalBalance(validatorId, slot)
+			if err != nil {
+				return common.Hash{}, err
+			}
+			return response.TxHash, nil
+		},
+	}, validatorIds, yes)
 
 }
 
@@ -4,9 +4,10 @@ import (
 	"fmt"
 	"sort"
 
-	cliutils "github.com/rocket-pool/smartnode/rocketpool-cli/cli"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/bindings/transactions/gaslimit"
 	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/prompt"
-	"github.com/rocket-pool/smartnode/shared/services/gas"
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
@@ -27,23 +28,7 @@ func getExitedValidator() (uint64, bool, error) {
 		return 0, false, err
 	}
 
-	activeValidators := []api.MegapoolValidatorDetails{}
-
-	for _, validator := range status.Megapool.Validators {
-		if !validator.Activated || validator.Exiting || validator.Exited {
-			continue
-		}
-		if !validator.BeaconStatus.Exists {
-			continue
-		}
-		if validator.BeaconStatus.ExitEpoch == FarFutureEpoch {
-			continue
-		}
-		if validator.BeaconStatus.WithdrawableEpoch == FarFutureEpoch {
-			continue
-		}
-		activeValidators = append(activeValidators, validator)
-	}
+	activeValidators := getExitedValidators(status)
 	if len(activeValidators) > 0 {
 		sort.Sort(ByIndex(activeValidators))
 		options := make([]string, len(activeValidators))
@@ -65,67 +50,92 @@ func getExitedValidator() (uint64, bool, error) {
 	return 0, false, nil
 }
 
-func notifyValidatorExit(validatorId uint64, yes bool) error {
-
-	// Get RP client
-	rp, err := rocketpool.NewClient().WithReady()
-	if err != nil {
-		return err
-	}
-	defer rp.Close()
-
-	fmt.Printf("Checking whether validator id %d can be notified...\n", validatorId)
-
-	response, err := rp.CanNotifyValidatorExit(validatorId)
-	if err != nil {
-		return fmt.Errorf("could not check notify-validator-exit for id %d: %w", validatorId, err)
-	}
-
-	if !response.CanExit {
-		fmt.Printf("Cannot notify the exit of validator id %d.\n", validatorId)
-		if response.InvalidStatus {
-			fmt.Println("  The validator is not in a staked state.")
+// Get the validators whose exit is visible on the finalized beacon state but hasn't been notified yet
+func getExitedValidators(status api.MegapoolStatusResponse) []api.MegapoolValidatorDetails {
+	exitedValidators := []api.MegapoolValidatorDetails{}
+	for _, validator := range status.Megapool.Validators {
+		if !validator.Activated || validator.Exiting || validator.Exited {
+			continue
 		}
-		if response.AlreadyExiting {
-			fmt.Println("  Exit has already been notified for this validator.")
+		if !validator.BeaconStatus.Exists {
+			continue
 		}
-		if response.AlreadyExited {
-			fmt.Println("  The validator has already been fully exited on the megapool.")
+		if validator.BeaconStatus.ExitEpoch == FarFutureEpoch {
+			continue
 		}
-		if response.ExitNotFinalized {
-			fmt.Println("  The validator exit is not yet reflected in the finalized beacon state.")
+		if validator.BeaconStatus.WithdrawableEpoch == FarFutureEpoch {
+			continue
 		}
-		return fmt.Errorf("cannot notify exit of validator id %d", validatorId)
-	}
-
-	// Assign max fees
-	err = gas.AssignMaxFeeAndLimit(response.GasLimits, rp, yes)
-	if err != nil {
-		return err
+		exitedValidators = append(exitedValidators, validator)
 	}
+	return exitedValidators
+}
 
-	// Prompt for confirmation
-	if prompt.Declined(yes, "Are you sure you want to notify about the validator id %d exit?", validatorId) {
-		fmt.Println("Cancelled.")
-		return nil
-	}
+// Resolve a --validator-id selection for exit notifications
+func selectExitedValidators(selection string) ([]uint64, error) {
+	return selectValidators(selection, func() ([]api.MegapoolValidatorDetails, error) {
+		rp, err := rocketpool.NewClient().WithReady()
+		if err != nil {
+			return nil, err
+		}
+		defer rp.Close()
+		fmt.Println("Loading megapool validators at the finalized beacon state...")
+		status, err := rp.MegapoolStatus(true)
+		if err != nil {
+			return nil, err
+		}
+		return getExitedValidators(status), nil
+	})
+}
 
-	fmt.Printf("Submitting notify-exit for validator id %d ...\n", validatorId)
+func notifyValidatorExit(validatorIds []uint64, yes bool) error {
 
-	// Exit the validator
-	resp, err := rp.NotifyValidatorExit(validatorId)
+	// Get RP client
+	rp, err := rocketpool.NewClient().WithReady()
 	if err != nil {
-		return fmt.Errorf("notify-validator-exit failed for id %d: %w", validatorId, err)
+		return err
 	}
+	defer rp.Close()
 
-	fmt.Printf("Notifying validator exit...\n")
-	cliutils.PrintTransactionHash(rp, resp.TxHash)
-	if _, err = rp.WaitForTransaction(resp.TxHash); err != nil {
-		return err
+	if len(validatorIds) == 0 {
+		fmt.Println("No validators are ready to notify exit.")
+		return nil
 	}
 
-	// Log & return
-	fmt.Printf("Successfully notified the exit of validator id %d.\n", validatorId)
-	return nil
+	// Check and notify each validator
+	return runValidatorOperation(rp, validatorOperation{
+		action:         "notify the exit of",
+		successMessage: "Successfully notified the exit of validator id %d.",
+		check: func(validatorId uint64) (bool, gaslimit.Limits, error) {
+			fmt.Printf("Checking whether validator id %d can be notified...\n", validatorId)
+			response, err := rp.CanNotifyValidatorExit(validatorId)
+			if err != nil {
+				return false, gaslimit.Limits{}, fmt.Errorf("could not check notify-validator-exit for id %d: %w", validatorId, err)
+			}
+			if !response.CanExit {
+				fmt.Printf("Cannot notify the exit of validator id %d.\n", validatorId)
+				if response.InvalidStatus {
+					fmt.Println("  The validator is not in a staked state.")
+				}
+				if response.AlreadyExiting {
+					fmt.Println("  Exit has already been notified for this validator.")
+				}
+				if response.AlreadyExited {
+					fmt.Println("  The validator has already been fully exited on the megapool.")
+				}
+				if response.ExitNotFinalized {
+					fmt.Println("  The validator exit is not yet reflected in the finalized beacon state.")
+				}
+			}
+			return response.CanExit, response.GasLimits, nil
+		},
+		submit: func(validatorId uint64) (common.Hash, error) {
+			response, err := rp.NotifyValidatorExit(validatorId)
+			if err != nil {
+				return common.Hash{}, fmt.Errorf("notify-validator-exit failed for id %d: %w", validatorId, err)
+			}
+			return response.TxHash, nil
+		},
+	}, validatorIds, yes)
 
 }
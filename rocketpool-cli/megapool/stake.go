@@ -3,9 +3,11 @@ package megapool
 import (
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/bindings/transactions/gaslimit"
 	cliutils "github.com/rocket-pool/smartnode/rocketpool-cli/cli"
 	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/prompt"
-	"github.com/rocket-pool/smartnode/shared/services/gas"
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
@@ -23,18 +25,7 @@ func getStakableValidator() (uint64, bool, error) {
 		return 0, false, err
 	}
 
-	validatorsReadyToStake := []api.MegapoolValidatorDetails{}
-	validatorsDepositPending := []api.MegapoolValidatorDetails{}
-
-	for _, validator := range status.Megapool.Validators {
-		if validator.InPrestake {
-			if validator.BeaconStatus.Index != "" {
-				validatorsReadyToStake = append(validatorsReadyToStake, validator)
-			} else {
-				validatorsDepositPending = append(validatorsDepositPending, validator)
-			}
-		}
-	}
+	validatorsReadyToStake, validatorsDepositPending := getStakableValidators(status)
 	if len(validatorsDepositPending) > 0 {
 		fmt.Println("The following validators have a pending deposit. Please wait until the deposit is processed before trying again:")
 		for _, v := range validatorsDepositPending {
@@ -59,7 +50,40 @@ func getStakableValidator() (uint64, bool, error) {
 	return 0, false, nil
 }
 
-func stake(validatorId uint64, yes bool) error {
+// Split the megapool's prestaked validators into those that are ready to stake and those whose deposit is still pending
+func getStakableValidators(status api.MegapoolStatusResponse) ([]api.MegapoolValidatorDetails, []api.MegapoolValidatorDetails) {
+	validatorsReadyToStake := []api.MegapoolValidatorDetails{}
+	validatorsDepositPending := []api.MegapoolValidatorDetails{}
+	for _, validator := range status.Megapool.Validators {
+		if validator.InPrestake {
+			if validator.BeaconStatus.Index != "" {
+				validatorsReadyToStake = append(validatorsReadyToStake, validator)
+			} else {
+				validatorsDepositPending = append(validatorsDepositPending, validator)
+			}
+		}
+	}
+	return validatorsReadyToStake, validatorsDepositPending
+}
+
+// Resolve a --validator-id selection for staking
+func selectStakableValidators(selection string) ([]uint64, error) {
+	return selectValidators(selection, func() ([]api.MegapoolValidatorDetails, error) {
+		rp, err := rocketpool.NewClient().WithReady()
+		if err != nil {
+			return nil, err
+		}
+		defer rp.Close()
+		status, err := rp.MegapoolStatus(true)
+		if err != nil {
+			return nil, err
+		}
+		validatorsReadyToStake, _ := getStakableValidators(status)
+		return validatorsReadyToStake, nil
+	})
+}
+
+func stake(validatorIds []uint64, yes bool) error {
 
 	// Get RP client
 	rp, err := rocketpool.NewClient().WithReady()
@@ -68,53 +92,41 @@ func stake(validatorId uint64, yes bool) error {
 	}
 	defer rp.Close()
 
-	// Warning reg the time necessary to build the proof
-	if prompt.Declined(yes, "The stake operation will construct a beacon state proof that the deposit for validator ID %d was correct. This will take several seconds to finish.\nDo you want to continue?", validatorId) {
-		fmt.Println("Cancelled.")
-		return nil
-	}
-
-	// Check megapool validator can be staked
-	canStake, err := rp.CanStake(validatorId)
-	if err != nil {
-		return err
-	}
-
-	if !canStake.CanStake {
-		fmt.Printf("The validator with index %d can't be staked.\n", validatorId)
-
-		if canStake.IndexNotFound {
-			fmt.Println("The validator deposit is still pending. Please wait until the deposit is processed before trying again.")
-		}
+	if len(validatorIds) == 0 {
+		fmt.Println("No validators can be staked at the moment")
 		return nil
 	}
 
-	// Assign max fees
-	err = gas.AssignMaxFeeAndLimit(canStake.GasLimits, rp, yes)
-	if err != nil {
-		return err
-	}
-
-	// Prompt for confirmation
-	if prompt.Declined(yes, "Are you sure you want to stake validator id %d", validatorId) {
+	// Warning reg the time necessary to build the proof
+	if prompt.Declined(yes, "The stake operation will construct a beacon state proof that the deposit for each validator (%s) was correct. This will take several seconds per validator to finish.\nDo you want to continue?", formatValidatorIds(validatorIds)) {
 		fmt.Println("Cancelled.")
 		return nil
 	}
 
-	// Stake
-	response, err := rp.Stake(validatorId)
-	if err != nil {
-		return err
-	}
-
-	fmt.Printf("Staking megapool validator %d...\n", validatorId)
-	cliutils.PrintTransactionHash(rp, response.TxHash)
-	if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
-		return err
-	}
-
-	// Log & return
-	fmt.Printf("Successfully staked megapool validator %d.\n", validatorId)
-	return nil
+	// Check and stake each validator
+	return runValidatorOperation(rp, validatorOperation{
+		action:         "stake",
+		successMessage: "Successfully staked megapool validator %d.",
+		check: func(validatorId uint64) (bool, gaslimit.Limits, error) {
+			canStake, err := rp.CanStake(validatorId)
+			if err != nil {
+				return false, gaslimit.Limits{}, err
+			}
+			if !canStake.CanStake {
+				fmt.Printf("The validator with id %d can't be staked.\n", validatorId)
+				if canStake.IndexNotFound {
+					fmt.Println("The validator deposit is still pending. Please wait until the deposit is processed before trying again.")
+				}
+			}
+			return canStake.CanStake, canStake.GasLimits, nil
+		},
+		submit: func(validatorId uint64) (common.Hash, error) {
+			response, err := rp.Stake(validatorId)
+			if err != nil {
+				return common.Hash{}, err
+			}
+			return response.TxHash, nil
+		},
+	}, validatorIds, yes)
 
 }
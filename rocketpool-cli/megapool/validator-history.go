@@ -0,0 +1,74 @@
+package megapool
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/color"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+)
+
+func getValidatorHistory(validatorId uint64) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClient().WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	if validatorId > math.MaxUint32 {
+		return fmt.Errorf("invalid validator ID %d", validatorId)
+	}
+
+	// Get the validator's history
+	response, err := rp.GetMegapoolValidatorHistory(uint32(validatorId))
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Validator %d of megapool %s\n", response.ValidatorId, color.LightBlue(response.MegapoolAddress.Hex()))
+	fmt.Printf("Pubkey:          %s\n", response.Pubkey.Hex())
+	if response.ValidatorIndex != "" {
+		fmt.Printf("Validator index: %s\n", response.ValidatorIndex)
+		fmt.Printf("Beacon status:   %s\n", response.BeaconStatus)
+	} else {
+		fmt.Println("Validator index: not yet seen on the Beacon Chain")
+	}
+	fmt.Println()
+
+	if len(response.Events) == 0 {
+		fmt.Println("No events were found for this validator.")
+		return nil
+	}
+
+	fmt.Printf("%-17s %-20s %-10s %-10s %-66s %s\n", "Step", "Time", "Slot", "Block", "Transaction", "Details")
+	for _, event := range response.Events {
+		block := "-"
+		if event.BlockNumber != 0 {
+			block = fmt.Sprint(event.BlockNumber)
+		}
+		txHash := "-"
+		if event.TxHash != (common.Hash{}) {
+			txHash = event.TxHash.Hex()
+		}
+		step := fmt.Sprintf("%-17s", event.Step)
+		if event.Pending {
+			step = color.Yellow(step)
+		}
+		fmt.Printf("%s %-20s %-10d %-10s %-66s %s\n", step, event.Time.Format("2006-01-02 15:04:05"), event.Slot, block, txHash, event.Description)
+	}
+
+	for _, event := range response.Events {
+		if event.Pending {
+			fmt.Println()
+			color.YellowPrintln("Steps in yellow are scheduled on the Beacon Chain but haven't happened yet.")
+			break
+		}
+	}
+
+	return nil
+
+}
@@ -0,0 +1,173 @@
+package megapool
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/bindings/transactions/gaslimit"
+	cliutils "github.com/rocket-pool/smartnode/rocketpool-cli/cli"
+	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/prompt"
+	"github.com/rocket-pool/smartnode/shared/services/gas"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// The largest number of validators a single --validator-id selection can name
+const maxValidatorSelection = 1000
+
+// Parse a --validator-id selection of comma-separated IDs and ranges (e.g. "1,4-7"), keeping the order given and dropping duplicates
+func parseValidatorIds(selection string) ([]uint64, error) {
+	validatorIds := []uint64{}
+	seen := map[uint64]bool{}
+	for part := range strings.SplitSeq(selection, ",") {
+		part = strings.TrimSpace(part)
+		start, end, isRange := strings.Cut(part, "-")
+		first, err := strconv.ParseUint(strings.TrimSpace(start), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid validator id '%s': %w", part, err)
+		}
+		last := first
+		if isRange {
+			last, err = strconv.ParseUint(strings.TrimSpace(end), 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid validator id range '%s': %w", part, err)
+			}
+			if last < first {
+				return nil, fmt.Errorf("Invalid validator id range '%s': the end is before the start", part)
+			}
+		}
+		for validatorId := first; validatorId <= last; validatorId++ {
+			if seen[validatorId] {
+				continue
+			}
+			if len(validatorIds) >= maxValidatorSelection {
+				return nil, fmt.Errorf("Too many validators selected; at most %d can be selected at once", maxValidatorSelection)
+			}
+			seen[validatorId] = true
+			validatorIds = append(validatorIds, validatorId)
+		}
+	}
+	return validatorIds, nil
+}
+
+// Resolve a --validator-id selection, where "all" selects every validator returned by getEligible
+func selectValidators(selection string, getEligible func() ([]api.MegapoolValidatorDetails, error)) ([]uint64, error) {
+	if strings.ToLower(strings.TrimSpace(selection)) == "all" {
+		eligible, err := getEligible()
+		if err != nil {
+			return nil, err
+		}
+		validatorIds := make([]uint64, len(eligible))
+		for i, validator := range eligible {
+			validatorIds[i] = uint64(validator.ValidatorId)
+		}
+		return validatorIds, nil
+	}
+	return parseValidatorIds(selection)
+}
+
+// A megapool operation that's submitted once per validator
+type validatorOperation struct {
+	// The action, used in prompts (e.g. "stake" or "notify the exit of")
+	action string
+
+	// The message printed once a validator's transaction is included, with a %d for the validator ID
+	successMessage string
+
+	// Check if the operation can be run for the validator, printing the reason if it can't
+	check func(validatorId uint64) (bool, gaslimit.Limits, error)
+
+	// Submit the operation for the validator
+	submit func(validatorId uint64) (common.Hash, error)
+}
+
+// Check the operation for each of the validators, then submit all of the eligible ones before waiting for any of them
+func runValidatorOperation(rp *rocketpool.Client, operation validatorOperation, validatorIds []uint64, yes bool) error {
+
+	// Check each validator and accumulate the gas estimates
+	var totalGasLimits gaslimit.Limits
+	eligible := []uint64{}
+	for _, validatorId := range validatorIds {
+		canRun, gasLimits, err := operation.check(validatorId)
+		if err != nil {
+			return fmt.Errorf("Error checking validator %d: %w", validatorId, err)
+		}
+		if !canRun {
+			continue
+		}
+		eligible = append(eligible, validatorId)
+		totalGasLimits = totalGasLimits.Add(gasLimits)
+	}
+	if len(eligible) == 0 {
+		fmt.Println("None of the selected validators are eligible.")
+		return nil
+	}
+
+	// If a custom nonce is set and there are multiple transactions, warn the user
+	if rocketpool.Defaults.CustomNonce != nil && len(eligible) > 1 {
+		cliutils.PrintMultiTransactionNonceWarning()
+	}
+
+	// Assign max fees
+	err := gas.AssignMaxFeeAndLimit(totalGasLimits, rp, yes)
+	if err != nil {
+		return err
+	}
+
+	// Prompt for confirmation
+	if prompt.Declined(yes, "Are you sure you want to %s %d validator(s) (%s)?", operation.action, len(eligible), formatValidatorIds(eligible)) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Submit the transactions back to back
+	hashes := map[uint64]common.Hash{}
+	submitted := []uint64{}
+	for _, validatorId := range eligible {
+		hash, err := operation.submit(validatorId)
+		if err != nil {
+			fmt.Printf("Could not %s validator %d: %s.\n", operation.action, validatorId, err.Error())
+			continue
+		}
+		fmt.Printf("Submitted the transaction to %s validator %d.\n", operation.action, validatorId)
+		cliutils.PrintTransactionHash(rp, hash)
+		hashes[validatorId] = hash
+		submitted = append(submitted, validatorId)
+
+		// If a custom nonce is set, increment it for the next transaction
+		if rocketpool.Defaults.CustomNonce != nil {
+			rp.IncrementCustomNonce()
+		}
+	}
+
+	// Wait for all of them
+	failed := len(eligible) - len(submitted)
+	for _, validatorId := range submitted {
+		if _, err = rp.WaitForTransaction(hashes[validatorId]); err != nil {
+			fmt.Printf("The transaction to %s validator %d failed: %s.\n", operation.action, validatorId, err.Error())
+			failed++
+			continue
+		}
+		fmt.Printf(operation.successMessage+"\n", validatorId)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d transaction(s) to %s validators failed", failed, len(eligible), operation.action)
+	}
+	return nil
+
+}
+
+// Format a list of validator IDs for display
+func formatValidatorIds(validatorIds []uint64) string {
+	ids := make([]string, len(validatorIds))
+	for i, validatorId := range validatorIds {
+		ids[i] = strconv.FormatUint(validatorId, 10)
+	}
+	if len(ids) == 1 {
+		return "ID " + ids[0]
+	}
+	return "IDs " + strings.Join(ids, ", ")
+}
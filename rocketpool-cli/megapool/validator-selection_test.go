@@ -0,0 +1,58 @@
+package megapool
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func TestParseValidatorIds(t *testing.T) {
+	cases := []struct {
+		selection string
+		expected  []uint64
+	}{
+		{"3", []uint64{3}},
+		{"1, 4-6,2", []uint64{1, 4, 5, 6, 2}},
+		{"2-3,3,1-2", []uint64{2, 3, 1}},
+		{"7-7", []uint64{7}},
+	}
+	for _, tc := range cases {
+		ids, err := parseValidatorIds(tc.selection)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", tc.selection, err)
+			continue
+		}
+		if !reflect.DeepEqual(ids, tc.expected) {
+			t.Errorf("%q: expected %v, got %v", tc.selection, tc.expected, ids)
+		}
+	}
+
+	for _, selection := range []string{"", "a", "5-2", "1-", "1,,2", "0-5000"} {
+		if _, err := parseValidatorIds(selection); err == nil {
+			t.Errorf("%q: expected an error", selection)
+		}
+	}
+}
+
+func TestSelectValidatorsAll(t *testing.T) {
+	eligible := func() ([]api.MegapoolValidatorDetails, error) {
+		return []api.MegapoolValidatorDetails{{ValidatorId: 4}, {ValidatorId: 9}}, nil
+	}
+	ids, err := selectValidators(" ALL ", eligible)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(ids, []uint64{4, 9}) {
+		t.Errorf("expected every eligible validator, got %v", ids)
+	}
+
+	// Explicit selections don't need the eligible list
+	ids, err = selectValidators("1-2", func() ([]api.MegapoolValidatorDetails, error) {
+		t.Fatal("the eligible validators shouldn't be loaded for an explicit selection")
+		return nil, nil
+	})
+	if err != nil || !reflect.DeepEqual(ids, []uint64{1, 2}) {
+		t.Errorf("expected validators 1 and 2, got %v (%v)", ids, err)
+	}
+}
@@ -2,6 +2,7 @@ package minipool
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/urfave/cli/v3"
 
@@ -364,6 +365,107 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 
 				},
 			},
+
+			{
+				Name:    "migrate",
+				Aliases: []string{"mg"},
+				Usage:   "Move minipools to the node's megapool: exit them, close them once withdrawn, and deposit matching megapool validators",
+				Commands: []*cli.Command{
+					{
+						Name:      "start",
+						Aliases:   []string{"b"},
+						Usage:     "Exit the selected minipools and start tracking their migration",
+						UsageText: "rocketpool minipool migrate start [options]",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:    "minipool",
+								Aliases: []string{"m"},
+								Usage:   "The minipool/s to migrate (comma-separated addresses or 'all')",
+							},
+							&cli.Uint64Flag{
+								Name:    "count",
+								Aliases: []string{"n"},
+								Usage:   "Migrate this many minipools, picking the ones that free up the most ETH first",
+							},
+							&cli.BoolFlag{
+								Name:    "use-express-tickets",
+								Aliases: []string{"e"},
+								Usage:   "Use the node's express tickets for the megapool deposits",
+								Value:   true,
+							},
+							&cli.BoolFlag{
+								Name:    "yes",
+								Aliases: []string{"y"},
+								Usage:   "Automatically confirm exiting and migrating the minipool/s",
+							},
+						},
+						Action: func(ctx context.Context, c *cli.Command) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 0); err != nil {
+								return err
+							}
+
+							// Validate flags
+							if c.String("minipool") != "" && c.String("minipool") != "all" {
+								if _, err := cliutils.ValidateAddresses("minipool address", c.String("minipool")); err != nil {
+									return err
+								}
+							}
+							if c.String("minipool") != "" && c.Uint64("count") > 0 {
+								return fmt.Errorf("only one of --minipool and --count can be used")
+							}
+
+							// Run
+							return startMigration(c.String("minipool"), c.Uint64("count"), c.Bool("use-express-tickets"), c.Bool("yes"))
+
+						},
+					},
+
+					{
+						Name:      "status",
+						Aliases:   []string{"s"},
+						Usage:     "Show the progress of the minipool migration",
+						UsageText: "rocketpool minipool migrate status",
+						Action: func(ctx context.Context, c *cli.Command) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 0); err != nil {
+								return err
+							}
+
+							// Run
+							return getMigrationStatus()
+
+						},
+					},
+
+					{
+						Name:      "cancel",
+						Aliases:   []string{"c"},
+						Usage:     "Stop the node daemon from closing and depositing for the minipools in the migration",
+						UsageText: "rocketpool minipool migrate cancel [options]",
+						Flags: []cli.Flag{
+							&cli.BoolFlag{
+								Name:    "yes",
+								Aliases: []string{"y"},
+								Usage:   "Automatically confirm cancelling the migration",
+							},
+						},
+						Action: func(ctx context.Context, c *cli.Command) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 0); err != nil {
+								return err
+							}
+
+							// Run
+							return cancelMigration(c.Bool("yes"))
+
+						},
+					},
+				},
+			},
 		},
 	})
 }
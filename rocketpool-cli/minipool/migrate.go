@@ -0,0 +1,269 @@
+package minipool
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/bindings/types"
+
+	cliutils "github.com/rocket-pool/smartnode/rocketpool-cli/cli"
+	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/color"
+	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/prompt"
+	"github.com/rocket-pool/smartnode/shared/math"
+	"github.com/rocket-pool/smartnode/shared/services/migration"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func startMigration(minipool string, count uint64, useExpressTickets bool, yes bool) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClient().WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Only one plan can run at a time
+	planResponse, err := rp.GetMinipoolMigrationPlan()
+	if err != nil {
+		return err
+	}
+	if planResponse.Plan != nil && !planResponse.Plan.IsComplete() {
+		fmt.Println("A migration is already in progress:")
+		fmt.Println()
+		printMigrationPlan(planResponse.Plan)
+		return nil
+	}
+
+	// Get minipool statuses
+	status, err := rp.MinipoolStatus()
+	if err != nil {
+		return err
+	}
+
+	// Get the minipools that can be migrated
+	stakingMinipools := []api.MinipoolDetails{}
+	for _, minipool := range status.Minipools {
+		if minipool.Status.Status == types.Staking && minipool.Validator.Active {
+			stakingMinipools = append(stakingMinipools, minipool)
+		}
+	}
+	if len(stakingMinipools) == 0 {
+		fmt.Println("No minipools can be migrated.")
+		return nil
+	}
+	rankMigrationCandidates(stakingMinipools)
+
+	// Get selected minipools
+	var selectedMinipools []api.MinipoolDetails
+	if count > 0 {
+
+		// Take the highest-ranked minipools
+		if count > uint64(len(stakingMinipools)) {
+			return fmt.Errorf("Only %d minipool(s) can be migrated.", len(stakingMinipools))
+		}
+		selectedMinipools = stakingMinipools[:count]
+
+	} else if minipool == "" {
+
+		// Prompt for minipool selection
+		fmt.Println("Minipools are listed in the suggested migration order: larger bonds first since they free up the most ETH for the megapool, then validators with a lower balance since they earn the least, then the oldest.")
+		fmt.Println()
+		options := make([]string, len(stakingMinipools)+1)
+		options[0] = "All available minipools"
+		for mi, minipool := range stakingMinipools {
+			options[mi+1] = fmt.Sprintf("%s (%.0f ETH bond, %.6f ETH balance, staking since %s)", minipool.Address.Hex(), math.RoundDown(math.WeiToEth(minipool.Node.DepositBalance), 0), math.RoundDown(math.WeiToEth(minipool.Validator.Balance), 6), minipool.Status.StatusTime.Format(cliutils.TimeFormat))
+		}
+		selected, _ := prompt.Select("Please select a minipool to migrate:", options)
+
+		// Get minipools
+		if selected == 0 {
+			selectedMinipools = stakingMinipools
+		} else {
+			selectedMinipools = []api.MinipoolDetails{stakingMinipools[selected-1]}
+		}
+
+	} else if minipool == "all" {
+		selectedMinipools = stakingMinipools
+	} else {
+
+		// Get matching minipools
+		for _, element := range strings.Split(minipool, ",") {
+			selectedAddress := common.HexToAddress(strings.TrimSpace(element))
+			found := false
+			for _, minipool := range stakingMinipools {
+				if minipool.Address == selectedAddress {
+					selectedMinipools = append(selectedMinipools, minipool)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return fmt.Errorf("The minipool %s is not available for migration.", selectedAddress.Hex())
+			}
+		}
+
+	}
+
+	// Show a warning message
+	color.YellowPrintln("NOTE:")
+	color.YellowPrintln("Migrating a minipool exits its validator from the Beacon Chain right away. The node daemon then takes care of the rest:")
+	color.YellowPrintln("1. Once the validator's balance has been withdrawn, it closes the minipool. The node's share goes to your withdrawal address.")
+	color.YellowPrintln("2. It then deposits a new megapool validator for each closed minipool, paying the bond from the node wallet and its credit balance.")
+	if useExpressTickets {
+		color.YellowPrintln("   Each deposit uses one of the node's express tickets while it has any left.")
+	}
+	color.YellowPrintln("If your withdrawal address isn't the node wallet, you'll need to send enough ETH back to the node wallet for the deposits.")
+	color.YellowPrintln("Closing and depositing are automatic transactions, so they follow the node's auto-tx gas threshold.")
+	fmt.Println()
+
+	// Prompt for confirmation
+	if !yes && !prompt.ConfirmWithIAgree("Are you sure you want to exit and migrate %d minipool(s)? This action cannot be undone!", len(selectedMinipools)) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Create the plan
+	addresses := make([]common.Address, len(selectedMinipools))
+	for i, minipool := range selectedMinipools {
+		addresses[i] = minipool.Address
+	}
+	response, err := rp.CreateMinipoolMigrationPlan(addresses, useExpressTickets)
+	if err != nil {
+		return err
+	}
+	for _, mp := range response.Plan.Minipools {
+		if mp.Stage == migration.StageExiting {
+			fmt.Printf("Successfully exited minipool %s.\n", mp.MinipoolAddress.Hex())
+		} else {
+			fmt.Printf("Could not exit minipool %s: %s. The node daemon will try again.\n", mp.MinipoolAddress.Hex(), mp.LastError)
+		}
+	}
+	fmt.Println()
+	fmt.Println("The migration plan has been saved. You can check on its progress at any time with `rocketpool minipool migrate status`.")
+
+	// Return
+	return nil
+
+}
+
+func getMigrationStatus() error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClient().WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get the plan
+	response, err := rp.GetMinipoolMigrationPlan()
+	if err != nil {
+		return err
+	}
+	if response.Plan == nil {
+		fmt.Println("There is no minipool migration in progress. You can start one with `rocketpool minipool migrate start`.")
+		return nil
+	}
+	printMigrationPlan(response.Plan)
+	return nil
+
+}
+
+func cancelMigration(yes bool) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClient().WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get the plan
+	response, err := rp.GetMinipoolMigrationPlan()
+	if err != nil {
+		return err
+	}
+	if response.Plan == nil {
+		fmt.Println("There is no minipool migration in progress.")
+		return nil
+	}
+
+	// Prompt for confirmation
+	color.YellowPrintln("Cancelling only stops the node daemon from closing minipools and depositing for them; exits that were already submitted can't be undone.")
+	if !(yes || prompt.Confirm("Are you sure you want to cancel the minipool migration?")) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Cancel the plan
+	if _, err := rp.CancelMinipoolMigrationPlan(); err != nil {
+		return err
+	}
+	fmt.Println("The minipool migration plan was cancelled.")
+	return nil
+
+}
+
+// Sort the minipools into the order they should be migrated in, which is also the order their megapool deposits are made.
+// Larger bonds come first since they free up the most ETH for the megapool, then validators with a lower balance since
+// they earn the least, then the ones that have been staking the longest.
+func rankMigrationCandidates(minipools []api.MinipoolDetails) {
+	sort.SliceStable(minipools, func(i, j int) bool {
+		if c := compareOptionalBigInt(minipools[i].Node.DepositBalance, minipools[j].Node.DepositBalance); c != 0 {
+			return c > 0
+		}
+		if c := compareOptionalBigInt(minipools[i].Validator.Balance, minipools[j].Validator.Balance); c != 0 {
+			return c < 0
+		}
+		return minipools[i].Status.StatusTime.Before(minipools[j].Status.StatusTime)
+	})
+}
+
+// Compare two values that may be missing, treating missing values as zero
+func compareOptionalBigInt(a *big.Int, b *big.Int) int {
+	if a == nil {
+		a = big.NewInt(0)
+	}
+	if b == nil {
+		b = big.NewInt(0)
+	}
+	return a.Cmp(b)
+}
+
+// Print the stage of every minipool in a migration plan
+func printMigrationPlan(plan *migration.Plan) {
+	counts := plan.StageCounts()
+	fmt.Printf("Migration started on %s, using express tickets: %t\n", plan.CreatedTime.Format(cliutils.TimeFormat), plan.UseExpressTickets)
+	fmt.Printf("%d waiting to exit, %d exiting, %d closed, %d deposited (of %d)\n", counts[migration.StageExitPending], counts[migration.StageExiting], counts[migration.StageClosed], counts[migration.StageDeposited], len(plan.Minipools))
+	fmt.Println()
+
+	for _, mp := range plan.Minipools {
+		fmt.Printf("Minipool %s\n", color.LightBlue(mp.MinipoolAddress.Hex()))
+		switch mp.Stage {
+		case migration.StageExitPending:
+			fmt.Println("\tWaiting for the node daemon to exit the validator")
+		case migration.StageExiting:
+			fmt.Println("\tExiting; the minipool will be closed once its balance has been withdrawn")
+		case migration.StageClosed:
+			fmt.Println("\tClosed; waiting to deposit the replacement megapool validator")
+		case migration.StageDeposited:
+			color.GreenPrintf("\tMigrated to megapool validator %s\n", mp.ValidatorPubkey.Hex())
+		}
+		if mp.CloseTxHash != (common.Hash{}) {
+			fmt.Printf("\tClose transaction: %s\n", mp.CloseTxHash.Hex())
+		}
+		if mp.DepositTxHash != (common.Hash{}) {
+			fmt.Printf("\tDeposit transaction: %s\n", mp.DepositTxHash.Hex())
+		}
+		if mp.LastError != "" {
+			color.YellowPrintf("\t%s\n", mp.LastError)
+		}
+		fmt.Printf("\tLast updated %s\n", mp.UpdatedTime.Format(cliutils.TimeFormat))
+	}
+}
@@ -0,0 +1,40 @@
+package minipool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/shared/math"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func newMigrationCandidate(address string, bond float64, balance float64, stakingSince time.Time) api.MinipoolDetails {
+	minipool := api.MinipoolDetails{Address: common.HexToAddress(address)}
+	minipool.Node.DepositBalance = math.EthToWei(bond)
+	minipool.Validator.Balance = math.EthToWei(balance)
+	minipool.Status.StatusTime = stakingSince
+	return minipool
+}
+
+func TestRankMigrationCandidates(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	minipools := []api.MinipoolDetails{
+		newMigrationCandidate("0x01", 8, 32.1, start),
+		newMigrationCandidate("0x02", 16, 32.1, start.Add(time.Hour)),
+		newMigrationCandidate("0x03", 16, 31.5, start.Add(2*time.Hour)),
+		newMigrationCandidate("0x04", 8, 32.1, start.Add(-time.Hour)),
+		{Address: common.HexToAddress("0x05")},
+	}
+
+	rankMigrationCandidates(minipools)
+
+	// Larger bonds first, then lower balances, then the oldest; missing details sort last
+	expected := []string{"0x03", "0x02", "0x04", "0x01", "0x05"}
+	for i, address := range expected {
+		if minipools[i].Address != common.HexToAddress(address) {
+			t.Errorf("expected minipool %s at position %d, got %s", address, i, minipools[i].Address.Hex())
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package node
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/goccy/go-json"
+	"github.com/mitchellh/go-homedir"
+
+	cliutils "github.com/rocket-pool/smartnode/rocketpool-cli/cli"
+	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/prompt"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func broadcastTransaction(path string, yes bool) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClient().WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Load the signed transaction
+	path, err = homedir.Expand(path)
+	if err != nil {
+		return fmt.Errorf("error expanding path: %w", err)
+	}
+	txBytes, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading %s: %w", path, err)
+	}
+	var tx api.SignedTransaction
+	if err := json.Unmarshal(txBytes, &tx); err != nil {
+		return fmt.Errorf("error parsing %s as a signed transaction: %w", path, err)
+	}
+	if tx.SerializedTx == "" {
+		return fmt.Errorf("%s does not contain a signed transaction", path)
+	}
+
+	// Prompt for confirmation
+	if prompt.Declined(yes, "Are you sure you want to submit transaction %s (nonce %d) from %s?", tx.TxHash.Hex(), tx.Nonce, tx.From.Hex()) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Submit it
+	response, err := rp.BroadcastTransaction(tx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Submitting transaction...\n")
+	cliutils.PrintTransactionHash(rp, response.TxHash)
+	if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
+		return err
+	}
+
+	// Log & return
+	fmt.Println("The transaction was successfully included in a block.")
+	return nil
+
+}
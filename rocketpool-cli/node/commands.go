@@ -354,6 +354,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 						Aliases: []string{"s"},
 						Usage:   "Automatically confirm swapping old RPL before staking",
 					},
+					cliutils.ExportUnsignedFlag(),
 				},
 				Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -379,6 +380,8 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 						}
 					}
 
+					cliutils.ApplyExportUnsignedFlag(c)
+
 					// Run
 					return nodeStakeRpl(c.String("amount"), c.Bool("swap"), c.Bool("yes"))
 
@@ -441,6 +444,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 						Aliases: []string{"y"},
 						Usage:   "Automatically confirm rewards claim",
 					},
+					cliutils.ExportUnsignedFlag(),
 				},
 				Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -449,6 +453,8 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 						return err
 					}
 
+					cliutils.ApplyExportUnsignedFlag(c)
+
 					// Run
 					return nodeClaimRewards(c.String("restake-amount"), c.Bool("yes"))
 
@@ -724,6 +730,31 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "broadcast",
+				Aliases:   []string{"bt"},
+				Usage:     "Submit a transaction that was exported with --export-unsigned and signed offline with `rocketpool wallet sign-tx`",
+				UsageText: "rocketpool node broadcast [-y] signed-tx-file",
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:    "yes",
+						Aliases: []string{"y"},
+						Usage:   "Automatically confirm submitting the transaction",
+					},
+				},
+				Action: func(ctx context.Context, c *cli.Command) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					return broadcastTransaction(c.Args().Get(0), c.Bool("yes"))
+
+				},
+			},
+
 			{
 				Name:      "send-message",
 				Usage:     "Send a zero-ETH transaction to the target address (or ENS) with the provided hex-encoded message as the data payload",
@@ -769,6 +800,111 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:    "watch",
+				Aliases: []string{"w"},
+				Usage:   "Monitor other nodes in read-only mode",
+				Commands: []*cli.Command{
+					{
+						Name:      "status",
+						Aliases:   []string{"s"},
+						Usage:     "Get the status of every node on the watch list",
+						UsageText: "rocketpool node watch status",
+						Action: func(ctx context.Context, c *cli.Command) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 0); err != nil {
+								return err
+							}
+
+							// Run
+							return getWatchedNodesStatus()
+
+						},
+					},
+
+					{
+						Name:      "add",
+						Aliases:   []string{"a"},
+						Usage:     "Add one or more nodes to the watch list, so the node daemon reports metrics and sends alerts for them",
+						UsageText: "rocketpool node watch add address [address...]",
+						Action: func(ctx context.Context, c *cli.Command) error {
+
+							// Validate args
+							if err := cliutils.ValidateMinArgCount(c, 1); err != nil {
+								return err
+							}
+
+							// Run
+							return addWatchedNodes(c.Args().Slice())
+
+						},
+					},
+
+					{
+						Name:      "remove",
+						Aliases:   []string{"r"},
+						Usage:     "Remove one or more nodes from the watch list",
+						UsageText: "rocketpool node watch remove address [address...]",
+						Action: func(ctx context.Context, c *cli.Command) error {
+
+							// Validate args
+							if err := cliutils.ValidateMinArgCount(c, 1); err != nil {
+								return err
+							}
+
+							// Run
+							return removeWatchedNodes(c.Args().Slice())
+
+						},
+					},
+				},
+			},
+
+			{
+				Name:      "exit-plan",
+				Aliases:   []string{"ep"},
+				Usage:     "Simulate exiting a set of megapool validators and minipools, showing the ETH returned, the timing and the effect on RPL rewards",
+				UsageText: "rocketpool node exit-plan [options]",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:    "validator-id",
+						Aliases: []string{"v"},
+						Usage:   "A comma-separated list of megapool validator IDs to exit, or 'all'",
+					},
+					&cli.StringFlag{
+						Name:    "minipool",
+						Aliases: []string{"m"},
+						Usage:   "A comma-separated list of minipool addresses to exit, or 'all'",
+					},
+				},
+				Action: func(ctx context.Context, c *cli.Command) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Validate flags
+					validatorIds := strings.TrimSpace(c.String("validator-id"))
+					if validatorIds != "" && !strings.EqualFold(validatorIds, "all") {
+						if _, err := cliutils.ValidateUint32s("validator ID", validatorIds); err != nil {
+							return err
+						}
+					}
+					minipools := strings.TrimSpace(c.String("minipool"))
+					if minipools != "" && !strings.EqualFold(minipools, "all") {
+						if _, err := cliutils.ValidateAddresses("minipool address", minipools); err != nil {
+							return err
+						}
+					}
+
+					// Run
+					return getExitPlan(validatorIds, minipools)
+
+				},
+			},
+
 			{
 				Name:      "provision-express-tickets",
 				Aliases:   []string{"pet"},
@@ -0,0 +1,102 @@
+package node
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/color"
+	"github.com/rocket-pool/smartnode/shared/math"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+)
+
+func getExitPlan(validatorIds string, minipools string) error {
+
+	if validatorIds == "" && minipools == "" {
+		return fmt.Errorf("Select the validators to simulate with --validator-id and/or --minipool.")
+	}
+
+	// Get RP client
+	rp, err := rocketpool.NewClient().WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get the plan
+	response, err := rp.GetNodeExitPlan(validatorIds, minipools)
+	if err != nil {
+		return err
+	}
+	plan := response.Plan
+
+	// Print the selected validators
+	color.GreenPrintln("=== Selected Validators ===")
+	for _, validator := range plan.Validators {
+		if validator.IsMegapool {
+			fmt.Printf("Megapool validator %d (%s): %.6f ETH on the Beacon Chain\n", validator.ValidatorId, validator.Pubkey.Hex(), formatEth(validator.BeaconBalance))
+		} else {
+			fmt.Printf("Minipool %s: %.6f ETH on the Beacon Chain, %.6f ETH to the node\n", validator.MinipoolAddress.Hex(), formatEth(validator.BeaconBalance), formatEth(validator.NodeShare))
+		}
+	}
+	fmt.Println()
+
+	// Print the expected ETH returned
+	color.GreenPrintln("=== Expected ETH Returned ===")
+	if plan.MegapoolCapitalReleased.Sign() > 0 || plan.MegapoolUserCapital.Sign() > 0 {
+		fmt.Printf("Megapool bond:                %.6f ETH -> %.6f ETH\n", formatEth(plan.MegapoolNodeBond), formatEth(plan.MegapoolBondAfterExit))
+		fmt.Printf("Bond released:                %.6f ETH\n", formatEth(plan.MegapoolCapitalReleased))
+		fmt.Printf("Rewards above 32 ETH:         %.6f ETH (%.6f ETH to the node)\n", formatEth(plan.MegapoolRewards), formatEth(plan.MegapoolNodeRewards))
+		if plan.MegapoolShortfall.Sign() > 0 {
+			color.YellowPrintf("Shortfall below 32 ETH:       %.6f ETH, covered by the node's bond\n", formatEth(plan.MegapoolShortfall))
+		}
+		if plan.MegapoolDebt.Sign() > 0 {
+			fmt.Printf("Outstanding debt deducted:    %.6f ETH\n", formatEth(plan.MegapoolDebt))
+		}
+		if plan.MegapoolDebtAfterExit.Sign() > 0 {
+			color.RedPrintf("Debt remaining after exit:    %.6f ETH\n", formatEth(plan.MegapoolDebtAfterExit))
+		}
+		fmt.Printf("From the megapool:            %.6f ETH\n", formatEth(plan.MegapoolEthReturned))
+	}
+	if plan.MinipoolEthReturned.Sign() > 0 {
+		fmt.Printf("From minipools:               %.6f ETH\n", formatEth(plan.MinipoolEthReturned))
+	}
+	fmt.Printf("Total:                        %s\n", color.GreenSprintf("%.6f ETH", formatEth(plan.TotalEthReturned)))
+	if plan.MegapoolRefundValue.Sign() > 0 {
+		fmt.Printf("The megapool also has a %.6f ETH refund that can be claimed separately.\n", formatEth(plan.MegapoolRefundValue))
+	}
+	fmt.Println()
+
+	// Print the timing
+	color.GreenPrintln("=== Timing ===")
+	fmt.Printf("Exit queue:                   %s\n", formatSeconds(plan.ExitQueueSeconds))
+	fmt.Printf("Withdrawability delay:        %s\n", formatSeconds(plan.WithdrawableDelaySeconds))
+	fmt.Printf("Withdrawal sweep:             up to %s\n", formatSeconds(plan.SweepSeconds))
+	fmt.Printf("Funds expected in about %s, and at the latest in %s.\n", color.LightBlue(formatSeconds(plan.ExpectedSeconds)), formatSeconds(plan.LatestSeconds))
+	fmt.Println()
+
+	// Print the effect on RPL rewards
+	color.GreenPrintln("=== RPL Rewards Eligibility ===")
+	fmt.Printf("Eligible RPL stake:           %.6f RPL\n", formatEth(plan.RplStake))
+	fmt.Printf("Eligible borrowed ETH:        %.6f ETH -> %.6f ETH\n", formatEth(plan.EligibleBorrowedEthBefore), formatEth(plan.EligibleBorrowedEthAfter))
+	fmt.Printf("RPL stake / borrowed ETH:     %.2f%% -> %.2f%%\n", formatEth(plan.RplPercentOfBorrowedEthBefore), formatEth(plan.RplPercentOfBorrowedEthAfter))
+	fmt.Printf("Node weight:                  %.6f -> %.6f\n", formatEth(plan.NodeWeightBefore), formatEth(plan.NodeWeightAfter))
+	if plan.RplStake.Sign() > 0 && plan.EligibleBorrowedEthAfter.Sign() == 0 {
+		color.YellowPrintln("The node would have no borrowed ETH left, so its staked RPL would no longer earn RPL rewards.")
+	}
+	return nil
+
+}
+
+// Convert a wei amount to ETH for display
+func formatEth(wei *big.Int) float64 {
+	if wei == nil {
+		return 0
+	}
+	return math.RoundDown(math.WeiToEth(wei), 6)
+}
+
+// Format a number of seconds for display
+func formatSeconds(seconds uint64) string {
+	return (time.Duration(seconds) * time.Second).Round(time.Minute).String()
+}
@@ -0,0 +1,162 @@
+package node
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cliutils "github.com/rocket-pool/smartnode/rocketpool-cli/cli"
+	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/color"
+	"github.com/rocket-pool/smartnode/shared/math"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func addWatchedNodes(addressesOrENS []string) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClient().WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	addresses, err := resolveNodeAddresses(rp, addressesOrENS)
+	if err != nil {
+		return err
+	}
+
+	// Add the nodes
+	response, err := rp.WatchNodes(addresses)
+	if err != nil {
+		return err
+	}
+	if len(response.AddedNodes) == 0 {
+		fmt.Println("All of those nodes were already on the watch list.")
+	}
+	for _, address := range response.AddedNodes {
+		fmt.Printf("Added %s to the watch list.\n", color.LightBlue(address.Hex()))
+	}
+	fmt.Printf("%d node(s) are being watched. The node daemon will start reporting on them during its next update.\n", len(response.WatchedNodes))
+	return nil
+
+}
+
+func removeWatchedNodes(addressesOrENS []string) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClient().WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	addresses, err := resolveNodeAddresses(rp, addressesOrENS)
+	if err != nil {
+		return err
+	}
+
+	// Remove the nodes
+	response, err := rp.UnwatchNodes(addresses)
+	if err != nil {
+		return err
+	}
+	if len(response.RemovedNodes) == 0 {
+		fmt.Println("None of those nodes were on the watch list.")
+	}
+	for _, address := range response.RemovedNodes {
+		fmt.Printf("Removed %s from the watch list.\n", color.LightBlue(address.Hex()))
+	}
+	fmt.Printf("%d node(s) are being watched.\n", len(response.WatchedNodes))
+	return nil
+
+}
+
+func getWatchedNodesStatus() error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClient().WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get the status of the watched nodes
+	response, err := rp.WatchedNodesStatus()
+	if err != nil {
+		return err
+	}
+	if len(response.Nodes) == 0 {
+		fmt.Println("The watch list is empty. Add nodes to it with `rocketpool node watch add`.")
+		return nil
+	}
+
+	fmt.Printf("Status of %d watched node(s) as of block %d:\n\n", len(response.Nodes), response.ElBlockNumber)
+	for _, node := range response.Nodes {
+		printWatchedNodeStatus(node)
+	}
+	return nil
+
+}
+
+func printWatchedNodeStatus(node api.WatchedNodeStatus) {
+	color.GreenPrintln(node.NodeAddress.Hex())
+	if !node.Registered {
+		color.YellowPrintln("This address is not registered with Rocket Pool.")
+		fmt.Println()
+		return
+	}
+
+	fmt.Printf("Timezone: %s\n", node.TimezoneLocation)
+	fmt.Printf("Withdrawal address: %s\n", color.LightBlue(node.WithdrawalAddress.Hex()))
+	fmt.Printf("Wallet balance: %.6f ETH and %.6f RPL\n", math.RoundDown(math.WeiToEth(node.EthBalance), 6), math.RoundDown(math.WeiToEth(node.RplBalance), 6))
+	fmt.Printf("RPL staked: %.6f RPL\n", math.RoundDown(math.WeiToEth(node.RplStake), 6))
+	fmt.Printf("ETH bonded: %.6f ETH, borrowed: %.6f ETH\n", math.RoundDown(math.WeiToEth(node.EthBonded), 6), math.RoundDown(math.WeiToEth(node.EthBorrowed), 6))
+	if node.SmoothingPoolRegistered {
+		fmt.Println("Smoothing Pool: opted in")
+	} else {
+		fmt.Println("Smoothing Pool: opted out")
+	}
+	fmt.Printf("Fee distributor share: %.6f ETH\n", math.RoundDown(math.WeiToEth(node.FeeDistributorShare), 6))
+	fmt.Printf("Unclaimed rewards: %.6f RPL and %.6f ETH\n", math.RoundDown(math.WeiToEth(node.UnclaimedRplRewards), 6), math.RoundDown(math.WeiToEth(node.UnclaimedEthRewards), 6))
+	if len(node.MissingRewardsIntervals) > 0 {
+		color.YellowPrintf("The rewards files for interval(s) %v haven't been downloaded, so their rewards aren't included.\n", node.MissingRewardsIntervals)
+	}
+	fmt.Printf("Minipools: %d\n", node.MinipoolCount)
+	if node.MegapoolDeployed {
+		fmt.Printf("Megapool: %s with %d validator(s)\n", color.LightBlue(node.MegapoolAddress.Hex()), node.MegapoolValidatorCount)
+		fmt.Printf("Megapool pending rewards: %.6f ETH, refund: %.6f ETH\n", math.RoundDown(math.WeiToEth(node.MegapoolPendingRewards), 6), math.RoundDown(math.WeiToEth(node.MegapoolRefundValue), 6))
+		if node.MegapoolDebt.Sign() > 0 {
+			color.YellowPrintf("Megapool debt: %.6f ETH\n", math.RoundDown(math.WeiToEth(node.MegapoolDebt), 6))
+		}
+	}
+
+	v := node.Validators
+	fmt.Printf("Validators: %d active, %d pending, %d exiting, %d exited, %d not yet on the Beacon Chain\n", v.Active, v.Pending, v.Exiting, v.Exited, v.NotOnBeacon)
+	if v.Slashed > 0 {
+		color.RedPrintf("%d validator(s) have been slashed!\n", v.Slashed)
+	}
+	fmt.Println()
+}
+
+// Resolve a list of node addresses, any of which may be ENS names
+func resolveNodeAddresses(rp *rocketpool.Client, addressesOrENS []string) ([]common.Address, error) {
+	addresses := make([]common.Address, 0, len(addressesOrENS))
+	for _, addressOrENS := range addressesOrENS {
+		if strings.Contains(addressOrENS, ".") {
+			response, err := rp.ResolveEnsName(addressOrENS)
+			if err != nil {
+				return nil, err
+			}
+			addresses = append(addresses, response.Address)
+			continue
+		}
+		address, err := cliutils.ValidateAddress("address", addressOrENS)
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses, nil
+}
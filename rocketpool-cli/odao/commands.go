@@ -52,6 +52,37 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "duties",
+				Aliases:   []string{"du"},
+				Usage:     "Show which balances, prices, rewards tree and scrub rounds the node submitted on time, late or missed, and whether its submissions matched the oracle DAO's consensus",
+				UsageText: "rocketpool odao duties [options]",
+				Flags: []cli.Flag{
+					&cli.Uint64Flag{
+						Name:    "lookback-days",
+						Aliases: []string{"l"},
+						Usage:   "The number of days of submissions to report on",
+						Value:   30,
+					},
+					&cli.BoolFlag{
+						Name:    "all",
+						Aliases: []string{"a"},
+						Usage:   "List every round, not just the ones that were late, missed or didn't match consensus",
+					},
+				},
+				Action: func(ctx context.Context, c *cli.Command) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return getDuties(c.Uint64("lookback-days"), c.Bool("all"))
+
+				},
+			},
+
 			{
 				Name:      "member-settings",
 				Aliases:   []string{"b"},
@@ -570,7 +601,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 					{
 						Name:      "details",
 						Aliases:   []string{"d"},
-						Usage:     "View proposal details",
+						Usage:     "View proposal details, including the changes its payload makes to the current settings or contracts",
 						UsageText: "rocketpool odao proposals details proposal-id",
 						Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -0,0 +1,114 @@
+package odao
+
+import (
+	"fmt"
+
+	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/color"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getDuties(lookbackDays uint64, showAll bool) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClient().WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	if lookbackDays == 0 || lookbackDays > 365 {
+		return fmt.Errorf("lookback days must be between 1 and 365")
+	}
+
+	// Get the duty report
+	response, err := rp.TNDAODuties(lookbackDays)
+	if err != nil {
+		return err
+	}
+	if !response.IsMember {
+		fmt.Println("The node is not a member of the oracle DAO.")
+		return nil
+	}
+	duties := response.Duties
+
+	// Print the summaries
+	fmt.Printf("oDAO duties of %s over the last %d days (blocks %d to %d):\n\n", duties.Member.Hex(), duties.LookbackDays, duties.FromBlock, duties.ToBlock)
+	for _, summary := range duties.Summaries {
+		color.GreenPrintf("=== %s ===\n", getDutyName(summary.Duty))
+		if summary.Rounds == 0 {
+			fmt.Println("No rounds in this period.")
+			fmt.Println()
+			continue
+		}
+		fmt.Printf("Rounds:                  %d\n", summary.Rounds)
+		fmt.Printf("Submitted on time:       %d\n", summary.OnTime)
+		printDutyCount("Submitted late:          %d\n", summary.Late, color.YellowPrintf)
+		printDutyCount("Missed:                  %d\n", summary.Missed, color.RedPrintf)
+		printDutyCount("Not finalized:           %d\n", summary.Unfinalized, color.YellowPrintf)
+		printDutyCount("Didn't match consensus:  %d\n", summary.Mismatched, color.RedPrintf)
+		fmt.Println()
+	}
+
+	// Print the rounds that need attention, or all of them
+	rounds := []api.TNDAODutyRound{}
+	for _, round := range duties.Rounds {
+		if showAll || round.Status != api.TNDAODutyStatus_OnTime || !round.MatchedConsensus {
+			rounds = append(rounds, round)
+		}
+	}
+	if len(rounds) == 0 {
+		fmt.Println("Every round in this period was submitted on time and matched consensus.")
+		return nil
+	}
+	if showAll {
+		color.GreenPrintln("=== Rounds ===")
+	} else {
+		color.GreenPrintln("=== Rounds Needing Attention ===")
+	}
+	for _, round := range rounds {
+		line := fmt.Sprintf("%-9s %-44s %-12s", round.Duty, round.Round, round.Status)
+		if round.SubmissionBlock != 0 {
+			line += fmt.Sprintf(" submitted in block %d", round.SubmissionBlock)
+		}
+		if round.ConsensusBlock != 0 {
+			line += fmt.Sprintf(", consensus in block %d", round.ConsensusBlock)
+		}
+		if round.SubmissionBlock != 0 && round.ConsensusBlock != 0 && !round.MatchedConsensus {
+			line += ", didn't match consensus"
+		}
+		switch {
+		case round.Status == api.TNDAODutyStatus_Missed || (round.SubmissionBlock != 0 && round.ConsensusBlock != 0 && !round.MatchedConsensus):
+			line = color.Red(line)
+		case round.Status != api.TNDAODutyStatus_OnTime:
+			line = color.Yellow(line)
+		}
+		fmt.Println(line)
+	}
+	return nil
+
+}
+
+// Get the display name of a duty
+func getDutyName(duty api.TNDAODuty) string {
+	switch duty {
+	case api.TNDAODuty_Balances:
+		return "Network Balances"
+	case api.TNDAODuty_Prices:
+		return "RPL Prices"
+	case api.TNDAODuty_Rewards:
+		return "Rewards Trees"
+	case api.TNDAODuty_Scrub:
+		return "Minipool Scrubs"
+	}
+	return string(duty)
+}
+
+// Print a count, highlighted if it isn't zero
+func printDutyCount(format string, count int, highlight func(string, ...any)) {
+	if count > 0 {
+		highlight(format, count)
+		return
+	}
+	fmt.Printf(format, count)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/rocket-pool/smartnode/bindings/types"
 
 	cliutils "github.com/rocket-pool/smartnode/rocketpool-cli/cli"
+	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/color"
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
 )
 
@@ -89,6 +90,16 @@ func getProposals(stateFilter string) error {
 			if !printed {
 				fmt.Printf("%d: %s - Proposed by: %s (no longer on the ODAO)\n", proposal.ID, proposal.Message, proposal.ProposerAddress)
 			}
+
+			// Summarize what open proposals would change; finished ones already show their result in the current values
+			if proposal.State == types.Pending || proposal.State == types.Active || proposal.State == types.Succeeded {
+				diff, err := rp.TNDAOProposalDiff(proposal.ID)
+				if err != nil {
+					color.YellowPrintf("    Unable to decode the proposal payload: %s\n", err.Error())
+				} else {
+					fmt.Printf("    %s\n", diff.Diff.Summary())
+				}
+			}
 		}
 
 		count += len(proposals)
@@ -183,5 +194,14 @@ func getProposal(id uint64) error {
 		fmt.Printf("Node has voted:       no\n")
 	}
 
+	// Decoded payload, compared with the current settings or contracts
+	diff, err := rp.TNDAOProposalDiff(id)
+	if err != nil {
+		color.YellowPrintf("\nUnable to decode the proposal payload: %s\n", err.Error())
+		return nil
+	}
+	fmt.Println()
+	cliutils.PrintDAOProposalDiff(diff.Diff)
+
 	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/urfave/cli/v3"
 
 	"github.com/rocket-pool/smartnode/bindings/settings/protocol"
@@ -127,6 +128,67 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:    "snapshot",
+				Aliases: []string{"sn"},
+				Usage:   "Take part in Snapshot signalling votes",
+				Commands: []*cli.Command{
+
+					{
+						Name:      "vote",
+						Aliases:   []string{"v"},
+						Usage:     "Vote on an active Snapshot proposal with the node wallet, or with the node's signalling address",
+						UsageText: "rocketpool pdao snapshot vote proposal-id [options]",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:    "choice",
+								Aliases: []string{"c"},
+								Usage:   "The number of the choice to vote for, starting at 1 (will prompt if not provided)",
+							},
+							&cli.StringFlag{
+								Name:    "reason",
+								Aliases: []string{"r"},
+								Usage:   "An optional reason to publish with the vote",
+							},
+							&cli.StringFlag{
+								Name:    "signalling-key",
+								Aliases: []string{"k"},
+								Usage:   "The path to a file containing the hex-encoded private key of the node's signalling address; if provided, the vote is signed with it instead of the node wallet",
+							},
+							&cli.BoolFlag{
+								Name:    "yes",
+								Aliases: []string{"y"},
+								Usage:   "Automatically confirm the vote",
+							},
+						},
+						Action: func(ctx context.Context, c *cli.Command) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 1); err != nil {
+								return err
+							}
+							proposalID, err := cliutils.ValidateTxHash("proposal-id", c.Args().Get(0))
+							if err != nil {
+								return err
+							}
+
+							// Validate flags
+							choice := uint32(0)
+							if c.String("choice") != "" {
+								choice, err = cliutils.ValidatePositiveUint32("choice", c.String("choice"))
+								if err != nil {
+									return err
+								}
+							}
+
+							// Run
+							return snapshotVote(proposalID, choice, c.String("reason"), c.String("signalling-key"), c.Bool("yes"))
+
+						},
+					},
+				},
+			},
+
 			{
 				Name:      "set-voting-delegate",
 				Aliases:   []string{"svd"},
@@ -218,6 +280,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 								Aliases: []string{"p"},
 								Usage:   "The Protocol DAO's rewards allocation (a percentage from 0 to 1 if '--raw' is not set)",
 							},
+							&cli.BoolFlag{
+								Name:  "simulate",
+								Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+							},
 						},
 						Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -227,7 +293,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 							}
 
 							// Run
-							return proposeRewardsPercentages(c.Bool("raw"), c.String("node"), c.String("odao"), c.String("pdao"), c.Bool("yes"))
+							return proposeRewardsPercentages(c.Bool("raw"), c.String("node"), c.String("odao"), c.String("pdao"), c.Bool("yes"), c.Bool("simulate"))
 
 						},
 					},
@@ -560,6 +626,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -573,7 +643,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingAuctionIsCreateLotEnabled(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingAuctionIsCreateLotEnabled(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -593,6 +663,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -606,7 +680,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingAuctionIsBidOnLotEnabled(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingAuctionIsBidOnLotEnabled(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -630,6 +704,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -643,7 +721,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingAuctionLotMinimumEthValue(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingAuctionLotMinimumEthValue(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -667,6 +745,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -680,7 +762,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingAuctionLotMaximumEthValue(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingAuctionLotMaximumEthValue(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -700,6 +782,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -713,7 +799,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingAuctionLotDuration(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingAuctionLotDuration(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -737,6 +823,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -750,7 +840,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingAuctionLotStartingPriceRatio(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingAuctionLotStartingPriceRatio(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -774,6 +864,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -787,7 +881,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingAuctionLotReservePriceRatio(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingAuctionLotReservePriceRatio(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -815,6 +909,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -828,7 +926,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingDepositIsDepositingEnabled(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingDepositIsDepositingEnabled(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -848,6 +946,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -861,7 +963,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingDepositAreDepositAssignmentsEnabled(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingDepositAreDepositAssignmentsEnabled(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -885,6 +987,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -898,7 +1004,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingDepositMinimumDeposit(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingDepositMinimumDeposit(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -922,6 +1028,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -935,7 +1045,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingDepositMaximumDepositPoolSize(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingDepositMaximumDepositPoolSize(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -955,6 +1065,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -968,7 +1082,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingDepositMaximumAssignmentsPerDeposit(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingDepositMaximumAssignmentsPerDeposit(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -988,6 +1102,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -1001,7 +1119,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingDepositMaximumSocialisedAssignmentsPerDeposit(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingDepositMaximumSocialisedAssignmentsPerDeposit(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -1025,6 +1143,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -1038,7 +1160,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingDepositDepositFee(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingDepositDepositFee(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -1058,6 +1180,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 											// Validate args
@@ -1070,7 +1196,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingDepositExpressQueueRate(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingDepositExpressQueueRate(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -1090,6 +1216,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 											// Validate args
@@ -1102,7 +1232,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingDepositExpressQueueTicketsBaseProvision(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingDepositExpressQueueTicketsBaseProvision(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -1130,6 +1260,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -1143,7 +1277,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingMinipoolIsSubmitWithdrawableEnabled(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingMinipoolIsSubmitWithdrawableEnabled(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -1163,6 +1297,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -1176,7 +1314,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingMinipoolLaunchTimeout(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingMinipoolLaunchTimeout(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -1196,6 +1334,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -1209,7 +1351,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingMinipoolIsBondReductionEnabled(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingMinipoolIsBondReductionEnabled(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -1229,6 +1371,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -1242,7 +1388,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingMinipoolMaximumCount(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingMinipoolMaximumCount(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -1262,6 +1408,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -1275,7 +1425,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingMinipoolUserDistributeWindowStart(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingMinipoolUserDistributeWindowStart(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -1295,6 +1445,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -1308,7 +1462,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingMinipoolUserDistributeWindowLength(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingMinipoolUserDistributeWindowLength(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -1340,6 +1494,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -1353,7 +1511,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingNetworkOracleDaoConsensusThreshold(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingNetworkOracleDaoConsensusThreshold(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -1377,6 +1535,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -1390,7 +1552,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingNetworkNodePenaltyThreshold(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingNetworkNodePenaltyThreshold(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -1414,6 +1576,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -1427,7 +1593,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingNetworkPerPenaltyRate(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingNetworkPerPenaltyRate(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -1447,6 +1613,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -1460,7 +1630,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingNetworkIsSubmitBalancesEnabled(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingNetworkIsSubmitBalancesEnabled(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -1480,6 +1650,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -1493,7 +1667,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingNetworkSubmitBalancesFrequency(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingNetworkSubmitBalancesFrequency(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -1513,6 +1687,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -1526,7 +1704,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingNetworkIsSubmitPricesEnabled(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingNetworkIsSubmitPricesEnabled(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -1546,6 +1724,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -1559,7 +1741,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingNetworkSubmitPricesFrequency(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingNetworkSubmitPricesFrequency(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -1583,6 +1765,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -1596,7 +1782,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingNetworkMinimumNodeFee(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingNetworkMinimumNodeFee(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -1620,6 +1806,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -1633,7 +1823,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingNetworkTargetNodeFee(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingNetworkTargetNodeFee(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -1657,6 +1847,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -1670,7 +1864,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingNetworkMaximumNodeFee(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingNetworkMaximumNodeFee(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -1694,6 +1888,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -1707,7 +1905,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingNetworkNodeFeeDemandRange(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingNetworkNodeFeeDemandRange(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -1731,6 +1929,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -1744,7 +1946,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingNetworkTargetRethCollateralRate(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingNetworkTargetRethCollateralRate(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -1764,6 +1966,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -1777,7 +1983,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingNetworkIsSubmitRewardsEnabled(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingNetworkIsSubmitRewardsEnabled(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -1833,6 +2039,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -1846,7 +2056,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingNodeCommissionShare(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingNodeCommissionShare(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -1870,6 +2080,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -1883,7 +2097,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingNodeCommissionShareSecurityCouncilAdder(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingNodeCommissionShareSecurityCouncilAdder(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -1907,6 +2121,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -1920,7 +2138,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingVoterShare(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingVoterShare(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -1944,6 +2162,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -1957,7 +2179,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingPDAOShare(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingPDAOShare(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -1981,6 +2203,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -1994,7 +2220,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeMaxNodeShareSecurityCouncilAdder(value, c.Bool("yes"), c.String("to-json"))
+											return proposeMaxNodeShareSecurityCouncilAdder(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -2018,6 +2244,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -2031,7 +2261,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeMaxRethBalanceDelta(value, c.Bool("yes"), c.String("to-json"))
+											return proposeMaxRethBalanceDelta(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -2059,6 +2289,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -2072,7 +2306,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingNodeIsRegistrationEnabled(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingNodeIsRegistrationEnabled(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -2092,6 +2326,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -2105,7 +2343,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingNodeIsSmoothingPoolRegistrationEnabled(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingNodeIsSmoothingPoolRegistrationEnabled(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -2125,6 +2363,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -2138,7 +2380,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingNodeIsDepositingEnabled(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingNodeIsDepositingEnabled(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -2158,6 +2400,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -2171,7 +2417,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingNodeAreVacantMinipoolsEnabled(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingNodeAreVacantMinipoolsEnabled(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -2195,6 +2441,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -2208,7 +2458,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingNodeMinimumLegacyRplStake(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingNodeMinimumLegacyRplStake(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -2232,6 +2482,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -2245,7 +2499,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingReducedBond(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingReducedBond(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -2265,6 +2519,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -2278,7 +2536,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingNodeUnstakingPeriod(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingNodeUnstakingPeriod(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -2306,6 +2564,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -2319,7 +2581,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingProposalsVotePhase1Time(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingProposalsVotePhase1Time(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -2339,6 +2601,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -2352,7 +2618,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingProposalsVotePhase2Time(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingProposalsVotePhase2Time(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -2372,6 +2638,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -2385,7 +2655,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingProposalsVoteDelayTime(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingProposalsVoteDelayTime(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -2405,6 +2675,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -2418,7 +2692,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingProposalsExecuteTime(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingProposalsExecuteTime(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -2442,6 +2716,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -2455,7 +2733,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingProposalsProposalBond(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingProposalsProposalBond(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -2479,6 +2757,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -2492,7 +2774,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingProposalsChallengeBond(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingProposalsChallengeBond(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -2512,6 +2794,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -2525,7 +2811,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingProposalsChallengePeriod(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingProposalsChallengePeriod(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -2549,6 +2835,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -2562,7 +2852,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingProposalsQuorum(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingProposalsQuorum(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -2586,6 +2876,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -2599,7 +2893,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingProposalsVetoQuorum(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingProposalsVetoQuorum(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -2619,6 +2913,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -2632,7 +2930,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingProposalsMaxBlockAge(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingProposalsMaxBlockAge(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -2660,6 +2958,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -2673,7 +2975,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingRewardsIntervalPeriods(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingRewardsIntervalPeriods(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -2705,6 +3007,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -2718,7 +3024,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingSecurityMembersQuorum(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingSecurityMembersQuorum(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -2738,6 +3044,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -2751,7 +3061,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingSecurityMembersLeaveTime(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingSecurityMembersLeaveTime(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -2771,6 +3081,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -2784,7 +3098,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingSecurityProposalVoteTime(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingSecurityProposalVoteTime(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -2804,6 +3118,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -2817,7 +3135,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingSecurityProposalExecuteTime(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingSecurityProposalExecuteTime(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -2837,6 +3155,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -2850,7 +3172,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingSecurityProposalActionTime(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingSecurityProposalActionTime(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -2878,6 +3200,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -2891,7 +3217,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingMegapoolTimeBeforeDissolve(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingMegapoolTimeBeforeDissolve(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -2915,6 +3241,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -2928,7 +3258,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingMaximumMegapoolEthPenalty(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingMaximumMegapoolEthPenalty(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -2948,6 +3278,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -2961,7 +3295,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingMegapoolNotifyThreshold(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingMegapoolNotifyThreshold(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -2985,6 +3319,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -2998,7 +3336,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingMegapoolLateNotifyFine(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingMegapoolLateNotifyFine(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -3018,6 +3356,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -3031,7 +3373,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingMegapoolDissolvePenalty(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingMegapoolDissolvePenalty(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -3051,6 +3393,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 											// Validate args
@@ -3063,7 +3409,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingMegapoolUserDistributeDelay(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingMegapoolUserDistributeDelay(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -3083,6 +3429,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 											// Validate args
@@ -3095,7 +3445,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingMegapoolUserDistributeDelayWithShortfall(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingMegapoolUserDistributeDelayWithShortfall(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -3119,6 +3469,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 												Name:  "to-json",
 												Usage: "Write this setting to a JSON file instead of submitting a proposal (creates the file or appends to it)",
 											},
+											&cli.BoolFlag{
+												Name:  "simulate",
+												Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+											},
 										},
 										Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -3132,7 +3486,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 											}
 
 											// Run
-											return proposeSettingPenaltyThreshold(value, c.Bool("yes"), c.String("to-json"))
+											return proposeSettingPenaltyThreshold(value, c.Bool("yes"), c.String("to-json"), c.Bool("simulate"))
 
 										},
 									},
@@ -3162,6 +3516,10 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 								Aliases: []string{"m"},
 								Usage:   "A custom proposal message (no blank spaces). If omitted, you will be prompted.",
 							},
+							&cli.BoolFlag{
+								Name:  "simulate",
+								Usage: "Simulate the proposal passing against the current chain state and report the result instead of submitting it",
+							},
 						},
 						Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -3171,7 +3529,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 							}
 
 							// Run
-							return submitBatch(c.String("file"), c.String("message"), c.Bool("yes"))
+							return submitBatch(c.String("file"), c.String("message"), c.Bool("yes"), c.Bool("simulate"))
 
 						},
 					},
@@ -3254,6 +3612,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 								Aliases: []string{"y"},
 								Usage:   "Automatically confirm all interactive questions",
 							},
+							cliutils.ExportUnsignedFlag(),
 						},
 						Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -3262,6 +3621,8 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 								return err
 							}
 
+							cliutils.ApplyExportUnsignedFlag(c)
+
 							// Run
 							return voteOnProposal(c.String("proposal"), c.String("vote-direction"), c.Bool("yes"))
 
@@ -3367,6 +3728,121 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 					},
 				},
 			},
+
+			{
+				Name:      "voting-power",
+				Aliases:   []string{"vp"},
+				Usage:     "Show the voting power distribution at a proposal's target block, including delegations and the voting power each open proposal hasn't received yet",
+				UsageText: "rocketpool pdao voting-power [--limit count] [--delegations] proposal-id",
+				Flags: []cli.Flag{
+					&cli.UintFlag{
+						Name:    "limit",
+						Aliases: []string{"l"},
+						Usage:   "The number of nodes with the most voting power to show; 0 shows every node",
+						Value:   20,
+					},
+					&cli.BoolFlag{
+						Name:    "delegations",
+						Aliases: []string{"d"},
+						Usage:   "List the nodes delegating to each node shown",
+					},
+				},
+				Action: func(ctx context.Context, c *cli.Command) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					proposalID, err := cliutils.ValidatePositiveUint("proposal-id", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					return getVotingPower(proposalID, uint64(c.Uint("limit")), c.Bool("delegations"))
+
+				},
+			},
+
+			{
+				Name:    "tree",
+				Aliases: []string{"t"},
+				Usage:   "Export and verify the voting trees used for Protocol DAO proposals",
+				Commands: []*cli.Command{
+
+					{
+						Name:      "export",
+						Aliases:   []string{"e"},
+						Usage:     "Export the network voting tree and pollard for a block, and optionally a node's voting tree, as JSON",
+						UsageText: "rocketpool pdao tree export --block block-number [--node node-address] [--output path]",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:    "block",
+								Aliases: []string{"b"},
+								Usage:   "The target block of the proposal to export the voting tree for",
+							},
+							&cli.StringFlag{
+								Name:    "node",
+								Aliases: []string{"n"},
+								Usage:   "The address of a node whose voting tree should be included in the export",
+							},
+							&cli.StringFlag{
+								Name:    "output",
+								Aliases: []string{"o"},
+								Usage:   "The file to write the export to; if not set, it will be printed to the terminal",
+							},
+						},
+						Action: func(ctx context.Context, c *cli.Command) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 0); err != nil {
+								return err
+							}
+							if c.String("block") == "" {
+								return fmt.Errorf("the --block flag is required")
+							}
+							blockNumber, err := cliutils.ValidateUint32("block", c.String("block"))
+							if err != nil {
+								return err
+							}
+							var nodeAddress *common.Address
+							if c.String("node") != "" {
+								address, err := cliutils.ValidateAddress("node", c.String("node"))
+								if err != nil {
+									return err
+								}
+								nodeAddress = &address
+							}
+
+							// Run
+							return exportVotingTree(blockNumber, nodeAddress, c.String("output"))
+
+						},
+					},
+
+					{
+						Name:      "verify",
+						Aliases:   []string{"v"},
+						Usage:     "Check the roots submitted for a proposal against the voting tree computed from this node's voting info snapshot",
+						UsageText: "rocketpool pdao tree verify proposal-id",
+						Action: func(ctx context.Context, c *cli.Command) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 1); err != nil {
+								return err
+							}
+							proposalID, err := cliutils.ValidatePositiveUint("proposal-id", c.Args().Get(0))
+							if err != nil {
+								return err
+							}
+
+							// Run
+							return verifyVotingTree(proposalID)
+
+						},
+					},
+				},
+			},
 		},
 	})
 }
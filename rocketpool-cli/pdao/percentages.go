@@ -31,7 +31,7 @@ func getRewardsPercentages() error {
 	return nil
 }
 
-func proposeRewardsPercentages(rawEnabled bool, nodeFlag string, odaoFlag string, pdaoFlag string, yes bool) error {
+func proposeRewardsPercentages(rawEnabled bool, nodeFlag string, odaoFlag string, pdaoFlag string, yes bool, simulate bool) error {
 	// Get RP client
 	rp, err := rocketpool.NewClient().WithReady()
 	if err != nil {
@@ -81,6 +81,16 @@ func proposeRewardsPercentages(rawEnabled bool, nodeFlag string, odaoFlag string
 		return err
 	}
 
+	// Simulate the proposal passing instead of submitting it
+	if simulate {
+		response, err := rp.PDAOSimulateRewardsPercentages(nodePercent, odaoPercent, pdaoPercent)
+		if err != nil {
+			return err
+		}
+		printSimulation(response)
+		return nil
+	}
+
 	// Check submissions
 	canResponse, err := rp.PDAOCanProposeRewardsPercentages(nodePercent, odaoPercent, pdaoPercent)
 	if err != nil {
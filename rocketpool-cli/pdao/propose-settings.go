@@ -11,375 +11,379 @@ import (
 	"github.com/rocket-pool/smartnode/shared/math"
 	"github.com/rocket-pool/smartnode/shared/services/gas"
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
 
 	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/prompt"
 )
 
-func proposeSettingAuctionIsCreateLotEnabled(value bool, yes bool, toJson string) error {
+func proposeSettingAuctionIsCreateLotEnabled(value bool, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(value)
-	return proposeSetting(protocol.AuctionSettingsContractName, protocol.CreateLotEnabledSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.AuctionSettingsContractName, protocol.CreateLotEnabledSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingAuctionIsBidOnLotEnabled(value bool, yes bool, toJson string) error {
+func proposeSettingAuctionIsBidOnLotEnabled(value bool, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(value)
-	return proposeSetting(protocol.AuctionSettingsContractName, protocol.BidOnLotEnabledSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.AuctionSettingsContractName, protocol.BidOnLotEnabledSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingAuctionLotMinimumEthValue(value *big.Int, yes bool, toJson string) error {
+func proposeSettingAuctionLotMinimumEthValue(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.AuctionSettingsContractName, protocol.LotMinimumEthValueSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.AuctionSettingsContractName, protocol.LotMinimumEthValueSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingAuctionLotMaximumEthValue(value *big.Int, yes bool, toJson string) error {
+func proposeSettingAuctionLotMaximumEthValue(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.AuctionSettingsContractName, protocol.LotMaximumEthValueSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.AuctionSettingsContractName, protocol.LotMaximumEthValueSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingAuctionLotDuration(value time.Duration, yes bool, toJson string) error {
+func proposeSettingAuctionLotDuration(value time.Duration, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(protocol.AuctionSettingsContractName, protocol.LotDurationSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.AuctionSettingsContractName, protocol.LotDurationSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingAuctionLotStartingPriceRatio(value *big.Int, yes bool, toJson string) error {
+func proposeSettingAuctionLotStartingPriceRatio(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.AuctionSettingsContractName, protocol.LotStartingPriceRatioSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.AuctionSettingsContractName, protocol.LotStartingPriceRatioSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingAuctionLotReservePriceRatio(value *big.Int, yes bool, toJson string) error {
+func proposeSettingAuctionLotReservePriceRatio(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.AuctionSettingsContractName, protocol.LotReservePriceRatioSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.AuctionSettingsContractName, protocol.LotReservePriceRatioSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingDepositIsDepositingEnabled(value bool, yes bool, toJson string) error {
+func proposeSettingDepositIsDepositingEnabled(value bool, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(value)
-	return proposeSetting(protocol.DepositSettingsContractName, protocol.DepositEnabledSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.DepositSettingsContractName, protocol.DepositEnabledSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingDepositAreDepositAssignmentsEnabled(value bool, yes bool, toJson string) error {
+func proposeSettingDepositAreDepositAssignmentsEnabled(value bool, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(value)
-	return proposeSetting(protocol.DepositSettingsContractName, protocol.AssignDepositsEnabledSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.DepositSettingsContractName, protocol.AssignDepositsEnabledSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingDepositMinimumDeposit(value *big.Int, yes bool, toJson string) error {
+func proposeSettingDepositMinimumDeposit(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.DepositSettingsContractName, protocol.MinimumDepositSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.DepositSettingsContractName, protocol.MinimumDepositSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingDepositMaximumDepositPoolSize(value *big.Int, yes bool, toJson string) error {
+func proposeSettingDepositMaximumDepositPoolSize(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.DepositSettingsContractName, protocol.MaximumDepositPoolSizeSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.DepositSettingsContractName, protocol.MaximumDepositPoolSizeSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingDepositMaximumAssignmentsPerDeposit(value uint64, yes bool, toJson string) error {
+func proposeSettingDepositMaximumAssignmentsPerDeposit(value uint64, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(value)
-	return proposeSetting(protocol.DepositSettingsContractName, protocol.MaximumDepositAssignmentsSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.DepositSettingsContractName, protocol.MaximumDepositAssignmentsSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingDepositMaximumSocialisedAssignmentsPerDeposit(value uint64, yes bool, toJson string) error {
+func proposeSettingDepositMaximumSocialisedAssignmentsPerDeposit(value uint64, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(value)
-	return proposeSetting(protocol.DepositSettingsContractName, protocol.MaximumSocializedDepositAssignmentsSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.DepositSettingsContractName, protocol.MaximumSocializedDepositAssignmentsSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingDepositExpressQueueRate(value uint64, yes bool, toJson string) error {
+func proposeSettingDepositExpressQueueRate(value uint64, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(value)
-	return proposeSetting(protocol.DepositSettingsContractName, protocol.ExpressQueueRatePath, trueValue, yes, toJson)
+	return proposeSetting(protocol.DepositSettingsContractName, protocol.ExpressQueueRatePath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingDepositExpressQueueTicketsBaseProvision(value uint64, yes bool, toJson string) error {
+func proposeSettingDepositExpressQueueTicketsBaseProvision(value uint64, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(value)
-	return proposeSetting(protocol.DepositSettingsContractName, protocol.ExpressQueueTicketsBaseProvisionPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.DepositSettingsContractName, protocol.ExpressQueueTicketsBaseProvisionPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingDepositDepositFee(value *big.Int, yes bool, toJson string) error {
+func proposeSettingDepositDepositFee(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.DepositSettingsContractName, protocol.DepositFeeSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.DepositSettingsContractName, protocol.DepositFeeSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingMinipoolIsSubmitWithdrawableEnabled(value bool, yes bool, toJson string) error {
+func proposeSettingMinipoolIsSubmitWithdrawableEnabled(value bool, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(value)
-	return proposeSetting(protocol.MinipoolSettingsContractName, protocol.MinipoolSubmitWithdrawableEnabledSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.MinipoolSettingsContractName, protocol.MinipoolSubmitWithdrawableEnabledSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingMinipoolLaunchTimeout(value time.Duration, yes bool, toJson string) error {
+func proposeSettingMinipoolLaunchTimeout(value time.Duration, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(protocol.MinipoolSettingsContractName, protocol.MinipoolLaunchTimeoutSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.MinipoolSettingsContractName, protocol.MinipoolLaunchTimeoutSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingMinipoolIsBondReductionEnabled(value bool, yes bool, toJson string) error {
+func proposeSettingMinipoolIsBondReductionEnabled(value bool, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(value)
-	return proposeSetting(protocol.MinipoolSettingsContractName, protocol.BondReductionEnabledSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.MinipoolSettingsContractName, protocol.BondReductionEnabledSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingMinipoolMaximumCount(value uint64, yes bool, toJson string) error {
+func proposeSettingMinipoolMaximumCount(value uint64, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(value)
-	return proposeSetting(protocol.MinipoolSettingsContractName, protocol.MaximumMinipoolCountSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.MinipoolSettingsContractName, protocol.MaximumMinipoolCountSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingMinipoolUserDistributeWindowStart(value time.Duration, yes bool, toJson string) error {
+func proposeSettingMinipoolUserDistributeWindowStart(value time.Duration, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(protocol.MinipoolSettingsContractName, protocol.MinipoolUserDistributeWindowStartSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.MinipoolSettingsContractName, protocol.MinipoolUserDistributeWindowStartSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingMinipoolUserDistributeWindowLength(value time.Duration, yes bool, toJson string) error {
+func proposeSettingMinipoolUserDistributeWindowLength(value time.Duration, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(protocol.MinipoolSettingsContractName, protocol.MinipoolUserDistributeWindowLengthSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.MinipoolSettingsContractName, protocol.MinipoolUserDistributeWindowLengthSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingNetworkOracleDaoConsensusThreshold(value *big.Int, yes bool, toJson string) error {
+func proposeSettingNetworkOracleDaoConsensusThreshold(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.NetworkSettingsContractName, protocol.NodeConsensusThresholdSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.NetworkSettingsContractName, protocol.NodeConsensusThresholdSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingNetworkNodePenaltyThreshold(value *big.Int, yes bool, toJson string) error {
+func proposeSettingNetworkNodePenaltyThreshold(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.NetworkSettingsContractName, protocol.NetworkPenaltyThresholdSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.NetworkSettingsContractName, protocol.NetworkPenaltyThresholdSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingNetworkPerPenaltyRate(value *big.Int, yes bool, toJson string) error {
+func proposeSettingNetworkPerPenaltyRate(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.NetworkSettingsContractName, protocol.NetworkPenaltyPerRateSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.NetworkSettingsContractName, protocol.NetworkPenaltyPerRateSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingNetworkIsSubmitBalancesEnabled(value bool, yes bool, toJson string) error {
+func proposeSettingNetworkIsSubmitBalancesEnabled(value bool, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(value)
-	return proposeSetting(protocol.NetworkSettingsContractName, protocol.SubmitBalancesEnabledSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.NetworkSettingsContractName, protocol.SubmitBalancesEnabledSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingNetworkSubmitBalancesFrequency(value time.Duration, yes bool, toJson string) error {
+func proposeSettingNetworkSubmitBalancesFrequency(value time.Duration, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(protocol.NetworkSettingsContractName, protocol.SubmitBalancesFrequencySettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.NetworkSettingsContractName, protocol.SubmitBalancesFrequencySettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingNetworkIsSubmitPricesEnabled(value bool, yes bool, toJson string) error {
+func proposeSettingNetworkIsSubmitPricesEnabled(value bool, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(value)
-	return proposeSetting(protocol.NetworkSettingsContractName, protocol.SubmitPricesEnabledSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.NetworkSettingsContractName, protocol.SubmitPricesEnabledSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingNetworkSubmitPricesFrequency(value time.Duration, yes bool, toJson string) error {
+func proposeSettingNetworkSubmitPricesFrequency(value time.Duration, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(protocol.NetworkSettingsContractName, protocol.SubmitPricesFrequencySettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.NetworkSettingsContractName, protocol.SubmitPricesFrequencySettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingNetworkMinimumNodeFee(value *big.Int, yes bool, toJson string) error {
+func proposeSettingNetworkMinimumNodeFee(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.NetworkSettingsContractName, protocol.MinimumNodeFeeSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.NetworkSettingsContractName, protocol.MinimumNodeFeeSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingNetworkTargetNodeFee(value *big.Int, yes bool, toJson string) error {
+func proposeSettingNetworkTargetNodeFee(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.NetworkSettingsContractName, protocol.TargetNodeFeeSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.NetworkSettingsContractName, protocol.TargetNodeFeeSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingNetworkMaximumNodeFee(value *big.Int, yes bool, toJson string) error {
+func proposeSettingNetworkMaximumNodeFee(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.NetworkSettingsContractName, protocol.MaximumNodeFeeSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.NetworkSettingsContractName, protocol.MaximumNodeFeeSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingNetworkNodeFeeDemandRange(value *big.Int, yes bool, toJson string) error {
+func proposeSettingNetworkNodeFeeDemandRange(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.NetworkSettingsContractName, protocol.NodeFeeDemandRangeSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.NetworkSettingsContractName, protocol.NodeFeeDemandRangeSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingNetworkTargetRethCollateralRate(value *big.Int, yes bool, toJson string) error {
+func proposeSettingNetworkTargetRethCollateralRate(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.NetworkSettingsContractName, protocol.TargetRethCollateralRateSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.NetworkSettingsContractName, protocol.TargetRethCollateralRateSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingNetworkIsSubmitRewardsEnabled(value bool, yes bool, toJson string) error {
+func proposeSettingNetworkIsSubmitRewardsEnabled(value bool, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(value)
-	return proposeSetting(protocol.NetworkSettingsContractName, protocol.SubmitRewardsEnabledSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.NetworkSettingsContractName, protocol.SubmitRewardsEnabledSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingNodeIsRegistrationEnabled(value bool, yes bool, toJson string) error {
+func proposeSettingNodeIsRegistrationEnabled(value bool, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(value)
-	return proposeSetting(protocol.NodeSettingsContractName, protocol.NodeRegistrationEnabledSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.NodeSettingsContractName, protocol.NodeRegistrationEnabledSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingNodeIsSmoothingPoolRegistrationEnabled(value bool, yes bool, toJson string) error {
+func proposeSettingNodeIsSmoothingPoolRegistrationEnabled(value bool, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(value)
-	return proposeSetting(protocol.NodeSettingsContractName, protocol.SmoothingPoolRegistrationEnabledSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.NodeSettingsContractName, protocol.SmoothingPoolRegistrationEnabledSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingNodeIsDepositingEnabled(value bool, yes bool, toJson string) error {
+func proposeSettingNodeIsDepositingEnabled(value bool, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(value)
-	return proposeSetting(protocol.NodeSettingsContractName, protocol.NodeDepositEnabledSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.NodeSettingsContractName, protocol.NodeDepositEnabledSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingNodeAreVacantMinipoolsEnabled(value bool, yes bool, toJson string) error {
+func proposeSettingNodeAreVacantMinipoolsEnabled(value bool, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(value)
-	return proposeSetting(protocol.NodeSettingsContractName, protocol.VacantMinipoolsEnabledSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.NodeSettingsContractName, protocol.VacantMinipoolsEnabledSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingNodeMinimumLegacyRplStake(value *big.Int, yes bool, toJson string) error {
+func proposeSettingNodeMinimumLegacyRplStake(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.NodeSettingsContractName, protocol.MinimumLegacyRplStakePath, trueValue, yes, toJson)
+	return proposeSetting(protocol.NodeSettingsContractName, protocol.MinimumLegacyRplStakePath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingReducedBond(value *big.Int, yes bool, toJson string) error {
+func proposeSettingReducedBond(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.NodeSettingsContractName, protocol.ReducedBondSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.NodeSettingsContractName, protocol.ReducedBondSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingNodeUnstakingPeriod(value time.Duration, yes bool, toJson string) error {
+func proposeSettingNodeUnstakingPeriod(value time.Duration, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(protocol.NodeSettingsContractName, protocol.NodeUnstakingPeriodSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.NodeSettingsContractName, protocol.NodeUnstakingPeriodSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingProposalsVotePhase1Time(value time.Duration, yes bool, toJson string) error {
+func proposeSettingProposalsVotePhase1Time(value time.Duration, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(protocol.ProposalsSettingsContractName, protocol.VotePhase1TimeSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.ProposalsSettingsContractName, protocol.VotePhase1TimeSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingProposalsVotePhase2Time(value time.Duration, yes bool, toJson string) error {
+func proposeSettingProposalsVotePhase2Time(value time.Duration, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(protocol.ProposalsSettingsContractName, protocol.VotePhase2TimeSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.ProposalsSettingsContractName, protocol.VotePhase2TimeSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingProposalsVoteDelayTime(value time.Duration, yes bool, toJson string) error {
+func proposeSettingProposalsVoteDelayTime(value time.Duration, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(protocol.ProposalsSettingsContractName, protocol.VoteDelayTimeSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.ProposalsSettingsContractName, protocol.VoteDelayTimeSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingProposalsExecuteTime(value time.Duration, yes bool, toJson string) error {
+func proposeSettingProposalsExecuteTime(value time.Duration, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(protocol.ProposalsSettingsContractName, protocol.ExecuteTimeSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.ProposalsSettingsContractName, protocol.ExecuteTimeSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingProposalsProposalBond(value *big.Int, yes bool, toJson string) error {
+func proposeSettingProposalsProposalBond(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.ProposalsSettingsContractName, protocol.ProposalBondSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.ProposalsSettingsContractName, protocol.ProposalBondSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingProposalsChallengeBond(value *big.Int, yes bool, toJson string) error {
+func proposeSettingProposalsChallengeBond(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.ProposalsSettingsContractName, protocol.ChallengeBondSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.ProposalsSettingsContractName, protocol.ChallengeBondSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingProposalsChallengePeriod(value time.Duration, yes bool, toJson string) error {
+func proposeSettingProposalsChallengePeriod(value time.Duration, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(protocol.ProposalsSettingsContractName, protocol.ChallengePeriodSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.ProposalsSettingsContractName, protocol.ChallengePeriodSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingProposalsQuorum(value *big.Int, yes bool, toJson string) error {
+func proposeSettingProposalsQuorum(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.ProposalsSettingsContractName, protocol.ProposalQuorumSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.ProposalsSettingsContractName, protocol.ProposalQuorumSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingProposalsVetoQuorum(value *big.Int, yes bool, toJson string) error {
+func proposeSettingProposalsVetoQuorum(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.ProposalsSettingsContractName, protocol.ProposalVetoQuorumSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.ProposalsSettingsContractName, protocol.ProposalVetoQuorumSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingProposalsMaxBlockAge(value uint64, yes bool, toJson string) error {
+func proposeSettingProposalsMaxBlockAge(value uint64, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(value)
-	return proposeSetting(protocol.ProposalsSettingsContractName, protocol.ProposalMaxBlockAgeSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.ProposalsSettingsContractName, protocol.ProposalMaxBlockAgeSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingRewardsIntervalPeriods(value uint64, yes bool, toJson string) error {
+func proposeSettingRewardsIntervalPeriods(value uint64, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(value)
-	return proposeSetting(protocol.RewardsSettingsContractName, protocol.RewardsClaimIntervalPeriodsSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.RewardsSettingsContractName, protocol.RewardsClaimIntervalPeriodsSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingSecurityMembersQuorum(value *big.Int, yes bool, toJson string) error {
+func proposeSettingSecurityMembersQuorum(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.SecuritySettingsContractName, protocol.SecurityMembersQuorumSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.SecuritySettingsContractName, protocol.SecurityMembersQuorumSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingSecurityMembersLeaveTime(value time.Duration, yes bool, toJson string) error {
+func proposeSettingSecurityMembersLeaveTime(value time.Duration, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(protocol.SecuritySettingsContractName, protocol.SecurityMembersLeaveTimeSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.SecuritySettingsContractName, protocol.SecurityMembersLeaveTimeSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingSecurityProposalVoteTime(value time.Duration, yes bool, toJson string) error {
+func proposeSettingSecurityProposalVoteTime(value time.Duration, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(protocol.SecuritySettingsContractName, protocol.SecurityProposalVoteTimeSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.SecuritySettingsContractName, protocol.SecurityProposalVoteTimeSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingSecurityProposalExecuteTime(value time.Duration, yes bool, toJson string) error {
+func proposeSettingSecurityProposalExecuteTime(value time.Duration, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(protocol.SecuritySettingsContractName, protocol.SecurityProposalExecuteTimeSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.SecuritySettingsContractName, protocol.SecurityProposalExecuteTimeSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingSecurityProposalActionTime(value time.Duration, yes bool, toJson string) error {
+func proposeSettingSecurityProposalActionTime(value time.Duration, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(protocol.SecuritySettingsContractName, protocol.SecurityProposalActionTimeSettingPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.SecuritySettingsContractName, protocol.SecurityProposalActionTimeSettingPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingMegapoolTimeBeforeDissolve(value time.Duration, yes bool, toJson string) error {
+func proposeSettingMegapoolTimeBeforeDissolve(value time.Duration, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(uint64(value.Seconds()))
-	return proposeSetting(protocol.MegapoolSettingsContractName, protocol.MegapoolTimeBeforeDissolveSettingsPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.MegapoolSettingsContractName, protocol.MegapoolTimeBeforeDissolveSettingsPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingMaximumMegapoolEthPenalty(value *big.Int, yes bool, toJson string) error {
+func proposeSettingMaximumMegapoolEthPenalty(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.MegapoolSettingsContractName, protocol.MegapoolMaximumMegapoolEthPenaltyPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.MegapoolSettingsContractName, protocol.MegapoolMaximumMegapoolEthPenaltyPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingMegapoolNotifyThreshold(value uint64, yes bool, toJson string) error {
+func proposeSettingMegapoolNotifyThreshold(value uint64, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(value)
-	return proposeSetting(protocol.MegapoolSettingsContractName, protocol.MegapoolNotifyThresholdPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.MegapoolSettingsContractName, protocol.MegapoolNotifyThresholdPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingMegapoolLateNotifyFine(value *big.Int, yes bool, toJson string) error {
+func proposeSettingMegapoolLateNotifyFine(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.MegapoolSettingsContractName, protocol.MegapoolLateNotifyFinePath, trueValue, yes, toJson)
+	return proposeSetting(protocol.MegapoolSettingsContractName, protocol.MegapoolLateNotifyFinePath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingMegapoolDissolvePenalty(value *big.Int, yes bool, toJson string) error {
+func proposeSettingMegapoolDissolvePenalty(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.MegapoolSettingsContractName, protocol.MegapoolDissolvePenaltyPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.MegapoolSettingsContractName, protocol.MegapoolDissolvePenaltyPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingMegapoolUserDistributeDelay(value uint64, yes bool, toJson string) error {
+func proposeSettingMegapoolUserDistributeDelay(value uint64, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(value)
-	return proposeSetting(protocol.MegapoolSettingsContractName, protocol.MegapoolUserDistributeDelayPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.MegapoolSettingsContractName, protocol.MegapoolUserDistributeDelayPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingMegapoolUserDistributeDelayWithShortfall(value uint64, yes bool, toJson string) error {
+func proposeSettingMegapoolUserDistributeDelayWithShortfall(value uint64, yes bool, toJson string, simulate bool) error {
 	trueValue := fmt.Sprint(value)
-	return proposeSetting(protocol.MegapoolSettingsContractName, protocol.MegapoolUserDistributeDelayShortfallPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.MegapoolSettingsContractName, protocol.MegapoolUserDistributeDelayShortfallPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingPenaltyThreshold(value *big.Int, yes bool, toJson string) error {
+func proposeSettingPenaltyThreshold(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.MegapoolSettingsContractName, protocol.MegapoolPenaltyThreshold, trueValue, yes, toJson)
+	return proposeSetting(protocol.MegapoolSettingsContractName, protocol.MegapoolPenaltyThreshold, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingNodeCommissionShare(value *big.Int, yes bool, toJson string) error {
+func proposeSettingNodeCommissionShare(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.NetworkSettingsContractName, protocol.NetworkNodeCommissionSharePath, trueValue, yes, toJson)
+	return proposeSetting(protocol.NetworkSettingsContractName, protocol.NetworkNodeCommissionSharePath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingNodeCommissionShareSecurityCouncilAdder(value *big.Int, yes bool, toJson string) error {
+func proposeSettingNodeCommissionShareSecurityCouncilAdder(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.NetworkSettingsContractName, protocol.NetworkNodeCommissionShareSecurityCouncilAdderPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.NetworkSettingsContractName, protocol.NetworkNodeCommissionShareSecurityCouncilAdderPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingVoterShare(value *big.Int, yes bool, toJson string) error {
+func proposeSettingVoterShare(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.NetworkSettingsContractName, protocol.NetworkVoterSharePath, trueValue, yes, toJson)
+	return proposeSetting(protocol.NetworkSettingsContractName, protocol.NetworkVoterSharePath, trueValue, yes, toJson, simulate)
 }
 
-func proposeSettingPDAOShare(value *big.Int, yes bool, toJson string) error {
+func proposeSettingPDAOShare(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.NetworkSettingsContractName, protocol.NetworkPDAOSharePath, trueValue, yes, toJson)
+	return proposeSetting(protocol.NetworkSettingsContractName, protocol.NetworkPDAOSharePath, trueValue, yes, toJson, simulate)
 }
 
-func proposeMaxNodeShareSecurityCouncilAdder(value *big.Int, yes bool, toJson string) error {
+func proposeMaxNodeShareSecurityCouncilAdder(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.NetworkSettingsContractName, protocol.NetworkMaxNodeShareSecurityCouncilAdderPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.NetworkSettingsContractName, protocol.NetworkMaxNodeShareSecurityCouncilAdderPath, trueValue, yes, toJson, simulate)
 }
 
-func proposeMaxRethBalanceDelta(value *big.Int, yes bool, toJson string) error {
+func proposeMaxRethBalanceDelta(value *big.Int, yes bool, toJson string, simulate bool) error {
 	trueValue := value.String()
-	return proposeSetting(protocol.NetworkSettingsContractName, protocol.NetworkMaxRethBalanceDeltaPath, trueValue, yes, toJson)
+	return proposeSetting(protocol.NetworkSettingsContractName, protocol.NetworkMaxRethBalanceDeltaPath, trueValue, yes, toJson, simulate)
 }
 
 // Master general proposal function
-func proposeSetting(contract string, setting string, value string, yes bool, toJson string) error {
+func proposeSetting(contract string, setting string, value string, yes bool, toJson string, simulate bool) error {
 	if toJson != "" {
 		return writeSettingToBatchJSON(toJson, contract, setting, value)
 	}
+	if simulate {
+		return simulateSettings([]api.PDAOBatchSetting{{Contract: contract, Setting: setting, Value: value}})
+	}
 
 	// Get RP client
 	rp, err := rocketpool.NewClient().WithReady()
@@ -0,0 +1,50 @@
+package pdao
+
+import (
+	"fmt"
+
+	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/color"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Simulate the execution of a proposal that changes the given settings, as if it had passed, and print the results
+func simulateSettings(settings []api.PDAOBatchSetting) error {
+	rp, err := rocketpool.NewClient().WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	response, err := rp.PDAOSimulateSettingMulti(settings)
+	if err != nil {
+		return err
+	}
+	printSimulation(response)
+	return nil
+}
+
+// Print the results of a simulated proposal execution
+func printSimulation(response api.SimulatePDAOSettingMultiResponse) {
+	fmt.Printf("Simulated executing the proposal against block %d.\n\n", response.BlockNumber)
+	if response.Reverted {
+		color.RedPrintln("The proposal would revert when executed:")
+		fmt.Printf("  %s\n", response.RevertReason)
+		return
+	}
+
+	color.GreenPrintln("The proposal would execute successfully and change:")
+	for _, setting := range response.Settings {
+		fmt.Printf("  %s / %s: %s -> %s\n", setting.Contract, setting.Setting, setting.CurrentValue, setting.NewValue)
+	}
+	fmt.Println()
+
+	if len(response.InvariantViolations) == 0 {
+		fmt.Println("The new values don't break any of the setting invariants the Smart Node checks.")
+		return
+	}
+	color.YellowPrintln("WARNING: the new values would break setting invariants the Smart Node depends on:")
+	for _, violation := range response.InvariantViolations {
+		fmt.Printf("  - %s\n", violation)
+	}
+}
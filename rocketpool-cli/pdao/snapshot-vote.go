@@ -0,0 +1,120 @@
+package pdao
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/color"
+	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/prompt"
+	"github.com/rocket-pool/smartnode/rocketpool/eip712"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+)
+
+func snapshotVote(proposalID common.Hash, choice uint32, reason string, signallingKeyPath string, yes bool) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClient().WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check the proposal can be voted on
+	canVote, err := rp.PDAOCanSnapshotVote(proposalID)
+	if err != nil {
+		return err
+	}
+	if !canVote.CanVote {
+		fmt.Println("Cannot vote on the Snapshot proposal:")
+		if canVote.ProposalNotActive {
+			fmt.Printf("The proposal is not an active proposal in the %s space.\n", canVote.Space)
+		}
+		if canVote.UnsupportedType {
+			fmt.Printf("The proposal is a %s vote; only single-choice votes can be cast from the Smart Node.\n", canVote.Proposal.Type)
+		}
+		return nil
+	}
+	proposal := canVote.Proposal
+
+	// Load the signalling key if one was provided
+	voter := canVote.NodeAddress
+	var signallingKey *ecdsa.PrivateKey
+	if signallingKeyPath != "" {
+		if canVote.SignallingAddress == (common.Address{}) {
+			return fmt.Errorf("The node does not have a signalling address; set one with `rocketpool pdao set-signalling-address` first.")
+		}
+		keyFile, err := os.ReadFile(signallingKeyPath)
+		if err != nil {
+			return fmt.Errorf("error reading signalling key file: %w", err)
+		}
+		signallingKey, err = crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(string(keyFile)), "0x"))
+		if err != nil {
+			return fmt.Errorf("error loading signalling key: %w", err)
+		}
+		if address := crypto.PubkeyToAddress(signallingKey.PublicKey); address != canVote.SignallingAddress {
+			return fmt.Errorf("The signalling key belongs to %s, but the node's signalling address is %s.", address.Hex(), canVote.SignallingAddress.Hex())
+		}
+		voter = canVote.SignallingAddress
+	}
+
+	// Show the proposal
+	fmt.Printf("Snapshot proposal: %s\n", proposal.Title)
+	fmt.Printf("Ends:              %s\n", time.Unix(proposal.End, 0).Format(time.RFC822))
+	if proposal.Link != "" {
+		fmt.Printf("Link:              %s\n", proposal.Link)
+	}
+	for _, vote := range canVote.ExistingVotes {
+		color.YellowPrintf("%s has already voted on this proposal; voting again will replace that vote.\n", vote.Voter.Hex())
+	}
+	fmt.Println()
+
+	// Get the choice
+	if choice == 0 {
+		index, _ := prompt.Select("Please select a choice to vote for:", proposal.Choices)
+		choice = uint32(index + 1)
+	} else if int(choice) > len(proposal.Choices) {
+		return fmt.Errorf("Invalid choice %d; the proposal has %d choices.", choice, len(proposal.Choices))
+	}
+
+	// Prompt for confirmation
+	if prompt.Declined(yes, "Are you sure you want to vote '%s' on this proposal as %s?", proposal.Choices[choice-1], voter.Hex()) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Cast the vote, signing it here if it comes from the signalling address
+	var voteID string
+	if signallingKey != nil {
+		timestamp := uint64(time.Now().Unix())
+		vote := eip712.NewSnapshotVote(voter, canVote.Space, timestamp, proposalID, choice, reason)
+		signature, err := vote.Sign(signallingKey)
+		if err != nil {
+			return err
+		}
+		response, err := rp.PDAOSnapshotVoteSigned(proposalID, choice, reason, timestamp, signature)
+		if err != nil {
+			return err
+		}
+		voteID = response.VoteID
+	} else {
+		response, err := rp.PDAOSnapshotVote(proposalID, choice, reason)
+		if err != nil {
+			return err
+		}
+		voteID = response.VoteID
+	}
+
+	// Log & return
+	fmt.Printf("Successfully voted '%s' on Snapshot proposal %s as %s.\n", proposal.Choices[choice-1], proposalID.Hex(), voter.Hex())
+	if voteID != "" {
+		fmt.Printf("Vote receipt: %s\n", voteID)
+	}
+	return nil
+
+}
@@ -24,6 +24,8 @@ Submit that file as a single proposal:
 
   rocketpool pdao propose submit-batch --file settings.json
 
+Add --simulate to check what the proposal would do if it passed without submitting it.
+
 The file is a JSON array. Each object is one setting:
 
 [
@@ -43,7 +45,7 @@ The file is a JSON array. Each object is one setting:
 `)
 }
 
-func submitBatch(file string, message string, yes bool) error {
+func submitBatch(file string, message string, yes bool, simulate bool) error {
 	if file == "" {
 		printSubmitBatchHelp()
 		file = prompt.Prompt("Please enter the path to the JSON file:", "^.+$", "Invalid file path")
@@ -63,6 +65,10 @@ func submitBatch(file string, message string, yes bool) error {
 	}
 	fmt.Println()
 
+	if simulate {
+		return simulateSettings(settings)
+	}
+
 	if message == "" {
 		message = prompt.Prompt("Please enter a custom message for this multi-setting proposal (no blank spaces):", "^\\S*$", "Invalid message")
 	}
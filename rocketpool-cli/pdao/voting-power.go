@@ -0,0 +1,116 @@
+package pdao
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/color"
+	"github.com/rocket-pool/smartnode/shared/math"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+)
+
+func getVotingPower(proposalID uint64, limit uint64, showDelegations bool) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClient().WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get the distribution
+	response, err := rp.PDAOVotingPower(proposalID)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Voting power at the target block of proposal %d (block %d):\n", response.ProposalID, response.BlockNumber)
+	fmt.Printf("Total voting power: %.2f, held by %d node(s)\n\n", votingPowerToFloat(response.TotalVotingPower), len(response.Nodes))
+
+	// This node
+	node := response.Node
+	color.GreenPrintln("=== This Node ===")
+	fmt.Printf("Own voting power:       %.2f\n", votingPowerToFloat(node.OwnVotingPower))
+	if node.Delegate == node.Address {
+		fmt.Println("Delegate:               none, the node votes with its own power")
+	} else {
+		fmt.Printf("Delegate:               %s\n", node.Delegate.Hex())
+	}
+	received := big.NewInt(0)
+	for _, delegation := range node.Delegators {
+		received.Add(received, delegation.VotingPower)
+	}
+	fmt.Printf("Delegations received:   %.2f from %d node(s)\n", votingPowerToFloat(received), len(node.Delegators))
+	for _, delegation := range node.Delegators {
+		fmt.Printf("\t%s: %.2f\n", delegation.Delegator.Hex(), votingPowerToFloat(delegation.VotingPower))
+	}
+	fmt.Printf("Voting power held:      %.2f (%.2f%% of the network)\n", votingPowerToFloat(node.VotingPower), getShare(node.VotingPower, response.TotalVotingPower))
+	fmt.Printf("Current own power:      %.2f (used by proposals made from now on)\n\n", votingPowerToFloat(response.NodeCurrentVotingPower))
+
+	// Network distribution
+	color.GreenPrintln("=== Distribution ===")
+	count := len(response.Nodes)
+	if limit > 0 && uint64(count) > limit {
+		count = int(limit)
+	}
+	for i, holder := range response.Nodes[:count] {
+		line := fmt.Sprintf("%3d. %s  %10.2f  %6.2f%%", i+1, holder.Address.Hex(), votingPowerToFloat(holder.VotingPower), getShare(holder.VotingPower, response.TotalVotingPower))
+		if len(holder.Delegators) > 0 {
+			own := ownPowerHeld(holder.Address == holder.Delegate, holder.OwnVotingPower)
+			delegated := big.NewInt(0).Sub(holder.VotingPower, own)
+			line += fmt.Sprintf("  (%.2f own, %.2f from %d delegator(s))", votingPowerToFloat(own), votingPowerToFloat(delegated), len(holder.Delegators))
+		}
+		if holder.Address == node.Address {
+			line = color.LightBlue(line)
+		}
+		fmt.Println(line)
+		if showDelegations {
+			for _, delegation := range holder.Delegators {
+				fmt.Printf("       <- %s  %10.2f\n", delegation.Delegator.Hex(), votingPowerToFloat(delegation.VotingPower))
+			}
+		}
+	}
+	if count < len(response.Nodes) {
+		fmt.Printf("... and %d more; use --limit 0 to show every node.\n", len(response.Nodes)-count)
+	}
+	fmt.Println()
+
+	// Open proposals
+	color.GreenPrintln("=== Open Proposals ===")
+	if len(response.OpenProposals) == 0 {
+		fmt.Println("There are no open proposals.")
+		return nil
+	}
+	for _, prop := range response.OpenProposals {
+		fmt.Printf("%d: %s (%s)\n", prop.ID, prop.Message, types.ProtocolDaoProposalStates[prop.State])
+		fmt.Printf("\tVoted:   %.2f of %.2f\n", votingPowerToFloat(prop.VotedPower), votingPowerToFloat(prop.TotalVotingPower))
+		fmt.Printf("\tUnvoted: %.2f (%.2f%%)\n", votingPowerToFloat(prop.UnvotedPower), getShare(prop.UnvotedPower, prop.TotalVotingPower))
+		if prop.NodeVoted {
+			fmt.Println("\tThe node has voted on this proposal.")
+		}
+	}
+	return nil
+
+}
+
+// Voting power is the square root of an RPL amount in wei times 1e18, so it uses the same 18 decimals as ETH
+func votingPowerToFloat(votingPower *big.Int) float64 {
+	return math.RoundDown(math.WeiToEth(votingPower), 2)
+}
+
+// Get a value's share of the total as a percentage
+func getShare(value *big.Int, total *big.Int) float64 {
+	if total.Sign() == 0 {
+		return 0
+	}
+	return math.WeiToEth(value) / math.WeiToEth(total) * 100
+}
+
+// Get the part of a node's own power it still holds, which is none if it delegated it elsewhere
+func ownPowerHeld(selfDelegated bool, ownVotingPower *big.Int) *big.Int {
+	if selfDelegated {
+		return ownVotingPower
+	}
+	return big.NewInt(0)
+}
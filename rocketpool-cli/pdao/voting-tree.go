@@ -0,0 +1,100 @@
+package pdao
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/goccy/go-json"
+	"github.com/mitchellh/go-homedir"
+
+	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/color"
+	"github.com/rocket-pool/smartnode/shared/math"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+)
+
+func exportVotingTree(blockNumber uint32, nodeAddress *common.Address, outputPath string) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClient().WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get the trees
+	response, err := rp.PDAOExportVotingTree(blockNumber, nodeAddress)
+	if err != nil {
+		return err
+	}
+	exportBytes, err := json.MarshalIndent(response.Export, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error serializing voting tree: %w", err)
+	}
+
+	// Print to stdout unless a file was requested
+	if outputPath == "" {
+		fmt.Println(string(exportBytes))
+		return nil
+	}
+	outputPath, err = homedir.Expand(outputPath)
+	if err != nil {
+		return fmt.Errorf("error expanding output path: %w", err)
+	}
+	if err := os.WriteFile(outputPath, exportBytes, 0644); err != nil {
+		return fmt.Errorf("error writing voting tree to %s: %w", outputPath, err)
+	}
+	color.GreenPrintf("Wrote the voting tree for block %d to %s.\n", blockNumber, outputPath)
+	return nil
+
+}
+
+func verifyVotingTree(proposalID uint64) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClient().WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Check the submitted roots
+	response, err := rp.PDAOVerifyVotingTree(proposalID)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Proposal %d targets block %d.\n", response.ProposalID, response.BlockNumber)
+	fmt.Printf("Locally computed root: %s (%.6f voting power)\n", response.LocalRoot.Hash.Hex(), math.RoundDown(math.WeiToEth(response.LocalRoot.Sum), 6))
+	if response.CachedRoot == nil {
+		fmt.Println("This node doesn't have a cached network tree for the proposal's block.")
+	} else if response.CachedTreeMatches {
+		color.GreenPrintf("This node's cached network tree matches the recomputed one.\n")
+	} else {
+		color.RedPrintf("This node's cached network tree (root %s) does not match the one recomputed from its voting info snapshot; delete it so it's regenerated before proposing or responding to challenges.\n", response.CachedRoot.Hash.Hex())
+	}
+	fmt.Println()
+	if len(response.Roots) == 0 {
+		fmt.Println("No roots have been submitted for this proposal.")
+		return nil
+	}
+
+	mismatches := 0
+	for _, root := range response.Roots {
+		fmt.Printf("Index %d, submitted by %s at %s:\n", root.Index, root.Submitter.Hex(), root.Time.Format("2006-01-02 15:04:05 MST"))
+		if root.Matches {
+			color.GreenPrintf("\tMatches the local tree (root %s)\n", root.Root.Hash.Hex())
+		} else {
+			mismatches++
+			color.RedPrintf("\tDoes not match the local tree; the first mismatching tree index is %d\n", root.MismatchIndex)
+		}
+	}
+	fmt.Println()
+	if mismatches == 0 {
+		color.GreenPrintf("All %d submitted root(s) match the voting tree computed from this node's voting info snapshot.\n", len(response.Roots))
+	} else {
+		color.RedPrintf("%d of %d submitted root(s) do not match the voting tree computed from this node's voting info snapshot.\n", mismatches, len(response.Roots))
+	}
+	return nil
+
+}
@@ -76,6 +76,37 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 
 				},
 			},
+
+			{
+				Name:      "forecast",
+				Aliases:   []string{"f"},
+				Usage:     "Estimate when the validators in the megapool queues will be assigned, based on recent deposit pool activity",
+				UsageText: "rocketpool queue forecast [options]",
+				Flags: []cli.Flag{
+					&cli.Uint64Flag{
+						Name:    "lookback-days",
+						Aliases: []string{"l"},
+						Usage:   "The number of days of deposit pool history to base the forecast on",
+						Value:   14,
+					},
+					&cli.BoolFlag{
+						Name:    "all",
+						Aliases: []string{"a"},
+						Usage:   "Show the forecast for every queued validator, not just the node's",
+					},
+				},
+				Action: func(ctx context.Context, c *cli.Command) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return getForecast(c.Uint64("lookback-days"), c.Bool("all"))
+
+				},
+			},
 		},
 	})
 }
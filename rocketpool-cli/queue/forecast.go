@@ -0,0 +1,95 @@
+package queue
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/color"
+	"github.com/rocket-pool/smartnode/shared/math"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+const forecastDateFormat = "2006-01-02 15:04"
+
+func getForecast(lookbackDays uint64, showAll bool) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClient().WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	if lookbackDays == 0 || lookbackDays > 365 {
+		return fmt.Errorf("lookback days must be between 1 and 365")
+	}
+
+	// Get the forecast
+	response, err := rp.GetQueueForecast(uint32(lookbackDays))
+	if err != nil {
+		return err
+	}
+	forecast := response.Forecast
+
+	// Print the model inputs
+	fmt.Printf("There are %d validator(s) on the express queue and %d on the standard queue; %d express validator(s) are assigned for every standard one.\n", forecast.ExpressQueueLength, forecast.StandardQueueLength, forecast.ExpressQueueRate)
+	fmt.Printf("The deposit pool has %.6f ETH of user deposits available for assignments.\n", math.RoundDown(math.WeiToEth(forecast.UserBalance), 6))
+	fmt.Printf("Over the last %d days, the deposit pool received %.2f ETH/day (± %.2f) and %.2f validators/day (± %.2f) were assigned.\n", forecast.LookbackDays, forecast.DailyInflowMean, forecast.DailyInflowStdDev, forecast.DailyAssignmentsMean, forecast.DailyAssignmentsStdDev)
+	fmt.Println()
+
+	// Pick the validators to show
+	ownMegapool := response.MegapoolAddress != common.Address{}
+	entries := []api.QueueForecastEntry{}
+	for _, entry := range forecast.Entries {
+		if showAll || (ownMegapool && entry.MegapoolAddress == response.MegapoolAddress) {
+			entries = append(entries, entry)
+		}
+	}
+	if len(entries) == 0 {
+		if showAll {
+			fmt.Println("The megapool queues are empty.")
+		} else {
+			fmt.Println("None of the node's megapool validators are in the queue. Use --all to see the forecast for the whole queue.")
+		}
+		return nil
+	}
+
+	// Print the forecast
+	fmt.Printf("%-9s %-44s %-6s %-9s %-12s %-17s %s\n", "Position", "Megapool", "ID", "Queue", "ETH Needed", "Expected", "90% Range")
+	for _, entry := range entries {
+		queue := "standard"
+		if entry.ExpressQueue {
+			queue = "express"
+		}
+		line := fmt.Sprintf("%-9d %-44s %-6d %-9s %-12.2f %-17s %s", entry.Position, entry.MegapoolAddress.Hex(), entry.ValidatorId, queue, entry.EthRequired, formatForecastTime(entry.ExpectedTime), formatForecastRange(entry))
+		if ownMegapool && entry.MegapoolAddress == response.MegapoolAddress {
+			line = color.LightBlue(line)
+		}
+		fmt.Println(line)
+	}
+	fmt.Println()
+	fmt.Println("These are estimates based on recent activity; large deposits or withdrawals from the deposit pool can move them significantly.")
+	if showAll && ownMegapool {
+		fmt.Println("The node's validators are highlighted in blue.")
+	}
+
+	return nil
+
+}
+
+func formatForecastTime(t time.Time) string {
+	if t.IsZero() {
+		return "unknown"
+	}
+	return t.Local().Format(forecastDateFormat)
+}
+
+func formatForecastRange(entry api.QueueForecastEntry) string {
+	if entry.Unbounded {
+		return fmt.Sprintf("%s or later", formatForecastTime(entry.EarliestTime))
+	}
+	return fmt.Sprintf("%s to %s", formatForecastTime(entry.EarliestTime), formatForecastTime(entry.LatestTime))
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"math/big"
@@ -13,6 +14,7 @@ import (
 	"github.com/rocket-pool/smartnode/rocketpool-cli/claims"
 	cliutils "github.com/rocket-pool/smartnode/rocketpool-cli/cli"
 	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/color"
+	"github.com/rocket-pool/smartnode/rocketpool-cli/governance"
 	"github.com/rocket-pool/smartnode/rocketpool-cli/megapool"
 	"github.com/rocket-pool/smartnode/rocketpool-cli/minipool"
 	"github.com/rocket-pool/smartnode/rocketpool-cli/network"
@@ -105,6 +107,7 @@ func main() {
 	claims.RegisterCommands(app, "claims", []string{"l"})
 	minipool.RegisterCommands(app, "minipool", []string{"m"})
 	megapool.RegisterCommands(app, "megapool", []string{"g"})
+	governance.RegisterCommands(app, "governance", []string{"v"})
 	network.RegisterCommands(app, "network", []string{"e"})
 	node.RegisterCommands(app, "node", []string{"n"})
 	odao.RegisterCommands(app, "odao", []string{"o"})
@@ -240,6 +243,18 @@ func main() {
 		return
 	}
 
+	// Exporting an unsigned transaction stops the command before it waits for the transaction, which isn't a failure
+	var exported *rocketpool.UnsignedTransactionExportedError
+	if errors.As(err, &exported) {
+		fmt.Println()
+		color.GreenPrintf("The transaction was not sent. It was written unsigned to %s:\n", exported.Path)
+		cliutils.PrintUnsignedTransaction(exported.Transaction)
+		fmt.Println()
+		fmt.Println("Sign it on your offline machine with `rocketpool wallet sign-tx`, then submit it with `rocketpool node broadcast`.")
+		fmt.Println("If this action needs more than one transaction, run it again with --export-unsigned once this one has been included in a block.")
+		return
+	}
+
 	// Print the error to stderr
 	fmt.Fprintf(os.Stderr, "%s\n", cliutils.PrettyError(err))
 	// Gently remind the operator how to check usage
@@ -591,7 +591,7 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 					{
 						Name:      "details",
 						Aliases:   []string{"d"},
-						Usage:     "View proposal details",
+						Usage:     "View proposal details, including the changes its payload makes to the current settings or contracts",
 						UsageText: "rocketpool security proposals details proposal-id",
 						Action: func(ctx context.Context, c *cli.Command) error {
 
@@ -0,0 +1,136 @@
+package wallet
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/color"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func auditValidatorKeys(bound uint) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClient().WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get & check wallet status
+	status, err := rp.WalletStatus()
+	if err != nil {
+		return err
+	}
+	if !status.WalletInitialized {
+		fmt.Println("The node wallet is not initialized.")
+		return nil
+	}
+
+	// Run the audit
+	fmt.Println("Deriving validator keys and checking them against the node's validators, this may take a moment...")
+	response, err := rp.AuditValidatorKeys(bound)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Checked derivation indices 0 to %d against %d on-chain validator(s) and the %s keystore(s).\n", response.DerivedKeyCount-1, response.ValidatorCount, strings.Join(response.Keystores, ", "))
+	fmt.Printf("The wallet's next validator key index is %d.\n\n", response.NextAccount)
+	problems := false
+
+	// On-chain validators with no local key
+	if len(response.MissingKeys) > 0 {
+		problems = true
+		color.RedPrintf("%d validator(s) exist on chain but have no key in any keystore:\n", len(response.MissingKeys))
+		recoverable := 0
+		for _, key := range response.MissingKeys {
+			printAuditedValidatorKey(key)
+			if key.WalletIndex != nil {
+				recoverable++
+			}
+		}
+		if recoverable > 0 {
+			fmt.Printf("%d of these keys can be derived from the node wallet; run `rocketpool wallet rebuild` to restore them.\n", recoverable)
+		}
+		if recoverable < len(response.MissingKeys) {
+			color.YellowPrintf("%d of these keys were not found within the audited indices. Try a larger --bound, or import them with `rocketpool wallet validators import` if they came from elsewhere.\n", len(response.MissingKeys)-recoverable)
+		}
+		fmt.Println()
+	}
+
+	// Keys that only some keystores have
+	if len(response.PartialKeys) > 0 {
+		problems = true
+		color.YellowPrintf("%d key(s) are missing from some of the keystores:\n", len(response.PartialKeys))
+		for _, key := range response.PartialKeys {
+			printAuditedValidatorKey(key)
+		}
+		fmt.Println("Run `rocketpool wallet rebuild` to write the node's keys to every keystore.")
+		fmt.Println()
+	}
+
+	// Local keys with no validator
+	if len(response.OrphanedKeys) > 0 {
+		problems = true
+		color.YellowPrintf("%d local key(s) have no matching validator on chain:\n", len(response.OrphanedKeys))
+		for _, key := range response.OrphanedKeys {
+			printAuditedValidatorKey(key)
+		}
+		fmt.Println("These are usually left over from a deposit that was never made. They are safe to keep, but will not attest.")
+		fmt.Println()
+	}
+
+	// Derivation gaps
+	if len(response.UnusedIndices) > 0 {
+		fmt.Printf("%d derivation index(es) below the next key index have no validator and no stored key: %s\n\n", len(response.UnusedIndices), formatIndices(response.UnusedIndices))
+	}
+	if response.HighestValidatorIndex != nil && *response.HighestValidatorIndex >= response.NextAccount {
+		problems = true
+		color.RedPrintf("A validator uses derivation index %d, but the wallet's next key index is %d. New validators would be assigned a key that is already in use!\n", *response.HighestValidatorIndex, response.NextAccount)
+		fmt.Println("Run `rocketpool wallet rebuild` to update the wallet's key index before creating any new validators.")
+		fmt.Println()
+	}
+
+	if !problems {
+		color.GreenPrintln("Every on-chain validator has its key in every keystore, and no orphaned keys were found.")
+	}
+	return nil
+
+}
+
+// Print a single audited key along with where it was found
+func printAuditedValidatorKey(key api.AuditedValidatorKey) {
+	fmt.Printf("\t%s", color.LightBlue(key.Pubkey.Hex()))
+	if key.MinipoolAddress != nil {
+		fmt.Printf(" (minipool %s)", key.MinipoolAddress.Hex())
+	}
+	if key.MegapoolValidatorId != nil {
+		fmt.Printf(" (megapool validator %d)", *key.MegapoolValidatorId)
+	}
+	if key.WalletIndex != nil {
+		fmt.Printf(" [index %d]", *key.WalletIndex)
+	}
+	if len(key.Keystores) > 0 && len(key.MissingKeystores) > 0 {
+		fmt.Printf(" - missing from %s", strings.Join(key.MissingKeystores, ", "))
+	}
+	fmt.Println()
+}
+
+// Format a sorted list of indices, collapsing consecutive runs into ranges
+func formatIndices(indices []uint) string {
+	parts := []string{}
+	for i := 0; i < len(indices); {
+		j := i
+		for j+1 < len(indices) && indices[j+1] == indices[j]+1 {
+			j++
+		}
+		if i == j {
+			parts = append(parts, fmt.Sprint(indices[i]))
+		} else {
+			parts = append(parts, fmt.Sprintf("%d-%d", indices[i], indices[j]))
+		}
+		i = j + 1
+	}
+	return strings.Join(parts, ", ")
+}
@@ -3,8 +3,10 @@ package wallet
 import (
 	"context"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/urfave/cli/v3"
 
+	"github.com/rocket-pool/smartnode/bindings/types"
 	cliutils "github.com/rocket-pool/smartnode/rocketpool-cli/cli"
 	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/color"
 )
@@ -260,6 +262,147 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 
 				},
 			},
+			{
+				Name:    "validators",
+				Aliases: []string{"v"},
+				Usage:   "Manage the node's validator keys",
+				Commands: []*cli.Command{
+
+					{
+						Name:      "export",
+						Aliases:   []string{"e"},
+						Usage:     "Export the node's validator keys as standard EIP-2335 keystores with password files",
+						UsageText: "rocketpool wallet validators export [options]",
+						Flags: append([]cli.Flag{
+							&cli.StringFlag{
+								Name:    "output-dir",
+								Aliases: []string{"o"},
+								Usage:   "The directory to write the keystores and their password files to",
+								Value:   "validator-keys",
+							},
+						}, validatorKeyFilterFlags()...),
+						Action: func(ctx context.Context, c *cli.Command) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 0); err != nil {
+								return err
+							}
+
+							// Validate flags
+							pubkeys, minipools, megapoolValidatorIds, err := validateValidatorKeyFilterFlags(c)
+							if err != nil {
+								return err
+							}
+
+							// Run
+							return exportValidatorKeys(c.String("output-dir"), pubkeys, minipools, megapoolValidatorIds, c.Root().Bool("secure-session"))
+
+						},
+					},
+
+					{
+						Name:      "import",
+						Aliases:   []string{"i"},
+						Usage:     "Import standard EIP-2335 keystores for the node's minipool or megapool validators into the Smart Node's Validator Client",
+						UsageText: "rocketpool wallet validators import --keystores path [options]",
+						Flags: append([]cli.Flag{
+							&cli.StringFlag{
+								Name:     "keystores",
+								Aliases:  []string{"k"},
+								Usage:    "A keystore file, or a directory of keystore files, to import. Each keystore's password is read from a file with the same name and a .txt extension unless --password-file is set; missing passwords are prompted for.",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:  "password-file",
+								Usage: "A file containing the password for every keystore being imported",
+							},
+							&cli.BoolFlag{
+								Name:    "no-restart",
+								Aliases: []string{"n"},
+								Usage:   "Don't restart the Validator Client after importing the keys. Note that the keys won't be loaded (and won't attest) until you restart the VC to load them.",
+							},
+							&cli.BoolFlag{
+								Name:    "yes",
+								Aliases: []string{"y"},
+								Usage:   "Automatically confirm all interactive questions",
+							},
+						}, validatorKeyFilterFlags()...),
+						Action: func(ctx context.Context, c *cli.Command) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 0); err != nil {
+								return err
+							}
+
+							// Validate flags
+							pubkeys, minipools, megapoolValidatorIds, err := validateValidatorKeyFilterFlags(c)
+							if err != nil {
+								return err
+							}
+
+							// Run
+							return importValidatorKeys(c.String("keystores"), c.String("password-file"), pubkeys, minipools, megapoolValidatorIds, c.Bool("no-restart"), c.Bool("yes"))
+
+						},
+					},
+
+					{
+						Name:      "audit",
+						Aliases:   []string{"a"},
+						Usage:     "Compare the node's derived validator keys and Validator Client keystores against its minipool and megapool validators on chain",
+						UsageText: "rocketpool wallet validators audit [options]",
+						Flags: []cli.Flag{
+							&cli.UintFlag{
+								Name:    "bound",
+								Aliases: []string{"b"},
+								Usage:   "Check derivation indices from 0 up to (but not including) this index. Defaults to 100 past the wallet's next validator key index.",
+							},
+						},
+						Action: func(ctx context.Context, c *cli.Command) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 0); err != nil {
+								return err
+							}
+
+							// Run
+							return auditValidatorKeys(uint(c.Uint("bound")))
+
+						},
+					},
+				},
+			},
+
+			{
+				Name:      "sign-tx",
+				Aliases:   []string{"st"},
+				Usage:     "Sign a transaction that was exported with --export-unsigned using the node wallet. This doesn't need synced clients, so it can be run on an offline machine.",
+				UsageText: "rocketpool wallet sign-tx [options] unsigned-tx-file",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:    "output",
+						Aliases: []string{"o"},
+						Usage:   "The file to write the signed transaction to; defaults to the input file name with a .signed.json extension",
+					},
+					&cli.BoolFlag{
+						Name:    "yes",
+						Aliases: []string{"y"},
+						Usage:   "Automatically confirm signing the transaction",
+					},
+				},
+				Action: func(ctx context.Context, c *cli.Command) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					return signTransaction(c.Args().Get(0), c.String("output"), c.Bool("yes"))
+
+				},
+			},
+
 			{
 				Name:      "set-ens-name",
 				Aliases:   []string{"ens"},
@@ -360,3 +503,48 @@ func RegisterCommands(app *cli.Command, name string, aliases []string) {
 		},
 	})
 }
+
+// Flags for selecting a subset of the node's validators
+func validatorKeyFilterFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:  "pubkeys",
+			Usage: "A comma-separated list of validator pubkeys to include",
+		},
+		&cli.StringFlag{
+			Name:  "minipools",
+			Usage: "A comma-separated list of minipool addresses whose validators should be included",
+		},
+		&cli.StringFlag{
+			Name:  "megapool-validator-ids",
+			Usage: "A comma-separated list of megapool validator IDs to include",
+		},
+	}
+}
+
+// Parse the validator filter flags; if none are set, every validator is included
+func validateValidatorKeyFilterFlags(c *cli.Command) ([]types.ValidatorPubkey, []common.Address, []uint32, error) {
+	var pubkeys []types.ValidatorPubkey
+	var minipools []common.Address
+	var megapoolValidatorIds []uint32
+	var err error
+	if c.String("pubkeys") != "" {
+		pubkeys, err = cliutils.ValidatePubkeys("pubkeys", c.String("pubkeys"))
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	if c.String("minipools") != "" {
+		minipools, err = cliutils.ValidateAddresses("minipools", c.String("minipools"))
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	if c.String("megapool-validator-ids") != "" {
+		megapoolValidatorIds, err = cliutils.ValidateUint32s("megapool-validator-ids", c.String("megapool-validator-ids"))
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	return pubkeys, minipools, megapoolValidatorIds, nil
+}
@@ -0,0 +1,125 @@
+package wallet
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/goccy/go-json"
+	"github.com/mitchellh/go-homedir"
+
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/color"
+	promptcli "github.com/rocket-pool/smartnode/rocketpool-cli/cli/prompt"
+	hexutils "github.com/rocket-pool/smartnode/shared/hex"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+)
+
+// Exported keystores and their password files share a name so clients like Teku can pair them up
+const (
+	validatorKeystoreFilenameFormat string = "keystore-%s.json"
+	validatorPasswordFilenameFormat string = "keystore-%s.txt"
+)
+
+func exportValidatorKeys(outputDir string, pubkeys []types.ValidatorPubkey, minipools []common.Address, megapoolValidatorIds []uint32, secureSession bool) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClient().WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get & check wallet status
+	status, err := rp.WalletStatus()
+	if err != nil {
+		return err
+	}
+	if !status.WalletInitialized {
+		fmt.Println("The node wallet is not initialized.")
+		return nil
+	}
+
+	if !secureSession {
+		// Check if stdout is interactive
+		stat, err := os.Stdout.Stat()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "An error occurred while determining whether or not the output is a tty: %v\n"+
+				"Use \"rocketpool --secure-session wallet validators export\" to bypass.\n", err)
+			os.Exit(1)
+		}
+
+		if (stat.Mode()&os.ModeCharDevice) == os.ModeCharDevice &&
+			!promptcli.ConfirmSecureSession("Exporting validator keys will write their keystores and passwords to disk.") {
+			return nil
+		}
+	}
+
+	// Prepare the output directory
+	outputDir, err = homedir.Expand(outputDir)
+	if err != nil {
+		return fmt.Errorf("error expanding output directory: %w", err)
+	}
+	if err := os.MkdirAll(outputDir, 0700); err != nil {
+		return fmt.Errorf("error creating output directory %s: %w", outputDir, err)
+	}
+
+	// Export the keys
+	fmt.Println("Exporting validator keys, this may take a while...")
+	response, err := rp.ExportValidatorKeys(pubkeys, minipools, megapoolValidatorIds)
+	if err != nil {
+		return err
+	}
+
+	// Write the keystores and passwords, refusing to overwrite anything that's already there
+	for _, key := range response.Keys {
+		pubkeyString := hexutils.AddPrefix(key.Keystore.Pubkey.Hex())
+		keystorePath := filepath.Join(outputDir, fmt.Sprintf(validatorKeystoreFilenameFormat, pubkeyString))
+		passwordPath := filepath.Join(outputDir, fmt.Sprintf(validatorPasswordFilenameFormat, pubkeyString))
+		for _, path := range []string{keystorePath, passwordPath} {
+			if _, err := os.Stat(path); !os.IsNotExist(err) {
+				return fmt.Errorf("%s already exists; please move it out of the way or choose a different output directory", path)
+			}
+		}
+
+		keystoreBytes, err := json.MarshalIndent(key.Keystore, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error serializing keystore for validator %s: %w", pubkeyString, err)
+		}
+		if err := os.WriteFile(keystorePath, keystoreBytes, 0600); err != nil {
+			return fmt.Errorf("error writing keystore for validator %s: %w", pubkeyString, err)
+		}
+		if err := os.WriteFile(passwordPath, []byte(key.Password), 0600); err != nil {
+			return fmt.Errorf("error writing password for validator %s: %w", pubkeyString, err)
+		}
+
+		switch {
+		case key.MinipoolAddress != nil:
+			fmt.Printf("Exported %s (minipool %s)\n", pubkeyString, key.MinipoolAddress.Hex())
+		case key.MegapoolValidatorId != nil:
+			fmt.Printf("Exported %s (megapool validator %d)\n", pubkeyString, *key.MegapoolValidatorId)
+		default:
+			fmt.Printf("Exported %s\n", pubkeyString)
+		}
+	}
+	fmt.Println()
+
+	if len(response.MissingKeys) > 0 {
+		color.YellowPrintln("The following validators belong to this node but their keys couldn't be found in any of its keystores, so they weren't exported:")
+		for _, pubkey := range response.MissingKeys {
+			color.YellowPrintln(hexutils.AddPrefix(pubkey.Hex()))
+		}
+		color.YellowPrintln("You may be able to restore them with `rocketpool wallet rebuild`.")
+		fmt.Println()
+	}
+
+	if len(response.Keys) == 0 {
+		fmt.Println("No validator keys were exported.")
+		return nil
+	}
+	fmt.Printf("%d validator keys were exported to %s.\n", len(response.Keys), outputDir)
+	color.RedPrintln("Each keystore's password is stored next to it. Keep this directory safe, and NEVER load these keys into another Validator Client while the Smart Node is still validating with them or you WILL BE SLASHED.")
+	return nil
+
+}
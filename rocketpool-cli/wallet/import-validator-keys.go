@@ -0,0 +1,191 @@
+package wallet
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/goccy/go-json"
+	"github.com/mitchellh/go-homedir"
+
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/color"
+	promptcli "github.com/rocket-pool/smartnode/rocketpool-cli/cli/prompt"
+	hexutils "github.com/rocket-pool/smartnode/shared/hex"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func importValidatorKeys(keystorePath string, passwordFile string, pubkeys []types.ValidatorPubkey, minipools []common.Address, megapoolValidatorIds []uint32, noRestart bool, yes bool) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClient().WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get & check wallet status
+	status, err := rp.WalletStatus()
+	if err != nil {
+		return err
+	}
+	if !status.WalletInitialized {
+		fmt.Println("The node wallet is not initialized.")
+		return nil
+	}
+
+	// Load the keystores
+	keystoreFiles, err := getKeystoreFiles(keystorePath)
+	if err != nil {
+		return err
+	}
+	if len(keystoreFiles) == 0 {
+		fmt.Printf("No keystore files were found in %s.\n", keystorePath)
+		return nil
+	}
+
+	// Load the shared password if there is one
+	sharedPassword := ""
+	if passwordFile != "" {
+		passwordFile, err = homedir.Expand(passwordFile)
+		if err != nil {
+			return fmt.Errorf("error expanding password file path: %w", err)
+		}
+		bytes, err := os.ReadFile(passwordFile)
+		if err != nil {
+			return fmt.Errorf("error reading password file %s: %w", passwordFile, err)
+		}
+		sharedPassword = strings.TrimRight(string(bytes), "\r\n")
+	}
+
+	keys := make([]api.ImportedValidatorKey, 0, len(keystoreFiles))
+	for _, file := range keystoreFiles {
+		bytes, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("error reading keystore %s: %w", file, err)
+		}
+		keystore := api.ValidatorKeystore{}
+		if err := json.Unmarshal(bytes, &keystore); err != nil {
+			return fmt.Errorf("error deserializing keystore %s: %w", file, err)
+		}
+
+		// Use the shared password, then a password file next to the keystore, and finally ask for it
+		password := sharedPassword
+		if password == "" {
+			password, err = getKeystorePassword(file, keystore.Pubkey)
+			if err != nil {
+				return err
+			}
+		}
+		keys = append(keys, api.ImportedValidatorKey{
+			Keystore: keystore,
+			Password: password,
+		})
+	}
+
+	fmt.Printf("Found %d keystores to import.\n\n", len(keys))
+
+	// Print a warning and prompt for confirmation of anti-slashing
+	color.RedPrintln("WARNING:")
+	color.RedPrintln("Before doing this, you **MUST** do the following:")
+	color.RedPrintln("1. Remove these keys from any other Validator Client that has them loaded")
+	color.RedPrintln("2. Restart it so that it is no longer validating with those keys")
+	color.RedPrintln("3. Wait for 15 minutes so they have missed at least two attestations")
+	color.RedPrintln("Failure to do this **will result in your validators being SLASHED**.")
+	fmt.Println()
+
+	if !(yes || promptcli.Confirm("Have you removed the keys from every other Validator Client, restarted them, and waited long enough for your validators to miss at least two attestations?")) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Import the keys
+	fmt.Println("Importing validator keys, this may take a while...")
+	response, err := rp.ImportValidatorKeys(keys, pubkeys, minipools, megapoolValidatorIds)
+	if err != nil {
+		return err
+	}
+	for _, pubkey := range response.ImportedKeys {
+		fmt.Printf("Imported %s\n", hexutils.AddPrefix(pubkey.Hex()))
+	}
+	for _, pubkey := range response.SkippedKeys {
+		fmt.Printf("Skipped %s (didn't match the filter)\n", hexutils.AddPrefix(pubkey.Hex()))
+	}
+	fmt.Println()
+
+	if len(response.ImportedKeys) == 0 {
+		fmt.Println("No validator keys were imported.")
+		return nil
+	}
+	fmt.Printf("%d validator keys were imported.\n", len(response.ImportedKeys))
+
+	if noRestart {
+		return nil
+	}
+
+	if yes || promptcli.Confirm("Would you like to restart the Smart Node's Validator Client now so it loads the imported keys?") {
+		// Restart the VC
+		fmt.Print("Restarting Validator Client... ")
+		_, err := rp.RestartVc()
+		if err != nil {
+			fmt.Println("failed!")
+			color.YellowPrintf("WARNING: error restarting validator client: %s\n", err.Error())
+			fmt.Println()
+			color.YellowPrintln("Please restart it manually so it picks up the imported validator keys.")
+			return nil
+		}
+		fmt.Println("done!")
+		fmt.Println()
+	}
+
+	return nil
+
+}
+
+// Get the keystore file at the path, or every JSON file in it if it's a directory
+func getKeystoreFiles(keystorePath string) ([]string, error) {
+	keystorePath, err := homedir.Expand(keystorePath)
+	if err != nil {
+		return nil, fmt.Errorf("error expanding keystore path: %w", err)
+	}
+	info, err := os.Stat(keystorePath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", keystorePath, err)
+	}
+	if !info.IsDir() {
+		return []string{keystorePath}, nil
+	}
+
+	entries, err := os.ReadDir(keystorePath)
+	if err != nil {
+		return nil, fmt.Errorf("error enumerating keystores in %s: %w", keystorePath, err)
+	}
+	files := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		files = append(files, filepath.Join(keystorePath, entry.Name()))
+	}
+	return files, nil
+}
+
+// Read the password file that sits next to a keystore, or prompt for it if there isn't one
+func getKeystorePassword(keystoreFile string, pubkey types.ValidatorPubkey) (string, error) {
+	passwordFile := strings.TrimSuffix(keystoreFile, filepath.Ext(keystoreFile)) + ".txt"
+	bytes, err := os.ReadFile(passwordFile)
+	if err == nil {
+		return strings.TrimRight(string(bytes), "\r\n"), nil
+	}
+	if !os.IsNotExist(err) {
+		return "", fmt.Errorf("error reading password file %s: %w", passwordFile, err)
+	}
+	password := promptcli.PromptPassword(
+		fmt.Sprintf("Please enter the password that the keystore for %s was encrypted with:", hexutils.AddPrefix(pubkey.Hex())), "^.*$", "",
+	)
+	fmt.Println()
+	return password, nil
+}
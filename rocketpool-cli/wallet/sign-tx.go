@@ -0,0 +1,100 @@
+package wallet
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/goccy/go-json"
+	"github.com/mitchellh/go-homedir"
+
+	cliutils "github.com/rocket-pool/smartnode/rocketpool-cli/cli"
+	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/color"
+	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/prompt"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func signTransaction(path string, outputPath string, yes bool) error {
+
+	// Get RP client; the clients don't need to be synced (or even reachable) to sign
+	rp := rocketpool.NewClient()
+	defer rp.Close()
+
+	// Get & check wallet status
+	status, err := rp.WalletStatus()
+	if err != nil {
+		return err
+	}
+	if !status.WalletInitialized {
+		fmt.Println("The node wallet is not initialized.")
+		return nil
+	}
+
+	// Load the unsigned transaction
+	path, err = homedir.Expand(path)
+	if err != nil {
+		return fmt.Errorf("error expanding path: %w", err)
+	}
+	txBytes, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading %s: %w", path, err)
+	}
+	var tx api.UnsignedTransaction
+	if err := json.Unmarshal(txBytes, &tx); err != nil {
+		return fmt.Errorf("error parsing %s as an unsigned transaction: %w", path, err)
+	}
+	if tx.SerializedTx == "" {
+		return fmt.Errorf("%s does not contain an unsigned transaction", path)
+	}
+
+	// Decode what will actually be signed, and make sure it matches the summary and this node's network
+	cfg, _, err := rp.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("error loading configuration: %w", err)
+	}
+	decodedTx, err := tx.Decode(new(big.Int).SetUint64(uint64(cfg.Smartnode.GetChainID())))
+	if err != nil {
+		return fmt.Errorf("%s can't be signed: %w", path, err)
+	}
+	if tx.From != status.AccountAddress {
+		return fmt.Errorf("%s was built for %s, but this node wallet is %s", path, tx.From.Hex(), status.AccountAddress.Hex())
+	}
+
+	// Review it
+	fmt.Println("Transaction to sign:")
+	cliutils.PrintTransactionToSign(status.AccountAddress, decodedTx)
+	fmt.Println()
+	if prompt.Declined(yes, "Are you sure you want to sign this transaction with the node wallet?") {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Sign it
+	response, err := rp.SignTransaction(tx)
+	if err != nil {
+		return err
+	}
+
+	// Write the signed transaction
+	if outputPath == "" {
+		outputPath = strings.TrimSuffix(path, ".json") + ".signed.json"
+	}
+	outputPath, err = homedir.Expand(outputPath)
+	if err != nil {
+		return fmt.Errorf("error expanding output path: %w", err)
+	}
+	signedBytes, err := json.MarshalIndent(response.SignedTransaction, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error serializing signed transaction: %w", err)
+	}
+	if err := os.WriteFile(outputPath, signedBytes, 0644); err != nil {
+		return fmt.Errorf("error writing signed transaction to %s: %w", outputPath, err)
+	}
+
+	color.GreenPrintf("Signed transaction %s and wrote it to %s.\n", response.SignedTransaction.TxHash.Hex(), outputPath)
+	fmt.Println("Move it to your online node and submit it with `rocketpool node broadcast`.")
+	return nil
+
+}
@@ -0,0 +1,17 @@
+package governance
+
+import (
+	"net/http"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/rocket-pool/smartnode/rocketpool/api/response"
+)
+
+// RegisterRoutes registers the governance module's HTTP routes onto mux.
+func RegisterRoutes(mux *http.ServeMux, c *cli.Command) {
+	mux.HandleFunc("/api/governance/upcoming", func(w http.ResponseWriter, r *http.Request) {
+		resp, err := getUpcoming(c)
+		response.WriteResponse(w, resp, err)
+	})
+}
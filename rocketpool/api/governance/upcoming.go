@@ -0,0 +1,204 @@
+package governance
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v3"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/bindings/dao"
+	"github.com/rocket-pool/smartnode/bindings/dao/protocol"
+	"github.com/rocket-pool/smartnode/bindings/dao/security"
+	"github.com/rocket-pool/smartnode/bindings/dao/trustednode"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/rocketpool/api/pdao"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/proposals"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getUpcoming(c *cli.Command) (*api.GovernanceUpcomingResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.GovernanceUpcomingResponse{}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the events
+	response.Events, response.SnapshotError, err = GetUpcomingEvents(c, nodeAccount.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Get the next phase change of every open pDAO, oDAO, Security Council and Snapshot proposal, soonest first, along with the node's voting status on them.
+// Snapshot errors aren't fatal; they're returned as a string instead.
+func GetUpcomingEvents(c *cli.Command, nodeAddress common.Address) ([]api.GovernanceEvent, string, error) {
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Sync
+	var wg errgroup.Group
+	var pdaoEvents, odaoEvents, securityEvents, snapshotEvents []api.GovernanceEvent
+	var snapshotError string
+
+	wg.Go(func() error {
+		var err error
+		pdaoEvents, err = getPDAOEvents(rp, nodeAddress)
+		return err
+	})
+	wg.Go(func() error {
+		isMember, err := trustednode.GetMemberExists(rp, nodeAddress, nil)
+		if err != nil {
+			return fmt.Errorf("error checking oDAO membership: %w", err)
+		}
+		odaoEvents, err = getDAOEvents(rp, api.GovernanceDAO_Oracle, "rocketDAONodeTrustedProposals", nodeAddress, isMember)
+		return err
+	})
+	wg.Go(func() error {
+		isMember, err := security.GetMemberExists(rp, nodeAddress, nil)
+		if err != nil {
+			return fmt.Errorf("error checking Security Council membership: %w", err)
+		}
+		securityEvents, err = getDAOEvents(rp, api.GovernanceDAO_SecurityCouncil, "rocketDAOSecurityProposals", nodeAddress, isMember)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		snapshotEvents, err = getSnapshotEvents(c, nodeAddress)
+		if err != nil {
+			snapshotError = err.Error()
+		}
+		return nil
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return nil, "", err
+	}
+
+	events := append(pdaoEvents, odaoEvents...)
+	events = append(events, securityEvents...)
+	events = append(events, snapshotEvents...)
+	proposals.SortGovernanceEvents(events)
+	return events, snapshotError, nil
+}
+
+// Get the events for the open Protocol DAO proposals
+func getPDAOEvents(rp *rocketpool.RocketPool, nodeAddress common.Address) ([]api.GovernanceEvent, error) {
+	props, err := protocol.GetProposals(rp, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting Protocol DAO proposals: %w", err)
+	}
+
+	events := []api.GovernanceEvent{}
+	for _, prop := range props {
+		event, ok := proposals.GetPDAOProposalEvent(prop)
+		if !ok {
+			continue
+		}
+		event.Voted, event.NeedsVote, err = proposals.GetPDAOVoteStatus(rp, prop, nodeAddress, nil)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// Get the events for the open proposals of the Oracle DAO or Security Council
+func getDAOEvents(rp *rocketpool.RocketPool, daoName api.GovernanceDAO, contractName string, nodeAddress common.Address, isMember bool) ([]api.GovernanceEvent, error) {
+	props, err := dao.GetDAOProposalsWithMember(rp, contractName, nodeAddress, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting %s proposals: %w", daoName, err)
+	}
+
+	events := []api.GovernanceEvent{}
+	for _, prop := range props {
+		event, ok := proposals.GetDAOProposalEvent(daoName, prop)
+		if !ok {
+			continue
+		}
+		event.NeedsVote = isMember && prop.State == types.Active && !prop.MemberVoted
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// Get the events for the pending and active Snapshot proposals
+func getSnapshotEvents(c *cli.Command, nodeAddress common.Address) ([]api.GovernanceEvent, error) {
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	apiDomain := cfg.Smartnode.GetSnapshotApiDomain()
+	if apiDomain == "" {
+		return nil, nil
+	}
+	space := cfg.Smartnode.GetSnapshotID()
+
+	// Get the proposals the node or its signalling address voted on
+	voted := map[string]bool{}
+	reg, err := services.GetRocketSignerRegistry(c)
+	if err != nil {
+		return nil, err
+	}
+	if reg != nil {
+		signallingAddress, err := reg.NodeToSigner(&bind.CallOpts{}, nodeAddress)
+		if err != nil {
+			return nil, fmt.Errorf("error getting the node's signalling address: %w", err)
+		}
+		votedProposals, err := pdao.GetSnapshotVotedProposals(apiDomain, space, nodeAddress, signallingAddress)
+		if err != nil {
+			return nil, err
+		}
+		for _, vote := range votedProposals.Data.Votes {
+			voted[vote.Proposal.Id] = true
+		}
+	}
+
+	events := []api.GovernanceEvent{}
+	for _, state := range []string{"pending", "active"} {
+		snapshotResponse, err := pdao.GetSnapshotProposals(apiDomain, space, state)
+		if err != nil {
+			return nil, err
+		}
+		for _, prop := range snapshotResponse.Data.Proposals {
+			event, ok := proposals.GetSnapshotProposalEvent(prop)
+			if !ok {
+				continue
+			}
+			if prop.State == "active" {
+				event.Voted = voted[prop.Id]
+				event.NeedsVote = !event.Voted
+			}
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
@@ -15,9 +15,9 @@ const (
 	perEpochActivationExitChurnLimit uint64 = 256_000_000_000
 )
 
-// getBeaconWithdrawalQueueEstimate estimates how long the current beacon-chain
+// GetBeaconWithdrawalQueueEstimate estimates how long the current beacon-chain
 // exit queue will take to be processed.
-func getBeaconWithdrawalQueueEstimate(c *cli.Command) (*api.BeaconWithdrawalQueueEstimateResponse, error) {
+func GetBeaconWithdrawalQueueEstimate(c *cli.Command) (*api.BeaconWithdrawalQueueEstimateResponse, error) {
 	if err := services.RequireBeaconClientSynced(c); err != nil {
 		return nil, err
 	}
@@ -42,9 +42,14 @@ func getBeaconWithdrawalQueueEstimate(c *cli.Command) (*api.BeaconWithdrawalQueu
 		return nil, fmt.Errorf("error getting validator set: %w", err)
 	}
 
-	// Walk the validator set once and collect the effective balance of validators currently waiting to exit
+	// Walk the validator set once and collect the effective balance of validators currently waiting to exit,
+	// along with the number of validators the withdrawal sweep has to visit
 	var exitQueueGwei uint64
+	var sweepValidatorCount uint64
 	for _, v := range validators {
+		if v.Balance > 0 {
+			sweepValidatorCount++
+		}
 
 		// In the exit queue if exit_epoch is set and still in the future.
 		if v.ExitEpoch != farFutureEpoch && v.ExitEpoch > currentEpoch {
@@ -65,7 +70,9 @@ func getBeaconWithdrawalQueueEstimate(c *cli.Command) (*api.BeaconWithdrawalQueu
 		ExitQueueGwei:         exitQueueGwei,
 		ChurnPerEpochGwei:     churnPerEpochGwei,
 		SecondsPerEpoch:       eth2Config.SecondsPerEpoch,
+		SecondsPerSlot:        eth2Config.SecondsPerSlot,
 		EstimatedQueueEpochs:  estimatedEpochs,
 		EstimatedQueueSeconds: estimatedSeconds,
+		SweepValidatorCount:   sweepValidatorCount,
 	}, nil
 }
@@ -12,7 +12,8 @@ import (
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
 
-func canClaimRefund(c *cli.Command) (*api.CanClaimRefundResponse, error) {
+// Check if the node's megapool has a refund to claim
+func CanClaimRefund(c *cli.Command) (*api.CanClaimRefundResponse, error) {
 
 	// Get services
 	if err := services.RequireNodeRegistered(c); err != nil {
@@ -0,0 +1,229 @@
+package megapool
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v3"
+
+	"github.com/rocket-pool/smartnode/bindings/megapool"
+	"github.com/rocket-pool/smartnode/rocketpool/validator"
+	"github.com/rocket-pool/smartnode/shared"
+	hexutils "github.com/rocket-pool/smartnode/shared/hex"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/types/eth2/generic"
+)
+
+// The amount deposited to the Beacon deposit contract when a megapool validator is created
+const megapoolPrestakeAmountGwei uint64 = 1e9
+
+// The network names used by deposit_data.json files, keyed by genesis fork version
+var depositNetworkNames = map[string]string{
+	"00000000": "mainnet",
+	"10000910": "hoodi",
+}
+
+func getDepositData(c *cli.Command, count uint) (*api.MegapoolDepositDataResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.MegapoolDepositDataResponse{}
+
+	// Get the withdrawal credentials
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	response.MegapoolAddress, err = megapool.GetMegapoolExpectedAddress(rp, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	response.WithdrawalCredentials = services.CalculateMegapoolWithdrawalCredentials(response.MegapoolAddress)
+
+	// Get eth2 config
+	eth2Config, err := bc.GetEth2Config()
+	if err != nil {
+		return nil, err
+	}
+
+	// Derive the keys the next deposits will use, without saving them
+	response.StartIndex, err = w.GetValidatorKeyCount()
+	if err != nil {
+		return nil, err
+	}
+	validatorKeys, err := w.GetValidatorKeys(response.StartIndex, count)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the deposit data
+	response.DepositData = make([]api.DepositDataEntry, 0, len(validatorKeys))
+	for _, key := range validatorKeys {
+		depositData, depositDataRoot, err := validator.GetDepositData(key.PrivateKey, response.WithdrawalCredentials, eth2Config, megapoolPrestakeAmountGwei)
+		if err != nil {
+			return nil, fmt.Errorf("error creating deposit data for validator key %d: %w", key.WalletIndex, err)
+		}
+		depositMessageRoot, err := validator.GetDepositMessageRoot(depositData)
+		if err != nil {
+			return nil, fmt.Errorf("error getting deposit message root for validator key %d: %w", key.WalletIndex, err)
+		}
+		forkVersion := hex.EncodeToString(eth2Config.GenesisForkVersion)
+		response.DepositData = append(response.DepositData, api.DepositDataEntry{
+			Pubkey:                hex.EncodeToString(depositData.PublicKey),
+			WithdrawalCredentials: hex.EncodeToString(depositData.WithdrawalCredentials),
+			Amount:                depositData.Amount,
+			Signature:             hex.EncodeToString(depositData.Signature),
+			DepositMessageRoot:    hex.EncodeToString(depositMessageRoot[:]),
+			DepositDataRoot:       hex.EncodeToString(depositDataRoot[:]),
+			ForkVersion:           forkVersion,
+			NetworkName:           depositNetworkNames[forkVersion],
+			DepositCliVersion:     shared.RocketPoolVersion(),
+		})
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func verifyDepositData(c *cli.Command, entries []api.DepositDataEntry) (*api.VerifyMegapoolDepositDataResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.VerifyMegapoolDepositDataResponse{}
+
+	// Get the withdrawal credentials
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	response.MegapoolAddress, err = megapool.GetMegapoolExpectedAddress(rp, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	response.WithdrawalCredentials = services.CalculateMegapoolWithdrawalCredentials(response.MegapoolAddress)
+
+	// Get eth2 config
+	eth2Config, err := bc.GetEth2Config()
+	if err != nil {
+		return nil, err
+	}
+	response.ForkVersion = hex.EncodeToString(eth2Config.GenesisForkVersion)
+	response.NetworkName = depositNetworkNames[response.ForkVersion]
+
+	// Check each deposit
+	response.Deposits = make([]api.DepositDataVerification, 0, len(entries))
+	for _, entry := range entries {
+		response.Deposits = append(response.Deposits, api.DepositDataVerification{
+			Pubkey: entry.Pubkey,
+			Errors: checkDepositDataEntry(entry, response.WithdrawalCredentials, eth2Config),
+		})
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Check a deposit_data.json entry against the megapool's withdrawal credentials and the network config, returning any problems found
+func checkDepositDataEntry(entry api.DepositDataEntry, withdrawalCredentials common.Hash, eth2Config beacon.Eth2Config) []string {
+	problems := []string{}
+	decode := func(name string, value string, length int) []byte {
+		bytes, err := hex.DecodeString(hexutils.RemovePrefix(value))
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s is not valid hex: %s", name, err.Error()))
+			return nil
+		}
+		if len(bytes) != length {
+			problems = append(problems, fmt.Sprintf("%s is %d bytes but should be %d", name, len(bytes), length))
+			return nil
+		}
+		return bytes
+	}
+
+	pubkey := decode("pubkey", entry.Pubkey, 48)
+	entryCredentials := decode("withdrawal_credentials", entry.WithdrawalCredentials, 32)
+	signature := decode("signature", entry.Signature, 96)
+	depositMessageRoot := decode("deposit_message_root", entry.DepositMessageRoot, 32)
+	depositDataRoot := decode("deposit_data_root", entry.DepositDataRoot, 32)
+	forkVersion := decode("fork_version", entry.ForkVersion, 4)
+	if len(problems) > 0 {
+		return problems
+	}
+
+	// Check the deposit parameters
+	if !bytes.Equal(entryCredentials, withdrawalCredentials[:]) {
+		problems = append(problems, fmt.Sprintf("withdrawal credentials are 0x%s but the node's megapool uses %s", hex.EncodeToString(entryCredentials), withdrawalCredentials.Hex()))
+	}
+	if entry.Amount != megapoolPrestakeAmountGwei {
+		problems = append(problems, fmt.Sprintf("amount is %d gwei but megapool deposits are %d gwei", entry.Amount, megapoolPrestakeAmountGwei))
+	}
+	if !bytes.Equal(forkVersion, eth2Config.GenesisForkVersion) {
+		problems = append(problems, fmt.Sprintf("fork version is 0x%s but the Beacon Chain's genesis fork version is 0x%s", hex.EncodeToString(forkVersion), hex.EncodeToString(eth2Config.GenesisForkVersion)))
+	}
+	if networkName, exists := depositNetworkNames[hex.EncodeToString(eth2Config.GenesisForkVersion)]; exists && entry.NetworkName != "" && entry.NetworkName != networkName {
+		problems = append(problems, fmt.Sprintf("network name is %s but the Beacon Chain is %s", entry.NetworkName, networkName))
+	}
+
+	// Check the roots and signature
+	depositData := generic.DepositData{
+		PublicKey:             pubkey,
+		WithdrawalCredentials: entryCredentials,
+		Amount:                entry.Amount,
+		Signature:             signature,
+	}
+	expectedMessageRoot, err := validator.GetDepositMessageRoot(depositData)
+	if err != nil {
+		return append(problems, fmt.Sprintf("error getting deposit message root: %s", err.Error()))
+	}
+	if !bytes.Equal(depositMessageRoot, expectedMessageRoot[:]) {
+		problems = append(problems, fmt.Sprintf("deposit message root is 0x%s but should be %s", hex.EncodeToString(depositMessageRoot), expectedMessageRoot.Hex()))
+	}
+	valid, expectedDataRoot, err := validator.VerifyDepositData(depositData, eth2Config)
+	if err != nil {
+		return append(problems, fmt.Sprintf("error verifying signature: %s", err.Error()))
+	}
+	if !bytes.Equal(depositDataRoot, expectedDataRoot[:]) {
+		problems = append(problems, fmt.Sprintf("deposit data root is 0x%s but should be %s", hex.EncodeToString(depositDataRoot), expectedDataRoot.Hex()))
+	}
+	if !valid {
+		problems = append(problems, "signature is not valid for this pubkey and deposit on the current network")
+	}
+	return problems
+}
@@ -0,0 +1,76 @@
+package megapool
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+
+	"github.com/rocket-pool/smartnode/rocketpool/validator"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func TestCheckDepositDataEntry(t *testing.T) {
+	if err := validator.InitializeBLS(); err != nil {
+		t.Fatalf("error initializing BLS: %v", err)
+	}
+	key, err := eth2types.GenerateBLSPrivateKey()
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+	withdrawalCredentials := services.CalculateMegapoolWithdrawalCredentials(common.HexToAddress("0x1234"))
+	hoodi := beacon.Eth2Config{GenesisForkVersion: []byte{0x10, 0x00, 0x09, 0x10}}
+	mainnet := beacon.Eth2Config{GenesisForkVersion: []byte{0x00, 0x00, 0x00, 0x00}}
+
+	// Build an entry the same way the generate route does
+	depositData, depositDataRoot, err := validator.GetDepositData(key, withdrawalCredentials, hoodi, megapoolPrestakeAmountGwei)
+	if err != nil {
+		t.Fatalf("error creating deposit data: %v", err)
+	}
+	depositMessageRoot, err := validator.GetDepositMessageRoot(depositData)
+	if err != nil {
+		t.Fatalf("error getting deposit message root: %v", err)
+	}
+	entry := api.DepositDataEntry{
+		Pubkey:                hex.EncodeToString(depositData.PublicKey),
+		WithdrawalCredentials: hex.EncodeToString(depositData.WithdrawalCredentials),
+		Amount:                depositData.Amount,
+		Signature:             hex.EncodeToString(depositData.Signature),
+		DepositMessageRoot:    hex.EncodeToString(depositMessageRoot[:]),
+		DepositDataRoot:       hex.EncodeToString(depositDataRoot[:]),
+		ForkVersion:           "10000910",
+		NetworkName:           "hoodi",
+	}
+
+	if problems := checkDepositDataEntry(entry, withdrawalCredentials, hoodi); len(problems) != 0 {
+		t.Errorf("expected a valid entry, got %v", problems)
+	}
+
+	// The signature is bound to the network's fork version
+	if problems := checkDepositDataEntry(entry, withdrawalCredentials, mainnet); len(problems) != 3 {
+		t.Errorf("expected fork version, network name and signature problems on mainnet, got %v", problems)
+	}
+
+	// Credentials for another megapool are rejected
+	other := services.CalculateMegapoolWithdrawalCredentials(common.HexToAddress("0x5678"))
+	if problems := checkDepositDataEntry(entry, other, hoodi); len(problems) != 1 {
+		t.Errorf("expected a withdrawal credentials problem, got %v", problems)
+	}
+
+	// Changing the amount invalidates both roots and the signature
+	changed := entry
+	changed.Amount = 32e9
+	if problems := checkDepositDataEntry(changed, withdrawalCredentials, hoodi); len(problems) != 4 {
+		t.Errorf("expected amount, root and signature problems, got %v", problems)
+	}
+
+	// Malformed fields are reported without further checks
+	changed = entry
+	changed.Signature = "0x1234"
+	if problems := checkDepositDataEntry(changed, withdrawalCredentials, hoodi); len(problems) != 1 {
+		t.Errorf("expected a signature length problem, got %v", problems)
+	}
+}
@@ -78,6 +78,11 @@ func canDissolveWithProof(c *cli.Command, validatorId uint32) (*api.CanDissolveW
 		return nil, err
 	}
 
+	// Check the proofs against our own Beacon Node before using them
+	if err := services.VerifyValidatorProof(bc, proof, slotProof); err != nil {
+		return nil, fmt.Errorf("Error verifying the validator proof: %w", err)
+	}
+
 	if !validatorInfo.InPrestake {
 		response.CanDissolve = false
 		response.NotInPrestake = true
@@ -166,6 +171,11 @@ func dissolveWithProof(c *cli.Command, validatorId uint32, opts *bind.TransactOp
 		return nil, err
 	}
 
+	// Check the proofs against our own Beacon Node before submitting them
+	if err := services.VerifyValidatorProof(bc, validatorProof, slotProof); err != nil {
+		return nil, fmt.Errorf("Error verifying the validator proof: %w", err)
+	}
+
 	// Dissolve
 	tx, err := megapool.DissolveWithProof(rp, megapoolAddress, validatorId, slotTimestamp, validatorProof, slotProof, opts)
 	if err != nil {
@@ -126,6 +126,11 @@ func canNotifyFinalBalance(c *cli.Command, validatorId uint32, withdrawalSlot ui
 		return nil, err
 	}
 
+	// Check the proofs against our own Beacon Node before using them
+	if err := services.VerifyFinalBalanceProof(bc, finalBalanceProof, validatorProof, slotProof); err != nil {
+		return nil, fmt.Errorf("Error verifying the final balance proof: %w", err)
+	}
+
 	// Notify the validator exit
 	gasLimits, err := megapool.EstimateNotifyFinalBalance(rp, megapoolAddress, validatorId, slotTimestamp, finalBalanceProof, validatorProof, slotProof, opts)
 	if err != nil {
@@ -250,6 +255,11 @@ func notifyFinalBalance(c *cli.Command, validatorId uint32, withdrawalSlot uint6
 		return nil, err
 	}
 
+	// Check the proofs against our own Beacon Node before submitting them
+	if err := services.VerifyFinalBalanceProof(bc, finalBalanceProof, validatorProof, slotProof); err != nil {
+		return nil, fmt.Errorf("Error verifying the final balance proof: %w", err)
+	}
+
 	// Notify the validator exit
 	tx, err := megapool.NotifyFinalBalance(rp, megapoolAddress, validatorId, slotTimestamp, finalBalanceProof, validatorProof, slotProof, opts)
 	if err != nil {
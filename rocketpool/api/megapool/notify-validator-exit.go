@@ -126,6 +126,11 @@ func canNotifyValidatorExit(c *cli.Command, validatorId uint32) (*api.CanNotifyV
 		return nil, err
 	}
 
+	// Check the proofs against our own Beacon Node before using them
+	if err := services.VerifyValidatorProof(bc, proof, slotProof); err != nil {
+		return nil, fmt.Errorf("Error verifying the validator proof: %w", err)
+	}
+
 	opts, err := w.GetNodeAccountTransactor()
 	if err != nil {
 		return nil, err
@@ -210,6 +215,11 @@ func notifyValidatorExit(c *cli.Command, validatorId uint32, opts *bind.Transact
 		return nil, err
 	}
 
+	// Check the proofs against our own Beacon Node before submitting them
+	if err := services.VerifyValidatorProof(bc, validatorProof, slotProof); err != nil {
+		return nil, fmt.Errorf("Error verifying the validator proof: %w", err)
+	}
+
 	// Notify the validator exit
 	tx, err := megapool.NotifyExit(rp, megapoolAddress, validatorId, slotTimetamp, validatorProof, slotProof, opts)
 	if err != nil {
@@ -0,0 +1,108 @@
+package megapool
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/rocket-pool/smartnode/rocketpool/api/response"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// RegisterProofRoutes registers the megapool proof server's routes onto mux. The routes mirror the
+// Rocket Pool proof API so other nodes can be pointed at this one instead. Only proofs from the states
+// the node has already cached are served, so callers can't make it download new ones.
+func RegisterProofRoutes(mux *http.ServeMux, c *cli.Command) {
+	mux.HandleFunc("GET /api/megapool/proofs/validators/{slot}/{validatorIndex}", func(w http.ResponseWriter, r *http.Request) {
+		slot, err := parsePathUint64(r, "slot")
+		if err != nil {
+			response.WriteErrorResponse(w, err)
+			return
+		}
+		validatorIndex, err := parsePathUint64(r, "validatorIndex")
+		if err != nil {
+			response.WriteErrorResponse(w, err)
+			return
+		}
+		resp, err := getValidatorProof(c, slot, validatorIndex)
+		response.WriteResponse(w, resp, notCachedToNotFound(r, err))
+	})
+
+	// The finalized slot is part of the route for compatibility with the Rocket Pool API, but proofs are
+	// always anchored in the finalized states this node has cached, and only withdrawals it has already
+	// found are served. Those are only the withdrawals of this node's own validators, so other nodes
+	// build their final balance proofs themselves.
+	mux.HandleFunc("GET /api/megapool/proofs/withdrawals/{finalizedSlot}/{withdrawalSlot}/{validatorIndex}", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := parsePathUint64(r, "finalizedSlot"); err != nil {
+			response.WriteErrorResponse(w, err)
+			return
+		}
+		withdrawalSlot, err := parsePathUint64(r, "withdrawalSlot")
+		if err != nil {
+			response.WriteErrorResponse(w, err)
+			return
+		}
+		validatorIndex, err := parsePathUint64(r, "validatorIndex")
+		if err != nil {
+			response.WriteErrorResponse(w, err)
+			return
+		}
+		resp, err := getWithdrawalProof(c, withdrawalSlot, validatorIndex)
+		response.WriteResponse(w, resp, notCachedToNotFound(r, err))
+	})
+}
+
+func getValidatorProof(c *cli.Command, slot uint64, validatorIndex uint64) (*api.MegapoolValidatorProofResponse, error) {
+	if err := requireProofServer(c); err != nil {
+		return nil, err
+	}
+	proof, err := services.GetProofServer().GetCachedValidatorProof(slot, validatorIndex)
+	if err != nil {
+		return nil, err
+	}
+	return &proof, nil
+}
+
+func getWithdrawalProof(c *cli.Command, withdrawalSlot uint64, validatorIndex uint64) (*api.MegapoolWithdrawalProofResponse, error) {
+	if err := requireProofServer(c); err != nil {
+		return nil, err
+	}
+	proof, err := services.GetProofServer().GetCachedWithdrawalProof(withdrawalSlot, validatorIndex)
+	if err != nil {
+		return nil, err
+	}
+	return &proof, nil
+}
+
+// Proofs are only served from the states the node has cached, so report the ones that aren't as missing
+func notCachedToNotFound(r *http.Request, err error) error {
+	if errors.Is(err, services.ErrProofNotCached) {
+		return &response.NotFoundError{Path: r.URL.Path}
+	}
+	return err
+}
+
+// Make sure the node has opted into serving proofs, since building them on demand is expensive
+func requireProofServer(c *cli.Command) error {
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return err
+	}
+	if !cfg.Smartnode.EnableProofServer.Value.(bool) {
+		return errors.New("the megapool proof server is not enabled on this node")
+	}
+	return nil
+}
+
+func parsePathUint64(r *http.Request, name string) (uint64, error) {
+	raw := r.PathValue(name)
+	v, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil {
+		return 0, &response.BadRequestError{Err: fmt.Errorf("invalid %s: %s", name, raw)}
+	}
+	return v, nil
+}
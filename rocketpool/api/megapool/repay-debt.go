@@ -14,7 +14,8 @@ import (
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
 
-func canRepayDebt(c *cli.Command, amount *big.Int) (*api.CanRepayDebtResponse, error) {
+// Check if the node can repay the given amount of its megapool's debt
+func CanRepayDebt(c *cli.Command, amount *big.Int) (*api.CanRepayDebtResponse, error) {
 
 	// Get services
 	if err := services.RequireNodeRegistered(c); err != nil {
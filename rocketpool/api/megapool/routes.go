@@ -7,14 +7,18 @@ import (
 	"strconv"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/goccy/go-json"
 	"github.com/urfave/cli/v3"
 
 	"github.com/rocket-pool/smartnode/rocketpool/api/response"
 	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
 )
 
 // RegisterRoutes registers the megapool module's HTTP routes onto mux.
 func RegisterRoutes(mux *http.ServeMux, c *cli.Command) {
+	RegisterProofRoutes(mux, c)
+
 	mux.HandleFunc("/api/megapool/status", func(w http.ResponseWriter, r *http.Request) {
 		finalizedState := r.URL.Query().Get("finalizedState") == "true"
 		resp, err := getStatus(c, finalizedState)
@@ -27,7 +31,7 @@ func RegisterRoutes(mux *http.ServeMux, c *cli.Command) {
 	})
 
 	mux.HandleFunc("/api/megapool/can-claim-refund", func(w http.ResponseWriter, r *http.Request) {
-		resp, err := canClaimRefund(c)
+		resp, err := CanClaimRefund(c)
 		response.WriteResponse(w, resp, err)
 	})
 
@@ -47,7 +51,7 @@ func RegisterRoutes(mux *http.ServeMux, c *cli.Command) {
 			response.WriteErrorResponse(w, err)
 			return
 		}
-		resp, err := canRepayDebt(c, amountWei)
+		resp, err := CanRepayDebt(c, amountWei)
 		response.WriteResponse(w, resp, err)
 	})
 
@@ -363,13 +367,43 @@ func RegisterRoutes(mux *http.ServeMux, c *cli.Command) {
 		response.WriteResponse(w, resp, err)
 	})
 
+	mux.HandleFunc("/api/megapool/validator-history", func(w http.ResponseWriter, r *http.Request) {
+		validatorId, err := parseUint32(r, "validatorId")
+		if err != nil {
+			response.WriteErrorResponse(w, err)
+			return
+		}
+		resp, err := getValidatorHistory(c, validatorId)
+		response.WriteResponse(w, resp, err)
+	})
+
 	mux.HandleFunc("/api/megapool/latest-block-withdrawals", func(w http.ResponseWriter, r *http.Request) {
 		resp, err := getLatestBlockWithdrawals(c)
 		response.WriteResponse(w, resp, err)
 	})
 
 	mux.HandleFunc("/api/megapool/beacon-withdrawal-queue-estimate", func(w http.ResponseWriter, r *http.Request) {
-		resp, err := getBeaconWithdrawalQueueEstimate(c)
+		resp, err := GetBeaconWithdrawalQueueEstimate(c)
+		response.WriteResponse(w, resp, err)
+	})
+
+	mux.HandleFunc("/api/megapool/deposit-data", func(w http.ResponseWriter, r *http.Request) {
+		count, err := parseUint64(r, "count")
+		if err != nil || count == 0 {
+			response.WriteErrorResponse(w, &response.BadRequestError{Err: fmt.Errorf("invalid count: %s", r.URL.Query().Get("count"))})
+			return
+		}
+		resp, err := getDepositData(c, uint(count))
+		response.WriteResponse(w, resp, err)
+	})
+
+	mux.HandleFunc("/api/megapool/verify-deposit-data", func(w http.ResponseWriter, r *http.Request) {
+		var entries []api.DepositDataEntry
+		if err := json.Unmarshal([]byte(r.FormValue("depositData")), &entries); err != nil {
+			response.WriteErrorResponse(w, &response.BadRequestError{Err: fmt.Errorf("invalid deposit data JSON: %w", err)})
+			return
+		}
+		resp, err := verifyDepositData(c, entries)
 		response.WriteResponse(w, resp, err)
 	})
 }
@@ -1,6 +1,7 @@
 package megapool
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -99,6 +100,11 @@ func canStake(c *cli.Command, validatorId uint64) (*api.CanStakeResponse, error)
 		return nil, err
 	}
 
+	// Check the proofs against our own Beacon Node before using them
+	if err := services.VerifyValidatorProof(bc, validatorProof, slotProof); err != nil {
+		return nil, fmt.Errorf("Error verifying the validator proof: %w", err)
+	}
+
 	// Get gas estimate
 	opts, err := w.GetNodeAccountTransactor()
 	if err != nil {
@@ -170,6 +176,11 @@ func stake(c *cli.Command, validatorId uint64, opts *bind.TransactOpts) (*api.St
 		return nil, err
 	}
 
+	// Check the proofs against our own Beacon Node before submitting them
+	if err := services.VerifyValidatorProof(bc, validatorProof, slotProof); err != nil {
+		return nil, fmt.Errorf("Error verifying the validator proof: %w", err)
+	}
+
 	// Stake
 	tx, err := megapool.Stake(rp, megapoolAddress, uint32(validatorId), slotTimestamp, validatorProof, slotProof, opts)
 	if err != nil {
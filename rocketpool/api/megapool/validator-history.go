@@ -0,0 +1,216 @@
+package megapool
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v3"
+
+	"github.com/rocket-pool/smartnode/bindings/megapool"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/bindings/utils"
+	"github.com/rocket-pool/smartnode/rocketpool/api/response"
+	"github.com/rocket-pool/smartnode/shared/math"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getValidatorHistory(c *cli.Command, validatorId uint32) (*api.MegapoolValidatorHistoryResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the node's megapool
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	megapoolDeployed, err := megapool.GetMegapoolDeployed(rp, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !megapoolDeployed {
+		return nil, fmt.Errorf("the node does not have a megapool")
+	}
+	megapoolAddress, err := megapool.GetMegapoolExpectedAddress(rp, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	mp, err := megapool.NewMegaPoolV1(rp, megapoolAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure the validator exists
+	validatorCount, err := mp.GetValidatorCount(nil)
+	if err != nil {
+		return nil, err
+	}
+	if validatorId >= validatorCount {
+		return nil, &response.BadRequestError{Err: fmt.Errorf("megapool %s does not have a validator with ID %d", megapoolAddress.Hex(), validatorId)}
+	}
+
+	// Response
+	response := api.MegapoolValidatorHistoryResponse{
+		ValidatorId:     validatorId,
+		MegapoolAddress: megapoolAddress,
+	}
+	validatorInfo, err := mp.GetValidatorInfoAndPubkey(validatorId, nil)
+	if err != nil {
+		return nil, err
+	}
+	pubkey := types.BytesToValidatorPubkey(validatorInfo.Pubkey)
+	response.Pubkey = pubkey
+
+	// Get the validator's events
+	intervalSize := big.NewInt(int64(cfg.Geth.EventLogInterval))
+	events, err := mp.GetValidatorEvents(validatorId, intervalSize, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the events for validator %d: %w", validatorId, err)
+	}
+
+	// Get the Beacon deposits made by the assignment and stake transactions
+	deposits := map[common.Hash][]utils.DepositData{}
+	for _, event := range events {
+		if event.Event != "MegapoolValidatorAssigned" && event.Event != "MegapoolValidatorStaked" {
+			continue
+		}
+		if _, exists := deposits[event.TxHash]; exists {
+			continue
+		}
+		txDeposits, err := utils.GetDepositsFromTransaction(rp, event.TxHash, pubkey, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error getting the Beacon deposits from transaction %s: %w", event.TxHash.Hex(), err)
+		}
+		deposits[event.TxHash] = txDeposits
+	}
+
+	// Get the validator's Beacon Chain status
+	eth2Config, err := bc.GetEth2Config()
+	if err != nil {
+		return nil, err
+	}
+	status, err := bc.GetValidatorStatus(pubkey, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the Beacon status of validator %s: %w", pubkey.Hex(), err)
+	}
+	if status.Exists {
+		response.ValidatorIndex = status.Index
+		response.BeaconStatus = status.Status
+	}
+
+	response.Events = buildValidatorHistory(eth2Config, events, deposits, validatorInfo.ValidatorInfo, status, time.Now())
+	return &response, nil
+
+}
+
+// Merge the megapool's events for a validator with its Beacon Chain milestones into a single timeline
+func buildValidatorHistory(eth2Config beacon.Eth2Config, events []megapool.ValidatorEvent, deposits map[common.Hash][]utils.DepositData, info megapool.ValidatorInfo, status beacon.ValidatorStatus, now time.Time) []api.MegapoolValidatorHistoryEvent {
+
+	history := []api.MegapoolValidatorHistoryEvent{}
+	addEvent := func(event megapool.ValidatorEvent, step string, description string) {
+		history = append(history, api.MegapoolValidatorHistoryEvent{
+			Step:        step,
+			Description: description,
+			Time:        event.Time,
+			Slot:        timeToSlot(eth2Config, event.Time),
+			BlockNumber: event.BlockNumber,
+			TxHash:      event.TxHash,
+		})
+	}
+	addDeposits := func(event megapool.ValidatorEvent, step string) {
+		for _, deposit := range deposits[event.TxHash] {
+			addEvent(event, step, fmt.Sprintf("Deposited %.6f ETH to the Beacon deposit contract", math.GweiToEth(deposit.Amount)))
+		}
+	}
+
+	// Contract events
+	for _, event := range events {
+		switch event.Event {
+		case "MegapoolValidatorEnqueued":
+			addEvent(event, "Deposit", fmt.Sprintf("Deposited a %.6f ETH bond", float64(info.LastRequestedBond)/1000))
+			addEvent(event, "Queue entry", "Entered the deposit queue")
+		case "MegapoolValidatorDequeued":
+			addEvent(event, "Queue exit", "Removed from the deposit queue")
+		case "MegapoolValidatorAssigned":
+			addEvent(event, "Assignment", "Assigned ETH from the deposit pool")
+			addDeposits(event, "Prestake")
+		case "MegapoolValidatorStaked":
+			addEvent(event, "Stake", "Staked the remaining ETH")
+			addDeposits(event, "Stake deposit")
+		case "MegapoolValidatorDissolved":
+			addEvent(event, "Dissolved", "Dissolved before staking")
+		case "MegapoolValidatorLocked":
+			addEvent(event, "Locked", "Locked pending an exit challenge")
+		case "MegapoolValidatorUnlocked":
+			addEvent(event, "Unlocked", "Unlocked after the exit challenge was resolved")
+		case "MegapoolValidatorExiting":
+			addEvent(event, "Exit notified", "Notified the megapool of the validator's exit")
+		case "MegapoolValidatorExited":
+			addEvent(event, "Final balance", fmt.Sprintf("Proved a final balance of %.6f ETH", math.GweiToEth(info.ExitBalance)))
+			addEvent(event, "Capital returned", "Returned the bond and user capital")
+		}
+	}
+
+	// Beacon Chain milestones
+	if status.Exists {
+		addEpoch := func(epoch uint64, step string, description string) {
+			if epoch == beacon.FarFutureEpoch {
+				return
+			}
+			slot := epoch * eth2Config.SlotsPerEpoch
+			slotTime := time.Unix(int64(eth2Config.GenesisTime+slot*eth2Config.SecondsPerSlot), 0)
+			history = append(history, api.MegapoolValidatorHistoryEvent{
+				Step:        step,
+				Description: fmt.Sprintf(description, epoch),
+				Time:        slotTime,
+				Slot:        slot,
+				Pending:     slotTime.After(now),
+			})
+		}
+		addEpoch(status.ActivationEpoch, "Activation", "Became active on the Beacon Chain at epoch %d")
+		addEpoch(status.ExitEpoch, "Exit request", "Exit requested; leaves the active set at epoch %d")
+		addEpoch(status.WithdrawableEpoch, "Withdrawable", "Balance becomes withdrawable at epoch %d")
+	}
+
+	// Order everything by slot, keeping transactions in the order they were mined
+	sort.SliceStable(history, func(i int, j int) bool {
+		return history[i].Slot < history[j].Slot
+	})
+	return history
+
+}
+
+// Get the slot that was current at the provided time
+func timeToSlot(eth2Config beacon.Eth2Config, t time.Time) uint64 {
+	timestamp := uint64(t.Unix())
+	if timestamp < eth2Config.GenesisTime || eth2Config.SecondsPerSlot == 0 {
+		return 0
+	}
+	return (timestamp - eth2Config.GenesisTime) / eth2Config.SecondsPerSlot
+}
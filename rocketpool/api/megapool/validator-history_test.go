@@ -0,0 +1,80 @@
+package megapool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/bindings/megapool"
+	"github.com/rocket-pool/smartnode/bindings/utils"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+func TestBuildValidatorHistory(t *testing.T) {
+	eth2Config := beacon.Eth2Config{GenesisTime: 1000, SecondsPerSlot: 12, SlotsPerEpoch: 32}
+	slotTime := func(slot uint64) time.Time {
+		return time.Unix(int64(1000+slot*12), 0)
+	}
+	depositTx := common.Hash{0x01}
+	assignTx := common.Hash{0x02}
+	stakeTx := common.Hash{0x03}
+
+	events := []megapool.ValidatorEvent{
+		{Event: "MegapoolValidatorEnqueued", Time: slotTime(100), BlockNumber: 10, TxHash: depositTx},
+		{Event: "MegapoolValidatorAssigned", Time: slotTime(200), BlockNumber: 20, TxHash: assignTx},
+		{Event: "MegapoolValidatorStaked", Time: slotTime(300), BlockNumber: 30, TxHash: stakeTx},
+	}
+	deposits := map[common.Hash][]utils.DepositData{
+		assignTx: {{Amount: 1_000_000_000, TxHash: assignTx}},
+		stakeTx:  {{Amount: 31_000_000_000, TxHash: stakeTx}},
+	}
+	info := megapool.ValidatorInfo{LastRequestedBond: 4000, Staked: true}
+
+	// Activated at epoch 12 (slot 384), with an exit scheduled for epoch 20 (slot 640)
+	status := beacon.ValidatorStatus{
+		Exists:            true,
+		ActivationEpoch:   12,
+		ExitEpoch:         20,
+		WithdrawableEpoch: beacon.FarFutureEpoch,
+	}
+	now := slotTime(500)
+
+	history := buildValidatorHistory(eth2Config, events, deposits, info, status, now)
+	expected := []struct {
+		step    string
+		slot    uint64
+		block   uint64
+		pending bool
+	}{
+		{"Deposit", 100, 10, false},
+		{"Queue entry", 100, 10, false},
+		{"Assignment", 200, 20, false},
+		{"Prestake", 200, 20, false},
+		{"Stake", 300, 30, false},
+		{"Stake deposit", 300, 30, false},
+		{"Activation", 384, 0, false},
+		{"Exit request", 640, 0, true},
+	}
+	if len(history) != len(expected) {
+		t.Fatalf("expected %d steps, got %d: %+v", len(expected), len(history), history)
+	}
+	for i, step := range expected {
+		actual := history[i]
+		if actual.Step != step.step || actual.Slot != step.slot || actual.BlockNumber != step.block || actual.Pending != step.pending {
+			t.Errorf("step %d: expected %+v, got %+v", i, step, actual)
+		}
+	}
+	if history[3].TxHash != assignTx || history[3].Description != "Deposited 1.000000 ETH to the Beacon deposit contract" {
+		t.Errorf("unexpected prestake step: %+v", history[3])
+	}
+	if history[0].Description != "Deposited a 4.000000 ETH bond" {
+		t.Errorf("unexpected deposit step: %+v", history[0])
+	}
+
+	// Validators that haven't reached the Beacon Chain only have contract events
+	history = buildValidatorHistory(eth2Config, events[:1], deposits, info, beacon.ValidatorStatus{}, now)
+	if len(history) != 2 {
+		t.Errorf("expected only the deposit steps, got %+v", history)
+	}
+}
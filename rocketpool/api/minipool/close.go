@@ -97,7 +97,7 @@ func getMinipoolCloseDetailsForNode(c *cli.Command) (*api.GetMinipoolCloseDetail
 			mi := mi
 			wg.Go(func() error {
 				address := addresses[mi]
-				mpDetails, err := getMinipoolCloseDetails(rp, address, nodeAccount.Address, opts)
+				mpDetails, err := GetMinipoolCloseDetails(rp, address, nodeAccount.Address, opts)
 				if err == nil {
 					details[mi] = mpDetails
 				}
@@ -147,7 +147,8 @@ func getMinipoolCloseDetailsForNode(c *cli.Command) (*api.GetMinipoolCloseDetail
 
 }
 
-func getMinipoolCloseDetails(rp *rocketpool.RocketPool, minipoolAddress common.Address, nodeAddress common.Address, opts *bind.TransactOpts) (api.MinipoolCloseDetails, error) {
+// Get the details needed to close one of the node's minipools, including whether it can be closed
+func GetMinipoolCloseDetails(rp *rocketpool.RocketPool, minipoolAddress common.Address, nodeAddress common.Address, opts *bind.TransactOpts) (api.MinipoolCloseDetails, error) {
 
 	// Create minipool
 	mp, err := minipool.NewMinipool(rp, minipoolAddress, nil)
@@ -312,7 +313,8 @@ func getMinipoolCloseDetails(rp *rocketpool.RocketPool, minipoolAddress common.A
 
 }
 
-func closeMinipool(c *cli.Command, minipoolAddress common.Address, opts *bind.TransactOpts, bundle bool) (*api.CloseMinipoolResponse, error) {
+// Close a minipool, distributing its balance first if that hasn't happened yet
+func CloseMinipool(c *cli.Command, minipoolAddress common.Address, opts *bind.TransactOpts, bundle bool) (*api.CloseMinipoolResponse, error) {
 
 	// Get services
 	if err := services.RequireNodeRegistered(c); err != nil {
@@ -59,7 +59,8 @@ func canExitMinipool(c *cli.Command, minipoolAddress common.Address) (*api.CanEx
 
 }
 
-func exitMinipool(c *cli.Command, minipoolAddress common.Address) (*api.ExitMinipoolResponse, error) {
+// Submit a voluntary exit for a minipool's validator
+func ExitMinipool(c *cli.Command, minipoolAddress common.Address) (*api.ExitMinipoolResponse, error) {
 
 	// Get services
 	if err := services.RequireNodeRegistered(c); err != nil {
@@ -0,0 +1,117 @@
+package minipool
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v3"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/migration"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func createMigrationPlan(c *cli.Command, minipoolAddresses []common.Address, useExpressTickets bool) (*api.MinipoolMigrationPlanResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.MinipoolMigrationPlanResponse{}
+
+	// Only one plan can run at a time
+	manager := migration.NewPlanManager(cfg.Smartnode.GetMinipoolMigrationPlanPath())
+	existingPlan, err := manager.Load()
+	if err != nil {
+		return nil, err
+	}
+	if existingPlan != nil && !existingPlan.IsComplete() {
+		return nil, migration.ErrPlanInProgress
+	}
+
+	// Make sure every minipool can be exited
+	if len(minipoolAddresses) == 0 {
+		return nil, fmt.Errorf("no minipools were selected")
+	}
+	for _, minipoolAddress := range minipoolAddresses {
+		canExit, err := canExitMinipool(c, minipoolAddress)
+		if err != nil {
+			return nil, fmt.Errorf("error checking minipool %s: %w", minipoolAddress.Hex(), err)
+		}
+		if !canExit.CanExit {
+			return nil, fmt.Errorf("minipool %s is not staking, so it can't be migrated", minipoolAddress.Hex())
+		}
+	}
+
+	// Save the plan and exit the minipools; any that fail are retried by the node daemon
+	plan := migration.NewPlan(minipoolAddresses, useExpressTickets)
+	err = manager.Create(plan, func(plan *migration.Plan) {
+		for i := range plan.Minipools {
+			if _, err := ExitMinipool(c, plan.Minipools[i].MinipoolAddress); err != nil {
+				plan.Minipools[i].Fail(err)
+				continue
+			}
+			plan.Minipools[i].Advance(migration.StageExiting)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	response.Plan = plan
+	return &response, nil
+
+}
+
+func getMigrationPlan(c *cli.Command) (*api.MinipoolMigrationPlanResponse, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.MinipoolMigrationPlanResponse{}
+
+	// Get the plan
+	response.Plan, err = migration.NewPlanManager(cfg.Smartnode.GetMinipoolMigrationPlanPath()).Load()
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func cancelMigrationPlan(c *cli.Command) (*api.CancelMinipoolMigrationResponse, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.CancelMinipoolMigrationResponse{}
+
+	// Delete the plan
+	if err := migration.NewPlanManager(cfg.Smartnode.GetMinipoolMigrationPlanPath()).Delete(); err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/urfave/cli/v3"
@@ -135,7 +136,28 @@ func RegisterRoutes(mux *http.ServeMux, c *cli.Command) {
 			response.WriteErrorResponse(w, err)
 			return
 		}
-		resp, err := exitMinipool(c, addr)
+		resp, err := ExitMinipool(c, addr)
+		response.WriteResponse(w, resp, err)
+	})
+
+	mux.HandleFunc("/api/minipool/migrate/create-plan", func(w http.ResponseWriter, r *http.Request) {
+		addresses, err := parseAddresses(r, "addresses")
+		if err != nil {
+			response.WriteErrorResponse(w, &response.BadRequestError{Err: err})
+			return
+		}
+		useExpressTickets := r.FormValue("useExpressTickets") == "true"
+		resp, err := createMigrationPlan(c, addresses, useExpressTickets)
+		response.WriteResponse(w, resp, err)
+	})
+
+	mux.HandleFunc("/api/minipool/migrate/status", func(w http.ResponseWriter, r *http.Request) {
+		resp, err := getMigrationPlan(c)
+		response.WriteResponse(w, resp, err)
+	})
+
+	mux.HandleFunc("/api/minipool/migrate/cancel", func(w http.ResponseWriter, r *http.Request) {
+		resp, err := cancelMigrationPlan(c)
 		response.WriteResponse(w, resp, err)
 	})
 
@@ -156,7 +178,7 @@ func RegisterRoutes(mux *http.ServeMux, c *cli.Command) {
 			return
 		}
 		bundle := r.FormValue("bundle") == "true"
-		resp, err := closeMinipool(c, addr, opts, bundle)
+		resp, err := CloseMinipool(c, addr, opts, bundle)
 		response.WriteResponse(w, resp, err)
 	})
 
@@ -354,3 +376,23 @@ func parseAddress(r *http.Request, name string) (common.Address, error) {
 	}
 	return common.HexToAddress(raw), nil
 }
+
+// Parse a comma-separated list of addresses
+func parseAddresses(r *http.Request, name string) ([]common.Address, error) {
+	raw := r.URL.Query().Get(name)
+	if raw == "" {
+		raw = r.FormValue(name)
+	}
+	if raw == "" {
+		return nil, fmt.Errorf("missing required parameter: %s", name)
+	}
+	addresses := []common.Address{}
+	for _, element := range strings.Split(raw, ",") {
+		element = strings.TrimSpace(element)
+		if !common.IsHexAddress(element) {
+			return nil, fmt.Errorf("invalid address in %s: %s", name, element)
+		}
+		addresses = append(addresses, common.HexToAddress(element))
+	}
+	return addresses, nil
+}
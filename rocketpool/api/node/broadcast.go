@@ -0,0 +1,75 @@
+package node
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/urfave/cli/v3"
+
+	hexutils "github.com/rocket-pool/smartnode/shared/hex"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Submit a transaction that was signed offline by the node wallet
+func broadcastTransaction(c *cli.Command, serializedTx string) (*api.BroadcastTransactionResponse, error) {
+
+	// Get services
+	if err := services.RequireEthClientSynced(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.BroadcastTransactionResponse{}
+
+	// Decode the transaction
+	txBytes, err := hex.DecodeString(hexutils.RemovePrefix(serializedTx))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing transaction bytes: %w", err)
+	}
+	var tx types.Transaction
+	if err := tx.UnmarshalBinary(txBytes); err != nil {
+		return nil, fmt.Errorf("error decoding transaction: %w", err)
+	}
+
+	// Make sure it was signed by the node for this network
+	chainID := cfg.Smartnode.GetChainID()
+	if tx.ChainId().Uint64() != uint64(chainID) {
+		return nil, fmt.Errorf("the transaction is for chain %d, but the node is on chain %d", tx.ChainId().Uint64(), chainID)
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), &tx)
+	if err != nil {
+		return nil, fmt.Errorf("error recovering the transaction's sender; it may not be signed: %w", err)
+	}
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	if sender != nodeAccount.Address {
+		return nil, fmt.Errorf("the transaction was signed by %s, but the node address is %s", sender.Hex(), nodeAccount.Address.Hex())
+	}
+
+	// Submit it
+	if err := ec.SendTransaction(context.Background(), &tx); err != nil {
+		return nil, fmt.Errorf("error submitting transaction: %w", err)
+	}
+	response.TxHash = tx.Hash()
+
+	// Return response
+	return &response, nil
+
+}
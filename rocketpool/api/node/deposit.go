@@ -35,7 +35,8 @@ const (
 	ValidatorEth          float64 = 32.0
 )
 
-func canNodeDeposits(c *cli.Command, count uint64, amountWei *big.Int, minNodeFee float64, salt *big.Int, expressTicketsRequested int64) (*api.CanNodeDepositsResponse, error) {
+// Check if the node can create megapool validators with the given total bond
+func CanNodeDeposits(c *cli.Command, count uint64, amountWei *big.Int, minNodeFee float64, salt *big.Int, expressTicketsRequested int64) (*api.CanNodeDepositsResponse, error) {
 
 	// Get services
 	if err := services.RequireNodeRegistered(c); err != nil {
@@ -294,7 +295,8 @@ func canNodeDeposits(c *cli.Command, count uint64, amountWei *big.Int, minNodeFe
 
 }
 
-func nodeDeposits(c *cli.Command, count uint64, amountWei *big.Int, minNodeFee float64, salt *big.Int, useCreditBalance bool, expressTicketsRequested int64, submit bool, opts *bind.TransactOpts) (*api.NodeDepositsResponse, error) {
+// Create megapool validators with the given total bond
+func NodeDeposits(c *cli.Command, count uint64, amountWei *big.Int, minNodeFee float64, salt *big.Int, useCreditBalance bool, expressTicketsRequested int64, submit bool, opts *bind.TransactOpts) (*api.NodeDepositsResponse, error) {
 
 	// Get services
 	if err := services.RequireNodeRegistered(c); err != nil {
@@ -470,8 +472,8 @@ func nodeDeposits(c *cli.Command, count uint64, amountWei *big.Int, minNodeFee f
 		expressTicketsRequested--
 	}
 
-	// Do not send transaction unless requested
-	opts.NoSend = !submit
+	// Do not send transaction unless requested (or it is being exported unsigned)
+	opts.NoSend = opts.NoSend || !submit
 
 	// Make multiple deposits in a single transaction
 	tx, err := node.DepositMulti(rp, deposits, opts)
@@ -0,0 +1,280 @@
+package node
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v3"
+
+	"github.com/rocket-pool/smartnode/bindings/node"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	megapoolapi "github.com/rocket-pool/smartnode/rocketpool/api/megapool"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+const (
+	// The number of epochs between a validator's exit and when its balance can be withdrawn
+	minValidatorWithdrawabilityDelay uint64 = 256
+
+	// The number of withdrawals the beacon chain sweep processes per slot
+	maxWithdrawalsPerPayload uint64 = 16
+)
+
+var (
+	gweiToWei            = big.NewInt(1e9)
+	fullValidatorDeposit = new(big.Int).Mul(big.NewInt(32), big.NewInt(1e18))
+)
+
+// Simulate exiting the selected megapool validators and minipools
+func getExitPlan(c *cli.Command, validatorIds []uint32, allValidators bool, minipoolAddresses []common.Address, allMinipools bool) (*api.NodeExitPlanResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	m, err := services.GetNetworkStateProvider(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeExitPlanResponse{}
+	plan := &response.Plan
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the node's state
+	s, err := m.GetHeadStateForNode(nodeAccount.Address)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the network state: %w", err)
+	}
+	nodeDetails, exists := s.NodeDetailsByAddress[nodeAccount.Address]
+	if !exists {
+		return nil, fmt.Errorf("node %s was not found in the network state", nodeAccount.Address.Hex())
+	}
+
+	// Select the megapool validators
+	megapoolBalances := []*big.Int{}
+	if len(validatorIds) > 0 || allValidators {
+		if !nodeDetails.MegapoolDeployed {
+			return nil, fmt.Errorf("the node does not have a megapool")
+		}
+		selected := map[uint32]bool{}
+		for _, validatorId := range validatorIds {
+			selected[validatorId] = false
+		}
+		for _, pubkey := range s.MegapoolToPubkeysMap[nodeDetails.MegapoolAddress] {
+			info, exists := s.GetMegapoolValidatorInfo(nodeDetails.MegapoolAddress, pubkey)
+			if !exists {
+				continue
+			}
+			if _, isSelected := selected[info.ValidatorId]; !isSelected && !allValidators {
+				continue
+			}
+			validatorStatus := s.MegapoolValidatorDetails[pubkey]
+			canExit := info.ValidatorInfo.Staked && !info.ValidatorInfo.Exiting && !info.ValidatorInfo.Exited && validatorStatus.Exists && validatorStatus.ExitEpoch == beacon.FarFutureEpoch
+			if !canExit {
+				if !allValidators {
+					return nil, fmt.Errorf("megapool validator %d is not an active validator that can be exited", info.ValidatorId)
+				}
+				continue
+			}
+			selected[info.ValidatorId] = true
+			balance := new(big.Int).Mul(new(big.Int).SetUint64(validatorStatus.Balance), gweiToWei)
+			megapoolBalances = append(megapoolBalances, balance)
+			plan.Validators = append(plan.Validators, api.NodeExitPlanValidator{
+				IsMegapool:    true,
+				ValidatorId:   info.ValidatorId,
+				Pubkey:        pubkey,
+				BeaconBalance: balance,
+			})
+		}
+		for validatorId, found := range selected {
+			if !found {
+				return nil, fmt.Errorf("megapool validator %d does not exist", validatorId)
+			}
+		}
+	}
+
+	// Select the minipools
+	plan.MinipoolEthReturned = big.NewInt(0)
+	minipoolUserDeposits := big.NewInt(0)
+	if len(minipoolAddresses) > 0 || allMinipools {
+		selected := map[common.Address]bool{}
+		for _, address := range minipoolAddresses {
+			selected[address] = false
+		}
+		for _, mpd := range s.MinipoolDetailsByNode[nodeAccount.Address] {
+			if _, isSelected := selected[mpd.MinipoolAddress]; !isSelected && !allMinipools {
+				continue
+			}
+			validatorStatus := s.MinipoolValidatorDetails[mpd.Pubkey]
+			canExit := mpd.Exists && mpd.Status == types.Staking && validatorStatus.Exists && validatorStatus.ExitEpoch == beacon.FarFutureEpoch
+			if !canExit {
+				if !allMinipools {
+					return nil, fmt.Errorf("minipool %s is not an active validator that can be exited", mpd.MinipoolAddress.Hex())
+				}
+				continue
+			}
+			selected[mpd.MinipoolAddress] = true
+			nodeShare := new(big.Int).Add(mpd.NodeShareOfBalanceIncludingBeacon, mpd.NodeRefundBalance)
+			plan.MinipoolEthReturned.Add(plan.MinipoolEthReturned, nodeShare)
+			minipoolUserDeposits.Add(minipoolUserDeposits, mpd.UserDepositBalance)
+			plan.Validators = append(plan.Validators, api.NodeExitPlanValidator{
+				MinipoolAddress: mpd.MinipoolAddress,
+				Pubkey:          mpd.Pubkey,
+				BeaconBalance:   new(big.Int).Mul(new(big.Int).SetUint64(validatorStatus.Balance), gweiToWei),
+				NodeShare:       nodeShare,
+			})
+		}
+		for address, found := range selected {
+			if !found {
+				return nil, fmt.Errorf("minipool %s does not belong to the node", address.Hex())
+			}
+		}
+	}
+	if len(plan.Validators) == 0 {
+		return nil, fmt.Errorf("none of the node's validators can be exited")
+	}
+
+	// Work out what the megapool returns once the selected validators are exited
+	plan.MegapoolNodeBond = big.NewInt(0)
+	plan.MegapoolBondAfterExit = big.NewInt(0)
+	plan.MegapoolCapitalReleased = big.NewInt(0)
+	plan.MegapoolUserCapital = big.NewInt(0)
+	plan.MegapoolShortfall = big.NewInt(0)
+	plan.MegapoolRewards = big.NewInt(0)
+	plan.MegapoolNodeRewards = big.NewInt(0)
+	plan.MegapoolDebt = big.NewInt(0)
+	plan.MegapoolDebtAfterExit = big.NewInt(0)
+	plan.MegapoolRefundValue = big.NewInt(0)
+	plan.MegapoolEthReturned = big.NewInt(0)
+	if mpDetails, exists := s.MegapoolDetails[nodeDetails.MegapoolAddress]; nodeDetails.MegapoolDeployed && exists {
+		plan.MegapoolNodeBond.Set(mpDetails.NodeBond)
+		plan.MegapoolBondAfterExit.Set(mpDetails.NodeBond)
+		plan.MegapoolDebt.Set(mpDetails.NodeDebt)
+		plan.MegapoolDebtAfterExit.Set(mpDetails.NodeDebt)
+		plan.MegapoolRefundValue.Set(mpDetails.RefundValue)
+
+		if len(megapoolBalances) > 0 {
+			remaining := int64(mpDetails.ActiveValidatorCount) - int64(len(megapoolBalances))
+			if remaining < 0 {
+				remaining = 0
+			}
+			bondRequirement, err := node.GetBondRequirement(rp, big.NewInt(remaining), nil)
+			if err != nil {
+				return nil, fmt.Errorf("error getting the bond requirement for %d validators: %w", remaining, err)
+			}
+			if bondRequirement.Cmp(mpDetails.NodeBond) < 0 {
+				plan.MegapoolBondAfterExit.Set(bondRequirement)
+				plan.MegapoolCapitalReleased.Sub(mpDetails.NodeBond, bondRequirement)
+			}
+			totalCapital := new(big.Int).Mul(fullValidatorDeposit, big.NewInt(int64(len(megapoolBalances))))
+			plan.MegapoolUserCapital.Sub(totalCapital, plan.MegapoolCapitalReleased)
+
+			// Split anything above the deposit as rewards
+			rewards, shortfall := getMegapoolExitBalances(megapoolBalances)
+			plan.MegapoolRewards.Set(rewards)
+			plan.MegapoolShortfall.Set(shortfall)
+			if rewards.Sign() > 0 {
+				split, err := services.CalculateRewards(rp, rewards, nodeAccount.Address)
+				if err != nil {
+					return nil, fmt.Errorf("error calculating the megapool reward split: %w", err)
+				}
+				if split.RewardSplit.NodeRewards != nil {
+					plan.MegapoolNodeRewards.Set(split.RewardSplit.NodeRewards)
+				}
+			}
+
+			// Any shortfall and existing debt come out of the node's share
+			returned, debt := getMegapoolExitReturn(plan.MegapoolCapitalReleased, plan.MegapoolNodeRewards, plan.MegapoolShortfall, plan.MegapoolDebt)
+			plan.MegapoolEthReturned.Set(returned)
+			plan.MegapoolDebtAfterExit.Set(debt)
+		}
+	}
+	plan.TotalEthReturned = new(big.Int).Add(plan.MegapoolEthReturned, plan.MinipoolEthReturned)
+
+	// Estimate the timing
+	estimate, err := megapoolapi.GetBeaconWithdrawalQueueEstimate(c)
+	if err != nil {
+		return nil, err
+	}
+	plan.ExitQueueSeconds = estimate.EstimatedQueueSeconds
+	plan.WithdrawableDelaySeconds = minValidatorWithdrawabilityDelay * estimate.SecondsPerEpoch
+	plan.SweepSeconds = getWithdrawalSweepSeconds(estimate.SweepValidatorCount, estimate.SecondsPerSlot)
+	plan.ExpectedSeconds = plan.ExitQueueSeconds + plan.WithdrawableDelaySeconds + plan.SweepSeconds/2
+	plan.LatestSeconds = plan.ExitQueueSeconds + plan.WithdrawableDelaySeconds + plan.SweepSeconds
+
+	// Compare the node's RPL rewards eligibility before and after the exits
+	plan.RplStake = s.GetRewardsEligibleRplStake(nodeDetails)
+	plan.EligibleBorrowedEthBefore = s.GetEligibleBorrowedEth(nodeDetails)
+	plan.EligibleBorrowedEthAfter = new(big.Int).Sub(plan.EligibleBorrowedEthBefore, plan.MegapoolUserCapital)
+	plan.EligibleBorrowedEthAfter.Sub(plan.EligibleBorrowedEthAfter, minipoolUserDeposits)
+	if plan.EligibleBorrowedEthAfter.Sign() < 0 {
+		plan.EligibleBorrowedEthAfter.SetUint64(0)
+	}
+	_, plan.RplPercentOfBorrowedEthBefore = s.GetStakedRplValueInEthAndPercentOfBorrowedEth(plan.EligibleBorrowedEthBefore, plan.RplStake)
+	_, plan.RplPercentOfBorrowedEthAfter = s.GetStakedRplValueInEthAndPercentOfBorrowedEth(plan.EligibleBorrowedEthAfter, plan.RplStake)
+	plan.NodeWeightBefore = big.NewInt(0)
+	if plan.EligibleBorrowedEthBefore.Sign() > 0 {
+		plan.NodeWeightBefore = s.GetNodeWeight(plan.EligibleBorrowedEthBefore, plan.RplStake)
+	}
+	plan.NodeWeightAfter = big.NewInt(0)
+	if plan.EligibleBorrowedEthAfter.Sign() > 0 {
+		plan.NodeWeightAfter = s.GetNodeWeight(plan.EligibleBorrowedEthAfter, plan.RplStake)
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Split the balances of exiting megapool validators into the rewards above the deposit and the shortfall below it
+func getMegapoolExitBalances(balances []*big.Int) (*big.Int, *big.Int) {
+	rewards := big.NewInt(0)
+	shortfall := big.NewInt(0)
+	for _, balance := range balances {
+		switch balance.Cmp(fullValidatorDeposit) {
+		case 1:
+			rewards.Add(rewards, new(big.Int).Sub(balance, fullValidatorDeposit))
+		case -1:
+			shortfall.Add(shortfall, new(big.Int).Sub(fullValidatorDeposit, balance))
+		}
+	}
+	return rewards, shortfall
+}
+
+// Get the ETH the node receives from the megapool after the shortfall and debt are covered, and the debt left over
+func getMegapoolExitReturn(capitalReleased *big.Int, nodeRewards *big.Int, shortfall *big.Int, debt *big.Int) (*big.Int, *big.Int) {
+	net := new(big.Int).Add(capitalReleased, nodeRewards)
+	net.Sub(net, shortfall)
+	net.Sub(net, debt)
+	if net.Sign() < 0 {
+		return big.NewInt(0), net.Neg(net)
+	}
+	return net, big.NewInt(0)
+}
+
+// Get the time it takes the withdrawal sweep to visit every validator once
+func getWithdrawalSweepSeconds(validatorCount uint64, secondsPerSlot uint64) uint64 {
+	slots := (validatorCount + maxWithdrawalsPerPayload - 1) / maxWithdrawalsPerPayload
+	return slots * secondsPerSlot
+}
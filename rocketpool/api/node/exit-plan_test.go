@@ -0,0 +1,57 @@
+package node
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func eth(amount float64) *big.Int {
+	gwei := big.NewInt(int64(math.Round(amount * 1e9)))
+	return gwei.Mul(gwei, gweiToWei)
+}
+
+func TestGetMegapoolExitBalances(t *testing.T) {
+	rewards, shortfall := getMegapoolExitBalances([]*big.Int{eth(32.5), eth(31.75), eth(32), eth(33)})
+	if rewards.Cmp(eth(1.5)) != 0 {
+		t.Errorf("expected 1.5 ETH of rewards, got %s", rewards)
+	}
+	if shortfall.Cmp(eth(0.25)) != 0 {
+		t.Errorf("expected 0.25 ETH of shortfall, got %s", shortfall)
+	}
+}
+
+func TestGetMegapoolExitReturn(t *testing.T) {
+	testCases := []struct {
+		name            string
+		capitalReleased *big.Int
+		nodeRewards     *big.Int
+		shortfall       *big.Int
+		debt            *big.Int
+		returned        *big.Int
+		debtAfter       *big.Int
+	}{
+		{"no deductions", eth(4), eth(0.1), eth(0), eth(0), eth(4.1), eth(0)},
+		{"shortfall and debt", eth(4), eth(0), eth(0.5), eth(1), eth(2.5), eth(0)},
+		{"debt larger than the return", eth(4), eth(0), eth(1), eth(5), eth(0), eth(2)},
+	}
+	for _, tc := range testCases {
+		returned, debtAfter := getMegapoolExitReturn(tc.capitalReleased, tc.nodeRewards, tc.shortfall, tc.debt)
+		if returned.Cmp(tc.returned) != 0 {
+			t.Errorf("%s: expected %s returned, got %s", tc.name, tc.returned, returned)
+		}
+		if debtAfter.Cmp(tc.debtAfter) != 0 {
+			t.Errorf("%s: expected %s debt left, got %s", tc.name, tc.debtAfter, debtAfter)
+		}
+	}
+}
+
+func TestGetWithdrawalSweepSeconds(t *testing.T) {
+	// 1,000,001 validators at 16 per slot take 62,501 slots
+	if seconds := getWithdrawalSweepSeconds(1_000_001, 12); seconds != 62_501*12 {
+		t.Errorf("expected %d seconds, got %d", 62_501*12, seconds)
+	}
+	if seconds := getWithdrawalSweepSeconds(0, 12); seconds != 0 {
+		t.Errorf("expected 0 seconds, got %d", seconds)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/urfave/cli/v3"
@@ -501,7 +502,7 @@ func RegisterRoutes(mux *http.ServeMux, c *cli.Command) {
 			response.WriteErrorResponse(w, err)
 			return
 		}
-		resp, err := canNodeDeposits(c, params.count, params.amountWei, params.minFee, params.salt, params.expressTickets)
+		resp, err := CanNodeDeposits(c, params.count, params.amountWei, params.minFee, params.salt, params.expressTickets)
 		response.WriteResponse(w, resp, err)
 	})
 
@@ -516,7 +517,7 @@ func RegisterRoutes(mux *http.ServeMux, c *cli.Command) {
 			response.WriteErrorResponse(w, err)
 			return
 		}
-		resp, err := nodeDeposits(c, params.count, params.amountWei, params.minFee, params.salt, params.useCreditBalance, params.expressTickets, params.submit, opts)
+		resp, err := NodeDeposits(c, params.count, params.amountWei, params.minFee, params.salt, params.useCreditBalance, params.expressTickets, params.submit, opts)
 		response.WriteResponse(w, resp, err)
 	})
 
@@ -751,6 +752,12 @@ func RegisterRoutes(mux *http.ServeMux, c *cli.Command) {
 		response.WriteResponse(w, resp, err)
 	})
 
+	mux.HandleFunc("/api/node/broadcast", func(w http.ResponseWriter, r *http.Request) {
+		serializedTx := r.FormValue("serializedTx")
+		resp, err := broadcastTransaction(c, serializedTx)
+		response.WriteResponse(w, resp, err)
+	})
+
 	// --- Vacant minipool ---
 
 	mux.HandleFunc("/api/node/can-create-vacant-minipool", func(w http.ResponseWriter, r *http.Request) {
@@ -864,6 +871,50 @@ func RegisterRoutes(mux *http.ServeMux, c *cli.Command) {
 		resp, err := getBondRequirement(c, numValidators)
 		response.WriteResponse(w, resp, err)
 	})
+
+	// --- Watch list ---
+
+	mux.HandleFunc("/api/node/watched-nodes", func(w http.ResponseWriter, r *http.Request) {
+		resp, err := getWatchedNodesStatus(c)
+		response.WriteResponse(w, resp, err)
+	})
+
+	mux.HandleFunc("/api/node/watch-nodes", func(w http.ResponseWriter, r *http.Request) {
+		addresses, err := parseNodeAddresses(r, "addresses")
+		if err != nil {
+			response.WriteErrorResponse(w, &response.BadRequestError{Err: err})
+			return
+		}
+		resp, err := watchNodes(c, addresses)
+		response.WriteResponse(w, resp, err)
+	})
+
+	mux.HandleFunc("/api/node/unwatch-nodes", func(w http.ResponseWriter, r *http.Request) {
+		addresses, err := parseNodeAddresses(r, "addresses")
+		if err != nil {
+			response.WriteErrorResponse(w, &response.BadRequestError{Err: err})
+			return
+		}
+		resp, err := unwatchNodes(c, addresses)
+		response.WriteResponse(w, resp, err)
+	})
+
+	// --- Exit plan ---
+
+	mux.HandleFunc("/api/node/exit-plan", func(w http.ResponseWriter, r *http.Request) {
+		validatorIds, allValidators, err := parseExitPlanValidatorIds(r, "validatorIds")
+		if err != nil {
+			response.WriteErrorResponse(w, &response.BadRequestError{Err: err})
+			return
+		}
+		minipools, allMinipools, err := parseExitPlanMinipools(r, "minipools")
+		if err != nil {
+			response.WriteErrorResponse(w, &response.BadRequestError{Err: err})
+			return
+		}
+		resp, err := getExitPlan(c, validatorIds, allValidators, minipools, allMinipools)
+		response.WriteResponse(w, resp, err)
+	})
 }
 
 // --- Helper types and functions ---
@@ -1000,3 +1051,56 @@ func parseNodeFloat64(r *http.Request, name string) (float64, error) {
 	}
 	return strconv.ParseFloat(raw, 64)
 }
+
+// Parse a comma-separated list of addresses
+func parseNodeAddresses(r *http.Request, name string) ([]common.Address, error) {
+	raw := r.URL.Query().Get(name)
+	if raw == "" {
+		raw = r.FormValue(name)
+	}
+	if raw == "" {
+		return nil, fmt.Errorf("missing required parameter: %s", name)
+	}
+	addresses := []common.Address{}
+	for _, element := range strings.Split(raw, ",") {
+		element = strings.TrimSpace(element)
+		if !common.IsHexAddress(element) {
+			return nil, fmt.Errorf("invalid address in %s: %s", name, element)
+		}
+		addresses = append(addresses, common.HexToAddress(element))
+	}
+	return addresses, nil
+}
+
+// Parse an optional comma-separated list of megapool validator IDs, or "all"
+func parseExitPlanValidatorIds(r *http.Request, name string) ([]uint32, bool, error) {
+	raw := strings.TrimSpace(r.URL.Query().Get(name))
+	if raw == "" {
+		return nil, false, nil
+	}
+	if strings.EqualFold(raw, "all") {
+		return nil, true, nil
+	}
+	validatorIds := []uint32{}
+	for _, element := range strings.Split(raw, ",") {
+		validatorId, err := strconv.ParseUint(strings.TrimSpace(element), 10, 32)
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid validator ID in %s: %s", name, element)
+		}
+		validatorIds = append(validatorIds, uint32(validatorId))
+	}
+	return validatorIds, false, nil
+}
+
+// Parse an optional comma-separated list of minipool addresses, or "all"
+func parseExitPlanMinipools(r *http.Request, name string) ([]common.Address, bool, error) {
+	raw := strings.TrimSpace(r.URL.Query().Get(name))
+	if raw == "" {
+		return nil, false, nil
+	}
+	if strings.EqualFold(raw, "all") {
+		return nil, true, nil
+	}
+	addresses, err := parseNodeAddresses(r, name)
+	return addresses, false, err
+}
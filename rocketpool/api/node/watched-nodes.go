@@ -0,0 +1,206 @@
+package node
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v3"
+
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	rprewards "github.com/rocket-pool/smartnode/shared/services/rewards"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getWatchedNodesStatus(c *cli.Command) (*api.WatchedNodesStatusResponse, error) {
+
+	// Get services
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.WatchedNodesStatusResponse{
+		Nodes: []api.WatchedNodeStatus{},
+	}
+
+	// Get the watch list
+	addresses, err := wallet.NewWatchListManager(cfg.Smartnode.GetWatchedNodesPath()).LoadAddresses()
+	if err != nil {
+		return nil, err
+	}
+	if len(addresses) == 0 {
+		return &response, nil
+	}
+
+	// Get the state of every watched node at once
+	m, err := services.GetNetworkStateProvider(c)
+	if err != nil {
+		return nil, err
+	}
+	watchedState, err := m.GetHeadStateForNodes(addresses)
+	if err != nil {
+		return nil, fmt.Errorf("error getting watched node state: %w", err)
+	}
+	response.ElBlockNumber = watchedState.ElBlockNumber
+
+	for _, address := range addresses {
+		response.Nodes = append(response.Nodes, getWatchedNodeStatus(watchedState, address))
+	}
+
+	// Add the rewards each node can claim
+	if err := addWatchedNodesUnclaimedRewards(rp, cfg, response.Nodes); err != nil {
+		return nil, fmt.Errorf("error getting watched node rewards: %w", err)
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func watchNodes(c *cli.Command, addresses []common.Address) (*api.WatchNodesResponse, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.WatchNodesResponse{}
+
+	// Add the nodes
+	watchList := wallet.NewWatchListManager(cfg.Smartnode.GetWatchedNodesPath())
+	response.AddedNodes, err = watchList.AddAddresses(addresses)
+	if err != nil {
+		return nil, err
+	}
+	response.WatchedNodes = watchList.GetAddresses()
+
+	// Return response
+	return &response, nil
+
+}
+
+func unwatchNodes(c *cli.Command, addresses []common.Address) (*api.UnwatchNodesResponse, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.UnwatchNodesResponse{}
+
+	// Remove the nodes
+	watchList := wallet.NewWatchListManager(cfg.Smartnode.GetWatchedNodesPath())
+	response.RemovedNodes, err = watchList.RemoveAddresses(addresses)
+	if err != nil {
+		return nil, err
+	}
+	response.WatchedNodes = watchList.GetAddresses()
+
+	// Return response
+	return &response, nil
+
+}
+
+// Summarize a single watched node from a network state that includes it.
+// Values the node doesn't have, e.g. because it has no megapool, are reported as zero.
+func getWatchedNodeStatus(s *state.NetworkState, address common.Address) api.WatchedNodeStatus {
+	status := api.WatchedNodeStatus{
+		NodeAddress:            address,
+		EthBalance:             big.NewInt(0),
+		RplBalance:             big.NewInt(0),
+		RplStake:               big.NewInt(0),
+		EthBonded:              big.NewInt(0),
+		EthBorrowed:            big.NewInt(0),
+		FeeDistributorShare:    big.NewInt(0),
+		MegapoolPendingRewards: big.NewInt(0),
+		MegapoolRefundValue:    big.NewInt(0),
+		MegapoolDebt:           big.NewInt(0),
+		UnclaimedRplRewards:    big.NewInt(0),
+		UnclaimedEthRewards:    big.NewInt(0),
+	}
+	node, exists := s.NodeDetailsByAddress[address]
+	if !exists || !node.Exists {
+		return status
+	}
+
+	status.Registered = true
+	status.TimezoneLocation = node.TimezoneLocation
+	status.WithdrawalAddress = node.WithdrawalAddress
+	status.EthBalance = valueOrZero(node.BalanceETH)
+	status.RplBalance = valueOrZero(node.BalanceRPL)
+	status.RplStake = big.NewInt(0).Add(valueOrZero(node.LegacyStakedRPL), valueOrZero(node.MegapoolStakedRPL))
+	status.EthBonded = valueOrZero(node.EthBonded)
+	status.EthBorrowed = valueOrZero(node.EthBorrowed)
+	if node.MinipoolCount != nil {
+		status.MinipoolCount = node.MinipoolCount.Uint64()
+	}
+	status.SmoothingPoolRegistered = node.SmoothingPoolRegistrationState
+	status.FeeDistributorShare = valueOrZero(node.DistributorBalanceNodeETH)
+	status.MegapoolDeployed = node.MegapoolDeployed
+	status.Validators = s.GetNodeValidatorCounts(address)
+
+	if node.MegapoolDeployed {
+		status.MegapoolAddress = node.MegapoolAddress
+		if megapool, exists := s.MegapoolDetails[node.MegapoolAddress]; exists {
+			status.MegapoolValidatorCount = megapool.ValidatorCount
+			status.MegapoolPendingRewards = valueOrZero(megapool.PendingRewards)
+			status.MegapoolRefundValue = valueOrZero(megapool.RefundValue)
+			status.MegapoolDebt = valueOrZero(megapool.NodeDebt)
+		}
+	}
+
+	return status
+}
+
+// Add up the RPL and ETH each registered node can claim from the rewards intervals it hasn't claimed yet.
+// Intervals whose rewards file isn't available locally can't be counted, so they're reported instead.
+func addWatchedNodesUnclaimedRewards(rp *rocketpool.RocketPool, cfg *config.RocketPoolConfig, nodes []api.WatchedNodeStatus) error {
+	addresses := []common.Address{}
+	for _, node := range nodes {
+		if node.Registered {
+			addresses = append(addresses, node.NodeAddress)
+		}
+	}
+	unclaimedRewards, err := rprewards.GetUnclaimedRewards(rp, cfg, addresses)
+	if err != nil {
+		return err
+	}
+	for i := range nodes {
+		rewards, exists := unclaimedRewards[nodes[i].NodeAddress]
+		if !exists {
+			continue
+		}
+		nodes[i].UnclaimedRplRewards = rewards.Rpl
+		nodes[i].UnclaimedEthRewards = rewards.Eth
+		nodes[i].MissingRewardsIntervals = rewards.MissingIntervals
+	}
+	return nil
+}
+
+// Get a value from the network state, or zero if it wasn't loaded
+func valueOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+	return value
+}
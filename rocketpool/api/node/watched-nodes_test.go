@@ -0,0 +1,55 @@
+package node
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	rpstate "github.com/rocket-pool/smartnode/bindings/utils/state"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+)
+
+func TestGetWatchedNodeStatusFillsZeros(t *testing.T) {
+	registered := common.HexToAddress("0xAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA")
+	unregistered := common.HexToAddress("0xBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBB")
+	networkState := &state.NetworkState{
+		NodeDetailsByAddress: map[common.Address]*rpstate.NativeNodeDetails{
+			// A node with a megapool whose details failed to load, and no fee distributor balance
+			registered: {
+				Exists:           true,
+				NodeAddress:      registered,
+				BalanceETH:       big.NewInt(5),
+				MegapoolDeployed: true,
+				MegapoolAddress:  common.HexToAddress("0x01"),
+			},
+		},
+	}
+
+	for _, address := range []common.Address{registered, unregistered} {
+		status := getWatchedNodeStatus(networkState, address)
+		values := map[string]*big.Int{
+			"ETH balance":              status.EthBalance,
+			"RPL balance":              status.RplBalance,
+			"RPL stake":                status.RplStake,
+			"ETH bonded":               status.EthBonded,
+			"ETH borrowed":             status.EthBorrowed,
+			"fee distributor share":    status.FeeDistributorShare,
+			"megapool pending rewards": status.MegapoolPendingRewards,
+			"megapool refund":          status.MegapoolRefundValue,
+			"megapool debt":            status.MegapoolDebt,
+			"unclaimed RPL":            status.UnclaimedRplRewards,
+			"unclaimed ETH":            status.UnclaimedEthRewards,
+		}
+		for name, value := range values {
+			if value == nil {
+				t.Errorf("%s: expected the %s to be filled in", address.Hex(), name)
+			}
+		}
+	}
+
+	status := getWatchedNodeStatus(networkState, registered)
+	if !status.Registered || status.EthBalance.Cmp(big.NewInt(5)) != 0 {
+		t.Errorf("expected the loaded values to be kept, got %+v", status)
+	}
+}
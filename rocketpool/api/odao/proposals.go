@@ -1,11 +1,14 @@
 package odao
 
 import (
+	"fmt"
+
 	"github.com/urfave/cli/v3"
 
 	"github.com/rocket-pool/smartnode/bindings/dao"
 
 	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/proposals"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
 
@@ -88,3 +91,43 @@ func getProposal(c *cli.Command, id uint64) (*api.TNDAOProposalResponse, error)
 	return &response, nil
 
 }
+
+func getProposalDiff(c *cli.Command, id uint64) (*api.TNDAOProposalDiffResponse, error) {
+
+	// Get services
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.TNDAOProposalDiffResponse{
+		ProposalID: id,
+	}
+
+	// Make sure the proposal belongs to the Oracle DAO
+	daoName, err := dao.GetProposalDAO(rp, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	if daoName != proposals.OracleDAOProposalsContractName {
+		return nil, fmt.Errorf("proposal %d is not a Oracle DAO proposal", id)
+	}
+
+	// Decode the payload
+	payload, err := dao.GetProposalPayload(rp, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	response.Diff, err = proposals.GetDAOProposalDiff(rp, daoName, payload, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
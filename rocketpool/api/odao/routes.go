@@ -40,6 +40,26 @@ func RegisterRoutes(mux *http.ServeMux, c *cli.Command) {
 		response.WriteResponse(w, resp, err)
 	})
 
+	mux.HandleFunc("/api/odao/proposal-diff", func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseUint64(r, "id")
+		if err != nil {
+			response.WriteErrorResponse(w, err)
+			return
+		}
+		resp, err := getProposalDiff(c, id)
+		response.WriteResponse(w, resp, err)
+	})
+
+	mux.HandleFunc("/api/odao/duties", func(w http.ResponseWriter, r *http.Request) {
+		lookbackDays, err := parseUint64(r, "lookbackDays")
+		if err != nil {
+			response.WriteErrorResponse(w, err)
+			return
+		}
+		resp, err := getDuties(c, lookbackDays)
+		response.WriteResponse(w, resp, err)
+	})
+
 	mux.HandleFunc("/api/odao/can-propose-invite", func(w http.ResponseWriter, r *http.Request) {
 		addr, memberId, memberUrl, err := parseInviteParams(r)
 		if err != nil {
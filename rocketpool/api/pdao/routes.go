@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/urfave/cli/v3"
 
 	bindtypes "github.com/rocket-pool/smartnode/bindings/types"
@@ -177,6 +178,51 @@ func RegisterRoutes(mux *http.ServeMux, c *cli.Command) {
 		response.WriteResponse(w, resp, err)
 	})
 
+	mux.HandleFunc("/api/pdao/simulate-setting-multi", func(w http.ResponseWriter, r *http.Request) {
+		settings, _, err := parseBatchSettings(r)
+		if err != nil {
+			response.WriteErrorResponse(w, err)
+			return
+		}
+		resp, err := simulateSettingMulti(c, settings)
+		response.WriteResponse(w, resp, err)
+	})
+
+	mux.HandleFunc("/api/pdao/export-voting-tree", func(w http.ResponseWriter, r *http.Request) {
+		blockNumber, err := parseUint32Param(r, "blockNumber")
+		if err != nil {
+			response.WriteErrorResponse(w, err)
+			return
+		}
+		var nodeAddress *common.Address
+		if raw := paramVal(r, "address"); raw != "" {
+			addr := common.HexToAddress(raw)
+			nodeAddress = &addr
+		}
+		resp, err := exportVotingTree(c, blockNumber, nodeAddress)
+		response.WriteResponse(w, resp, err)
+	})
+
+	mux.HandleFunc("/api/pdao/verify-voting-tree", func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseUint64Param(r, "id")
+		if err != nil {
+			response.WriteErrorResponse(w, err)
+			return
+		}
+		resp, err := verifyVotingTree(c, id)
+		response.WriteResponse(w, resp, err)
+	})
+
+	mux.HandleFunc("/api/pdao/voting-power", func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseUint64Param(r, "id")
+		if err != nil {
+			response.WriteErrorResponse(w, err)
+			return
+		}
+		resp, err := getVotingPower(c, id)
+		response.WriteResponse(w, resp, err)
+	})
+
 	mux.HandleFunc("/api/pdao/get-rewards-percentages", func(w http.ResponseWriter, r *http.Request) {
 		resp, err := getRewardsPercentages(c)
 		response.WriteResponse(w, resp, err)
@@ -192,6 +238,16 @@ func RegisterRoutes(mux *http.ServeMux, c *cli.Command) {
 		response.WriteResponse(w, resp, err)
 	})
 
+	mux.HandleFunc("/api/pdao/simulate-rewards-percentages", func(w http.ResponseWriter, r *http.Request) {
+		node, odaoAmt, pdaoAmt, err := parseRewardPercentages(r)
+		if err != nil {
+			response.WriteErrorResponse(w, err)
+			return
+		}
+		resp, err := simulateRewardsPercentages(c, node, odaoAmt, pdaoAmt)
+		response.WriteResponse(w, resp, err)
+	})
+
 	mux.HandleFunc("/api/pdao/propose-rewards-percentages", func(w http.ResponseWriter, r *http.Request) {
 		node, odaoAmt, pdaoAmt, err := parseRewardPercentages(r)
 		if err != nil {
@@ -551,6 +607,41 @@ func RegisterRoutes(mux *http.ServeMux, c *cli.Command) {
 		response.WriteResponse(w, resp, err)
 	})
 
+	mux.HandleFunc("/api/pdao/can-snapshot-vote", func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseHashParam(r, "id")
+		if err != nil {
+			response.WriteErrorResponse(w, err)
+			return
+		}
+		resp, err := canSnapshotVote(c, id)
+		response.WriteResponse(w, resp, err)
+	})
+
+	mux.HandleFunc("/api/pdao/snapshot-vote", func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseHashParam(r, "id")
+		if err != nil {
+			response.WriteErrorResponse(w, err)
+			return
+		}
+		choice, err := parseUint32Param(r, "choice")
+		if err != nil {
+			response.WriteErrorResponse(w, err)
+			return
+		}
+		// Votes signed with the signalling key carry the timestamp they were signed with
+		signature := paramVal(r, "signature")
+		timestamp := uint64(time.Now().Unix())
+		if signature != "" {
+			timestamp, err = parseUint64Param(r, "timestamp")
+			if err != nil {
+				response.WriteErrorResponse(w, err)
+				return
+			}
+		}
+		resp, err := snapshotVote(c, id, choice, paramVal(r, "reason"), timestamp, signature)
+		response.WriteResponse(w, resp, err)
+	})
+
 	mux.HandleFunc("/api/pdao/can-propose-allow-listed-controllers", func(w http.ResponseWriter, r *http.Request) {
 		addressList := paramVal(r, "addressList")
 		addresses, err := parseAddressList(r, "addressList")
@@ -609,6 +700,15 @@ func parseUint32Param(r *http.Request, name string) (uint32, error) {
 	return uint32(val), nil
 }
 
+func parseHashParam(r *http.Request, name string) (common.Hash, error) {
+	raw := paramVal(r, name)
+	val, err := hexutil.Decode(raw)
+	if err != nil || len(val) != common.HashLength {
+		return common.Hash{}, fmt.Errorf("invalid %s: %s", name, raw)
+	}
+	return common.BytesToHash(val), nil
+}
+
 func parseProposalVoteParams(r *http.Request) (uint64, bindtypes.VoteDirection, error) {
 	id, err := parseUint64Param(r, "id")
 	if err != nil {
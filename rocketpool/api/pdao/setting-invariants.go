@@ -0,0 +1,167 @@
+package pdao
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/rocket-pool/smartnode/bindings/settings/protocol"
+)
+
+// A protocol DAO setting, identified by its contract and path
+type settingRef struct {
+	contract string
+	setting  string
+}
+
+// The rewards claimer percentages aren't stored under setting paths, so they're read with the rewards settings contract's getters
+var (
+	rewardsNodePercentage = settingRef{protocol.RewardsSettingsContractName, "node operator rewards percentage"}
+	rewardsOdaoPercentage = settingRef{protocol.RewardsSettingsContractName, "Oracle DAO rewards percentage"}
+	rewardsPdaoPercentage = settingRef{protocol.RewardsSettingsContractName, "Protocol DAO rewards percentage"}
+)
+
+// The getters for settings that aren't stored under a setting path
+var settingGetters = map[settingRef]string{
+	rewardsNodePercentage: "getRewardsClaimersNodePerc",
+	rewardsOdaoPercentage: "getRewardsClaimersTrustedNodePerc",
+	rewardsPdaoPercentage: "getRewardsClaimersProtocolPerc",
+}
+
+// A relationship between uint256 settings that the protocol and the Smart Node rely on
+type settingInvariant struct {
+	// Describes the problem when the invariant is broken
+	violation string
+
+	// The settings the invariant covers, in the order they're passed to holds
+	settings []settingRef
+
+	// Checks the invariant against the values of its settings
+	holds func(values []*big.Int) bool
+}
+
+var oneHundredPercent = big.NewInt(1e18)
+
+// Checks that the values are in ascending order
+func isAscending(values []*big.Int) bool {
+	for i := 1; i < len(values); i++ {
+		if values[i-1].Cmp(values[i]) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Checks that the values add up to no more than 100%
+func isAtMostOneHundredPercent(values []*big.Int) bool {
+	total := big.NewInt(0)
+	for _, value := range values {
+		total.Add(total, value)
+	}
+	return total.Cmp(oneHundredPercent) <= 0
+}
+
+// Checks that the values add up to exactly 100%
+func isOneHundredPercent(values []*big.Int) bool {
+	total := big.NewInt(0)
+	for _, value := range values {
+		total.Add(total, value)
+	}
+	return total.Cmp(oneHundredPercent) == 0
+}
+
+// Create an invariant for a setting that is a percentage
+func newPercentageInvariant(contract string, setting string) settingInvariant {
+	return settingInvariant{
+		violation: fmt.Sprintf("%s is more than 100%%", setting),
+		settings:  []settingRef{{contract, setting}},
+		holds:     isAtMostOneHundredPercent,
+	}
+}
+
+var settingInvariants = []settingInvariant{
+	{
+		violation: "the node operator, Oracle DAO and Protocol DAO rewards percentages don't add up to 100%",
+		settings:  []settingRef{rewardsNodePercentage, rewardsOdaoPercentage, rewardsPdaoPercentage},
+		holds:     isOneHundredPercent,
+	},
+	{
+		violation: "the node, voter and pDAO shares of the rETH commission add up to more than 100%",
+		settings: []settingRef{
+			{protocol.NetworkSettingsContractName, protocol.NetworkNodeCommissionSharePath},
+			{protocol.NetworkSettingsContractName, protocol.NetworkVoterSharePath},
+			{protocol.NetworkSettingsContractName, protocol.NetworkPDAOSharePath},
+		},
+		holds: isAtMostOneHundredPercent,
+	},
+	{
+		violation: "the security council's node commission share adder is more than its maximum",
+		settings: []settingRef{
+			{protocol.NetworkSettingsContractName, protocol.NetworkNodeCommissionShareSecurityCouncilAdderPath},
+			{protocol.NetworkSettingsContractName, protocol.NetworkMaxNodeShareSecurityCouncilAdderPath},
+		},
+		holds: isAscending,
+	},
+	{
+		violation: "the minimum, target and maximum node fees are out of order",
+		settings: []settingRef{
+			{protocol.NetworkSettingsContractName, protocol.MinimumNodeFeeSettingPath},
+			{protocol.NetworkSettingsContractName, protocol.TargetNodeFeeSettingPath},
+			{protocol.NetworkSettingsContractName, protocol.MaximumNodeFeeSettingPath},
+		},
+		holds: isAscending,
+	},
+	{
+		violation: "the minimum auction lot value is more than the maximum lot value",
+		settings: []settingRef{
+			{protocol.AuctionSettingsContractName, protocol.LotMinimumEthValueSettingPath},
+			{protocol.AuctionSettingsContractName, protocol.LotMaximumEthValueSettingPath},
+		},
+		holds: isAscending,
+	},
+	{
+		violation: "the auction lot reserve price ratio is more than the starting price ratio",
+		settings: []settingRef{
+			{protocol.AuctionSettingsContractName, protocol.LotReservePriceRatioSettingPath},
+			{protocol.AuctionSettingsContractName, protocol.LotStartingPriceRatioSettingPath},
+		},
+		holds: isAscending,
+	},
+	newPercentageInvariant(protocol.NetworkSettingsContractName, protocol.NodeConsensusThresholdSettingPath),
+	newPercentageInvariant(protocol.DepositSettingsContractName, protocol.DepositFeeSettingPath),
+	newPercentageInvariant(protocol.ProposalsSettingsContractName, protocol.ProposalQuorumSettingPath),
+	newPercentageInvariant(protocol.ProposalsSettingsContractName, protocol.ProposalVetoQuorumSettingPath),
+	newPercentageInvariant(protocol.SecuritySettingsContractName, protocol.SecurityMembersQuorumSettingPath),
+}
+
+// Get the invariants that cover at least one of the changed settings
+func getAffectedInvariants(changed map[settingRef]bool) []settingInvariant {
+	affected := []settingInvariant{}
+	for _, invariant := range settingInvariants {
+		for _, setting := range invariant.settings {
+			if changed[setting] {
+				affected = append(affected, invariant)
+				break
+			}
+		}
+	}
+	return affected
+}
+
+// Get the violations of the invariants, given the values of all of the settings they cover
+func getInvariantViolations(invariants []settingInvariant, values map[settingRef]*big.Int) ([]string, error) {
+	violations := []string{}
+	for _, invariant := range invariants {
+		invariantValues := make([]*big.Int, len(invariant.settings))
+		for i, setting := range invariant.settings {
+			value, exists := values[setting]
+			if !exists {
+				return nil, fmt.Errorf("missing value for setting %s / %s", setting.contract, setting.setting)
+			}
+			invariantValues[i] = value
+		}
+		if !invariant.holds(invariantValues) {
+			violations = append(violations, invariant.violation)
+		}
+	}
+	return violations, nil
+}
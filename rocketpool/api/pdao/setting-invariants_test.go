@@ -0,0 +1,104 @@
+package pdao
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/bindings/settings/protocol"
+)
+
+func percent(value int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(value), big.NewInt(1e16))
+}
+
+func TestSettingInvariants(t *testing.T) {
+	nodeShare := settingRef{protocol.NetworkSettingsContractName, protocol.NetworkNodeCommissionSharePath}
+	voterShare := settingRef{protocol.NetworkSettingsContractName, protocol.NetworkVoterSharePath}
+	pdaoShare := settingRef{protocol.NetworkSettingsContractName, protocol.NetworkPDAOSharePath}
+	quorum := settingRef{protocol.ProposalsSettingsContractName, protocol.ProposalQuorumSettingPath}
+
+	invariants := getAffectedInvariants(map[settingRef]bool{voterShare: true, quorum: true})
+	if len(invariants) != 2 {
+		t.Fatalf("got %d affected invariants, want 2", len(invariants))
+	}
+
+	values := map[settingRef]*big.Int{
+		nodeShare:  percent(5),
+		voterShare: percent(9),
+		pdaoShare:  percent(0),
+		quorum:     percent(51),
+	}
+	violations, err := getInvariantViolations(invariants, values)
+	if err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if len(violations) != 0 {
+		t.Fatalf("unexpected violations: %v", violations)
+	}
+
+	values[voterShare] = percent(96)
+	values[quorum] = percent(101)
+	violations, err = getInvariantViolations(invariants, values)
+	if err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if len(violations) != 2 {
+		t.Fatalf("got violations %v, want the commission shares and the quorum", violations)
+	}
+
+	delete(values, pdaoShare)
+	if _, err := getInvariantViolations(invariants, values); err == nil {
+		t.Fatal("expected an error for a missing setting value")
+	}
+}
+
+func TestNodeFeeInvariant(t *testing.T) {
+	target := settingRef{protocol.NetworkSettingsContractName, protocol.TargetNodeFeeSettingPath}
+	invariants := getAffectedInvariants(map[settingRef]bool{target: true})
+	if len(invariants) != 1 {
+		t.Fatalf("got %d affected invariants, want 1", len(invariants))
+	}
+
+	values := map[settingRef]*big.Int{
+		{protocol.NetworkSettingsContractName, protocol.MinimumNodeFeeSettingPath}: percent(5),
+		target: percent(20),
+		{protocol.NetworkSettingsContractName, protocol.MaximumNodeFeeSettingPath}: percent(15),
+	}
+	violations, err := getInvariantViolations(invariants, values)
+	if err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if len(violations) != 1 {
+		t.Fatalf("got violations %v, want the node fee order", violations)
+	}
+}
+
+func TestRewardsPercentagesInvariant(t *testing.T) {
+	invariants := getAffectedInvariants(map[settingRef]bool{rewardsNodePercentage: true, rewardsOdaoPercentage: true, rewardsPdaoPercentage: true})
+	if len(invariants) != 1 {
+		t.Fatalf("got %d affected invariants, want 1", len(invariants))
+	}
+
+	values := map[settingRef]*big.Int{
+		rewardsNodePercentage: percent(70),
+		rewardsOdaoPercentage: percent(5),
+		rewardsPdaoPercentage: percent(25),
+	}
+	violations, err := getInvariantViolations(invariants, values)
+	if err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if len(violations) != 0 {
+		t.Fatalf("unexpected violations: %v", violations)
+	}
+
+	// Less than 100% is as much of a problem as more
+	values[rewardsPdaoPercentage] = percent(20)
+	violations, err = getInvariantViolations(invariants, values)
+	if err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if len(violations) != 1 {
+		t.Fatalf("got violations %v, want the rewards percentages", violations)
+	}
+}
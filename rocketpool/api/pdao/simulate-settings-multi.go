@@ -0,0 +1,289 @@
+package pdao
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v3"
+
+	daoprotocol "github.com/rocket-pool/smartnode/bindings/dao/protocol"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/bindings/settings/protocol"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// A setting a proposal changes, and the value it changes it to
+type settingChange struct {
+	ref         settingRef
+	settingType types.ProposalSettingType
+	value       any
+}
+
+// A call that reads a setting's value, either from RocketStorage or from one of the settings contract's getters
+type settingRead struct {
+	contract *rocketpool.Contract
+	method   string
+	args     []any
+}
+
+func simulateSettingMulti(c *cli.Command, settings []api.PDAOBatchSetting) (*api.SimulatePDAOSettingMultiResponse, error) {
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := decodeBatchSettings(settings, "")
+	if err != nil {
+		return nil, err
+	}
+	target, payload, err := daoprotocol.GetProposeSetMultiPayload(rp, decoded.contractNames, decoded.settingPaths, decoded.settingTypes, decoded.values)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]settingChange, len(decoded.settingPaths))
+	for i, settingPath := range decoded.settingPaths {
+		changes[i] = settingChange{
+			ref:         settingRef{decoded.contractNames[i], settingPath},
+			settingType: decoded.settingTypes[i],
+			value:       decoded.values[i],
+		}
+	}
+	return simulateProposalExecution(c, target, payload, changes)
+}
+
+func simulateRewardsPercentages(c *cli.Command, node *big.Int, odao *big.Int, pdao *big.Int) (*api.SimulatePDAOSettingMultiResponse, error) {
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	target, payload, err := daoprotocol.GetProposeSetRewardsPercentagePayload(rp, odao, pdao, node)
+	if err != nil {
+		return nil, err
+	}
+	changes := []settingChange{
+		{ref: rewardsNodePercentage, settingType: types.ProposalSettingType_Uint256, value: node},
+		{ref: rewardsOdaoPercentage, settingType: types.ProposalSettingType_Uint256, value: odao},
+		{ref: rewardsPdaoPercentage, settingType: types.ProposalSettingType_Uint256, value: pdao},
+	}
+	return simulateProposalExecution(c, target, payload, changes)
+}
+
+// Execute a proposal's payload against the latest block the way rocketDAOProtocolProposal does once the proposal passes,
+// then read the changed settings and the ones that share an invariant with them from the resulting state.
+// Nothing is committed to the chain; the calls are run in sequence with eth_simulateV1.
+func simulateProposalExecution(c *cli.Command, target common.Address, payload []byte, changes []settingChange) (*api.SimulatePDAOSettingMultiResponse, error) {
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	response := api.SimulatePDAOSettingMultiResponse{}
+
+	// Pin the simulation to the current block
+	ctx := context.Background()
+	header, err := ec.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the latest block: %w", err)
+	}
+	response.BlockNumber = header.Number.Uint64()
+
+	proposalAddress, err := rp.GetAddress("rocketDAOProtocolProposal", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read the changed settings before the proposal is executed
+	changed := map[settingRef]bool{}
+	reads := make([]settingRead, len(changes))
+	calls := []ethereum.CallMsg{}
+	for i, change := range changes {
+		changed[change.ref] = true
+		reads[i], err = getSettingRead(rp, change.ref, change.settingType)
+		if err != nil {
+			return nil, err
+		}
+		call, err := reads[i].callMsg()
+		if err != nil {
+			return nil, err
+		}
+		calls = append(calls, call)
+	}
+
+	// Execute the proposal
+	executeIndex := len(calls)
+	calls = append(calls, ethereum.CallMsg{From: *proposalAddress, To: &target, Data: payload})
+
+	// Read the changed settings again afterwards, along with the other settings in the invariants they're part of
+	afterIndices := map[settingRef]int{}
+	afterReads := map[settingRef]settingRead{}
+	addAfterRead := func(ref settingRef, read settingRead) error {
+		if _, exists := afterIndices[ref]; exists {
+			return nil
+		}
+		call, err := read.callMsg()
+		if err != nil {
+			return err
+		}
+		afterIndices[ref] = len(calls)
+		afterReads[ref] = read
+		calls = append(calls, call)
+		return nil
+	}
+	for i, change := range changes {
+		if err := addAfterRead(change.ref, reads[i]); err != nil {
+			return nil, err
+		}
+	}
+	invariants := getAffectedInvariants(changed)
+	for _, invariant := range invariants {
+		for _, setting := range invariant.settings {
+			read, err := getSettingRead(rp, setting, types.ProposalSettingType_Uint256)
+			if err != nil {
+				return nil, err
+			}
+			if err := addAfterRead(setting, read); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	results, err := ec.SimulateCalls(ctx, calls, header.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only a revert means the proposal would fail; other execution errors are a problem with the simulation itself
+	execution := results[executeIndex]
+	if execution.Reverted {
+		response.Reverted = true
+		response.RevertReason = execution.Error
+		if reason, err := abi.UnpackRevert(execution.RevertData); err == nil {
+			response.RevertReason = reason
+		}
+		return &response, nil
+	}
+	if execution.Error != "" {
+		return nil, fmt.Errorf("error simulating proposal execution: %s", execution.Error)
+	}
+
+	// Get the values from before and after the proposal was executed
+	values := map[settingRef]*big.Int{}
+	for ref, index := range afterIndices {
+		value, err := afterReads[ref].decode(results[index])
+		if err != nil {
+			return nil, fmt.Errorf("error getting simulated value of %s: %w", ref.setting, err)
+		}
+		if uintValue, ok := value.(*big.Int); ok {
+			values[ref] = uintValue
+		}
+	}
+	for i, change := range changes {
+		currentValue, err := reads[i].decode(results[i])
+		if err != nil {
+			return nil, fmt.Errorf("error getting current value of %s: %w", change.ref.setting, err)
+		}
+		newValue, err := reads[i].decode(results[afterIndices[change.ref]])
+		if err != nil {
+			return nil, fmt.Errorf("error getting simulated value of %s: %w", change.ref.setting, err)
+		}
+		if formatSettingValue(newValue) != formatSettingValue(change.value) {
+			return nil, fmt.Errorf("simulated value of %s is %s instead of %s; the setting is not stored where the Smart Node expects", change.ref.setting, formatSettingValue(newValue), formatSettingValue(change.value))
+		}
+		response.Settings = append(response.Settings, api.PDAOSimulatedSetting{
+			Contract:     change.ref.contract,
+			Setting:      change.ref.setting,
+			CurrentValue: formatSettingValue(currentValue),
+			NewValue:     formatSettingValue(newValue),
+		})
+	}
+
+	// Check the invariants the changed settings are part of against the state after the proposal was executed
+	response.InvariantViolations, err = getInvariantViolations(invariants, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// Get the call that reads a setting's value
+func getSettingRead(rp *rocketpool.RocketPool, ref settingRef, settingType types.ProposalSettingType) (settingRead, error) {
+	if getter, exists := settingGetters[ref]; exists {
+		contract, err := rp.GetContract(ref.contract, nil)
+		if err != nil {
+			return settingRead{}, err
+		}
+		return settingRead{contract: contract, method: getter}, nil
+	}
+
+	key, err := protocol.GetSettingStorageKey(ref.contract, ref.setting)
+	if err != nil {
+		return settingRead{}, err
+	}
+	var method string
+	switch settingType {
+	case types.ProposalSettingType_Uint256:
+		method = "getUint"
+	case types.ProposalSettingType_Bool:
+		method = "getBool"
+	case types.ProposalSettingType_Address:
+		method = "getAddress"
+	default:
+		return settingRead{}, fmt.Errorf("unsupported setting type %v", settingType)
+	}
+	return settingRead{contract: rp.RocketStorageContract, method: method, args: []any{key}}, nil
+}
+
+// Get the call message for the read
+func (r settingRead) callMsg() (ethereum.CallMsg, error) {
+	data, err := r.contract.ABI.Pack(r.method, r.args...)
+	if err != nil {
+		return ethereum.CallMsg{}, fmt.Errorf("error encoding %s call: %w", r.method, err)
+	}
+	return ethereum.CallMsg{To: r.contract.Address, Data: data}, nil
+}
+
+// Get the value from the read's simulated result
+func (r settingRead) decode(result services.SimulatedCall) (any, error) {
+	if result.Error != "" {
+		return nil, fmt.Errorf("%s failed: %s", r.method, result.Error)
+	}
+	values, err := r.contract.ABI.Unpack(r.method, result.ReturnData)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding %s result: %w", r.method, err)
+	}
+	if len(values) != 1 {
+		return nil, fmt.Errorf("expected 1 value from %s, got %d", r.method, len(values))
+	}
+	return values[0], nil
+}
+
+// Format a setting value for display
+func formatSettingValue(value any) string {
+	switch value := value.(type) {
+	case *big.Int:
+		return value.String()
+	case common.Address:
+		return value.Hex()
+	default:
+		return fmt.Sprint(value)
+	}
+}
@@ -0,0 +1,255 @@
+package pdao
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/urfave/cli/v3"
+
+	"github.com/rocket-pool/smartnode/rocketpool/eip712"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Snapshot proposal types whose votes are a single 1-based choice
+var supportedSnapshotProposalTypes = []string{"single-choice", "basic"}
+
+// The response from a Snapshot hub after submitting a signed message
+type snapshotMsgResponse struct {
+	ID               string `json:"id"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func canSnapshotVote(c *cli.Command, proposalID common.Hash) (*api.PDAOCanSnapshotVoteResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.PDAOCanSnapshotVoteResponse{
+		Space: cfg.Smartnode.GetSnapshotID(),
+	}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	response.NodeAddress = nodeAccount.Address
+
+	// Get the node's signalling address
+	response.SignallingAddress, err = getSignallingAddress(c, cfg, nodeAccount.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the proposal
+	proposal, err := getActiveSnapshotProposal(cfg, proposalID)
+	if err != nil {
+		return nil, err
+	}
+	if proposal == nil {
+		response.ProposalNotActive = true
+		return &response, nil
+	}
+	response.Proposal = *proposal
+	response.UnsupportedType = !slices.Contains(supportedSnapshotProposalTypes, proposal.Type)
+
+	// Get any votes the node or its signalling address already cast on it
+	votedProposals, err := GetSnapshotVotedProposals(cfg.Smartnode.GetSnapshotApiDomain(), response.Space, nodeAccount.Address, response.SignallingAddress)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting the node's Snapshot votes: %w", err)
+	}
+	response.ExistingVotes = []api.SnapshotProposalVote{}
+	for _, vote := range votedProposals.Data.Votes {
+		if vote.Proposal.Id == proposal.Id {
+			response.ExistingVotes = append(response.ExistingVotes, vote)
+		}
+	}
+
+	// Update & return response
+	response.CanVote = !(response.ProposalNotActive || response.UnsupportedType)
+	return &response, nil
+
+}
+
+// Cast a vote on a Snapshot proposal. If no signature is provided, the vote is cast from the node address and
+// signed with the node wallet; otherwise it must be a signature of the vote from the node's signalling address.
+func snapshotVote(c *cli.Command, proposalID common.Hash, choice uint32, reason string, timestamp uint64, signature string) (*api.PDAOSnapshotVoteResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.PDAOSnapshotVoteResponse{}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the proposal and choice
+	proposal, err := getActiveSnapshotProposal(cfg, proposalID)
+	if err != nil {
+		return nil, err
+	}
+	if proposal == nil {
+		return nil, fmt.Errorf("Snapshot proposal %s is not active.", proposalID.Hex())
+	}
+	if !slices.Contains(supportedSnapshotProposalTypes, proposal.Type) {
+		return nil, fmt.Errorf("Snapshot proposal %s is a %s vote; only single-choice votes can be cast from the Smart Node.", proposalID.Hex(), proposal.Type)
+	}
+	if choice == 0 || int(choice) > len(proposal.Choices) {
+		return nil, fmt.Errorf("Invalid choice %d; proposal %s has %d choices.", choice, proposalID.Hex(), len(proposal.Choices))
+	}
+
+	// Build and sign the vote
+	space := cfg.Smartnode.GetSnapshotID()
+	if signature == "" {
+		vote := eip712.NewSnapshotVote(nodeAccount.Address, space, timestamp, proposalID, choice, reason)
+		keyBytes, err := w.GetNodePrivateKeyBytes()
+		if err != nil {
+			return nil, err
+		}
+		key, err := crypto.ToECDSA(keyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("Error loading the node private key: %w", err)
+		}
+		signature, err = vote.Sign(key)
+		if err != nil {
+			return nil, err
+		}
+		response.Voter = vote.From
+		response.VoteID, err = SubmitSnapshotVote(cfg.Smartnode.GetSnapshotApiDomain(), vote, signature)
+		if err != nil {
+			return nil, err
+		}
+		return &response, nil
+	}
+
+	// Make sure a signed vote comes from the node's signalling address
+	signallingAddress, err := getSignallingAddress(c, cfg, nodeAccount.Address)
+	if err != nil {
+		return nil, err
+	}
+	if signallingAddress == (common.Address{}) {
+		return nil, fmt.Errorf("The node does not have a signalling address.")
+	}
+	vote := eip712.NewSnapshotVote(signallingAddress, space, timestamp, proposalID, choice, reason)
+	signer, err := vote.RecoverSigner(signature)
+	if err != nil {
+		return nil, err
+	}
+	if signer != signallingAddress {
+		return nil, fmt.Errorf("The vote was signed by %s, but the node's signalling address is %s.", signer.Hex(), signallingAddress.Hex())
+	}
+	response.Voter = vote.From
+	response.VoteID, err = SubmitSnapshotVote(cfg.Smartnode.GetSnapshotApiDomain(), vote, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Submit a signed vote to a Snapshot hub, returning the ID of the vote
+func SubmitSnapshotVote(apiDomain string, vote *eip712.SnapshotVote, signature string) (string, error) {
+	body, err := json.Marshal(vote.Envelope(signature))
+	if err != nil {
+		return "", fmt.Errorf("could not encode snapshot vote: %w", err)
+	}
+
+	client := getHttpClientWithTimeout()
+	url := fmt.Sprintf("https://%s/api/msg", apiDomain)
+	resp, err := client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	// Get response
+	responseBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	var msgResponse snapshotMsgResponse
+	if err := json.Unmarshal(responseBody, &msgResponse); err != nil && resp.StatusCode == http.StatusOK {
+		return "", fmt.Errorf("could not decode snapshot response: %w", err)
+	}
+
+	// Check the response code
+	if resp.StatusCode != http.StatusOK {
+		if msgResponse.ErrorDescription != "" {
+			return "", fmt.Errorf("snapshot rejected the vote: %s", msgResponse.ErrorDescription)
+		}
+		return "", fmt.Errorf("request failed with code %d", resp.StatusCode)
+	}
+	return msgResponse.ID, nil
+}
+
+// Get an active proposal in the configured Snapshot space, or nil if it isn't active
+func getActiveSnapshotProposal(cfg *config.RocketPoolConfig, proposalID common.Hash) (*api.SnapshotProposal, error) {
+	snapshotResponse, err := GetSnapshotProposals(cfg.Smartnode.GetSnapshotApiDomain(), cfg.Smartnode.GetSnapshotID(), "active")
+	if err != nil {
+		return nil, fmt.Errorf("Error getting active Snapshot proposals: %w", err)
+	}
+	for _, proposal := range snapshotResponse.Data.Proposals {
+		if common.HexToHash(proposal.Id) == proposalID {
+			return &proposal, nil
+		}
+	}
+	return nil, nil
+}
+
+// Get the node's signalling address, which is blank if it doesn't have one or the network has no signer registry
+func getSignallingAddress(c *cli.Command, cfg *config.RocketPoolConfig, nodeAddress common.Address) (common.Address, error) {
+	if cfg.Smartnode.GetRocketSignerRegistryAddress() == "" {
+		return common.Address{}, nil
+	}
+	reg, err := services.GetRocketSignerRegistry(c)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if reg == nil {
+		return common.Address{}, nil
+	}
+	signallingAddress, err := reg.NodeToSigner(&bind.CallOpts{}, nodeAddress)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("Error getting the node's signalling address: %w", err)
+	}
+	return signallingAddress, nil
+}
@@ -258,6 +258,7 @@ func GetSnapshotProposals(apiDomain string, space string, state string) (*api.Sn
 	proposals(where: {space: "%s"%s, start_gte: 1727694646}, orderBy: "created", orderDirection: desc) {
 	    id
 	    title
+	    type
 	    choices
 	    start
 	    end
@@ -0,0 +1,162 @@
+package pdao
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v3"
+
+	"github.com/rocket-pool/smartnode/bindings/dao/protocol"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/proposals"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getVotingPower(c *cli.Command, proposalID uint64) (*api.PDAOVotingPowerResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+	m, err := services.GetNetworkStateProvider(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.PDAOVotingPowerResponse{
+		ProposalID: proposalID,
+	}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the proposal's target block
+	blockNumber, err := protocol.GetProposalBlock(rp, proposalID, nil)
+	if err != nil {
+		return nil, err
+	}
+	if blockNumber == 0 {
+		return nil, fmt.Errorf("proposal %d does not exist", proposalID)
+	}
+	response.BlockNumber = blockNumber
+
+	// Get the voting power distribution at the target block
+	propMgr, err := proposals.NewProposalManager(nil, cfg, rp, bc)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := propMgr.GetVotingInfoSnapshot(blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	response.TotalVotingPower, response.Nodes = proposals.GetDelegatedVotingPower(snapshot)
+
+	// Get this node's share; it isn't in the list of holders if it delegated its power and nobody delegated to it
+	response.Node = api.PDAONodeVotingPower{
+		Address:        nodeAccount.Address,
+		Delegate:       nodeAccount.Address,
+		OwnVotingPower: big.NewInt(0),
+		VotingPower:    big.NewInt(0),
+		Delegators:     []api.PDAODelegation{},
+	}
+	for _, info := range snapshot.Info {
+		if info.NodeAddress == nodeAccount.Address {
+			response.Node.OwnVotingPower = info.VotingPower
+			if info.Delegate != (common.Address{}) {
+				response.Node.Delegate = info.Delegate
+			}
+			break
+		}
+	}
+	for _, holder := range response.Nodes {
+		if holder.Address == nodeAccount.Address {
+			response.Node = holder
+			break
+		}
+	}
+
+	// Get the node's voting power at the head of the chain
+	s, err := m.GetHeadStateForNode(nodeAccount.Address)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the network state: %w", err)
+	}
+	response.NodeCurrentVotingPower = big.NewInt(0)
+	for idx, node := range s.NodeDetails {
+		if node.NodeAddress == nodeAccount.Address {
+			response.NodeCurrentVotingPower = proposals.GetNodeVotingPower(s, idx)
+			break
+		}
+	}
+
+	// Get the unvoted power of each open proposal
+	allProposals, err := protocol.GetProposals(rp, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting proposals: %w", err)
+	}
+	response.OpenProposals = []api.PDAOProposalVotingPower{}
+	for _, prop := range allProposals {
+		if prop.State != types.ProtocolDaoProposalState_Pending &&
+			prop.State != types.ProtocolDaoProposalState_ActivePhase1 &&
+			prop.State != types.ProtocolDaoProposalState_ActivePhase2 {
+			continue
+		}
+
+		total := response.TotalVotingPower
+		if prop.TargetBlock != blockNumber {
+			propSnapshot, err := propMgr.GetVotingInfoSnapshot(prop.TargetBlock)
+			if err != nil {
+				return nil, err
+			}
+			total, _ = proposals.GetDelegatedVotingPower(propSnapshot)
+		}
+		voted := big.NewInt(0)
+		voted.Add(voted, prop.VotingPowerFor)
+		voted.Add(voted, prop.VotingPowerAgainst)
+		voted.Add(voted, prop.VotingPowerAbstained)
+		unvoted := big.NewInt(0).Sub(total, voted)
+		if unvoted.Sign() < 0 {
+			unvoted.SetUint64(0)
+		}
+
+		nodeVoted, _, err := proposals.GetPDAOVoteStatus(rp, prop, nodeAccount.Address, nil)
+		if err != nil {
+			return nil, err
+		}
+		response.OpenProposals = append(response.OpenProposals, api.PDAOProposalVotingPower{
+			ID:               prop.ID,
+			Message:          prop.Message,
+			State:            prop.State,
+			BlockNumber:      prop.TargetBlock,
+			TotalVotingPower: total,
+			VotedPower:       voted,
+			UnvotedPower:     unvoted,
+			NodeVoted:        nodeVoted,
+		})
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -0,0 +1,205 @@
+package pdao
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v3"
+
+	"github.com/rocket-pool/smartnode/bindings/dao/protocol"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/proposals"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func exportVotingTree(c *cli.Command, blockNumber uint32, nodeAddress *common.Address) (*api.PDAOExportVotingTreeResponse, error) {
+
+	// Get services
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.PDAOExportVotingTreeResponse{}
+
+	// Get the network tree, creating it from the voting info snapshot if it isn't on disk yet
+	propMgr, err := proposals.NewProposalManager(nil, cfg, rp, bc)
+	if err != nil {
+		return nil, err
+	}
+	networkTree, err := propMgr.GetNetworkTree(blockNumber, nil)
+	if err != nil {
+		return nil, err
+	}
+	_, pollard := networkTree.GetPollardForProposal()
+
+	response.Export = api.PDAOVotingTreeExport{
+		SmartnodeVersion: networkTree.SmartnodeVersion,
+		Network:          string(networkTree.Network),
+		BlockNumber:      networkTree.BlockNumber,
+		DepthPerRound:    networkTree.DepthPerRound,
+		NetworkTree:      getExportedVotingTree(networkTree.VotingTree),
+		Pollard:          dereferenceTreeNodes(pollard),
+	}
+
+	// Get the node's tree
+	if nodeAddress != nil {
+		nodeTree, err := propMgr.GetNodeTreeForAddress(blockNumber, *nodeAddress)
+		if err != nil {
+			return nil, err
+		}
+		exportedTree := getExportedVotingTree(nodeTree.VotingTree)
+		response.Export.NodeAddress = &nodeTree.Address
+		response.Export.NodeIndex = &nodeTree.NodeIndex
+		response.Export.NodeTree = &exportedTree
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func verifyVotingTree(c *cli.Command, proposalID uint64) (*api.PDAOVerifyVotingTreeResponse, error) {
+
+	// Get services
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.PDAOVerifyVotingTreeResponse{
+		ProposalID: proposalID,
+	}
+
+	// Get the proposal's target block
+	blockNumber, err := protocol.GetProposalBlock(rp, proposalID, nil)
+	if err != nil {
+		return nil, err
+	}
+	if blockNumber == 0 {
+		return nil, fmt.Errorf("proposal %d does not exist", proposalID)
+	}
+	response.BlockNumber = blockNumber
+
+	// Recompute the network tree from the voting info snapshot, ignoring any tree cached on disk
+	propMgr, err := proposals.NewProposalManager(nil, cfg, rp, bc)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := propMgr.GetVotingInfoSnapshot(blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	depthPerRound, err := protocol.GetDepthPerRound(rp, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the depth per round: %w", err)
+	}
+	networkTree := propMgr.RecomputeNetworkTree(snapshot, depthPerRound)
+	response.LocalRoot = *networkTree.Nodes[0]
+
+	// Check the cached network tree, which is what this node uses for proposals and challenges, against the recomputed one
+	cachedTree, err := propMgr.GetCachedNetworkTree(blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	if cachedTree != nil {
+		response.CachedRoot = cachedTree.Nodes[0]
+		response.CachedTreeMatches = votingTreesMatch(cachedTree.VotingTree, networkTree.VotingTree)
+	}
+
+	// Get every root submitted for the proposal; they can only come after the target block
+	latestBlock, err := ec.BlockNumber(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("error getting latest block number: %w", err)
+	}
+	intervalSize := big.NewInt(int64(cfg.Geth.EventLogInterval))
+	verifierAddresses := cfg.Smartnode.GetPreviousRocketDAOProtocolVerifierAddresses()
+	events, err := protocol.GetRootSubmittedEvents(rp, []uint64{proposalID}, intervalSize, big.NewInt(int64(blockNumber)), big.NewInt(0).SetUint64(latestBlock), verifierAddresses, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error scanning for RootSubmitted events: %w", err)
+	}
+
+	// Compare each submitted pollard with the recomputed tree it belongs to
+	response.Roots = make([]api.PDAOSubmittedRootCheck, len(events))
+	for i, event := range events {
+		tree := propMgr.RecomputeTreeForIndex(snapshot, networkTree, event.Index.Uint64(), depthPerRound)
+		mismatchIndex, _, _, err := tree.CheckForChallengeableArtifacts(event.Index.Uint64(), event.TreeNodes)
+		if err != nil {
+			return nil, fmt.Errorf("error checking the root submitted for index %d: %w", event.Index.Uint64(), err)
+		}
+		response.Roots[i] = api.PDAOSubmittedRootCheck{
+			Index:         event.Index.Uint64(),
+			Submitter:     event.Proposer,
+			Time:          event.Timestamp,
+			Root:          event.Root,
+			Matches:       mismatchIndex == 0,
+			MismatchIndex: mismatchIndex,
+		}
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Check whether two voting trees have the same nodes
+func votingTreesMatch(first *proposals.VotingTree, second *proposals.VotingTree) bool {
+	if len(first.Nodes) != len(second.Nodes) {
+		return false
+	}
+	for i, node := range first.Nodes {
+		other := second.Nodes[i]
+		if node.Hash != other.Hash || node.Sum.Cmp(other.Sum) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Convert a voting tree to its export format
+func getExportedVotingTree(tree *proposals.VotingTree) api.PDAOVotingTree {
+	return api.PDAOVotingTree{
+		Depth:            tree.Depth,
+		VirtualRootIndex: tree.VirtualRootIndex,
+		Nodes:            dereferenceTreeNodes(tree.Nodes),
+	}
+}
+
+func dereferenceTreeNodes(nodes []*types.VotingTreeNode) []types.VotingTreeNode {
+	values := make([]types.VotingTreeNode, len(nodes))
+	for i, node := range nodes {
+		values[i] = *node
+	}
+	return values
+}
@@ -0,0 +1,60 @@
+package queue
+
+import (
+	"github.com/urfave/cli/v3"
+
+	"github.com/rocket-pool/smartnode/bindings/megapool"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getQueueForecast(c *cli.Command, lookbackDays uint64) (*api.QueueForecastResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.QueueForecastResponse{}
+
+	// Get the node's megapool so its validators can be highlighted
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	megapoolDeployed, err := megapool.GetMegapoolDeployed(rp, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+	if megapoolDeployed {
+		response.MegapoolAddress, err = megapool.GetMegapoolExpectedAddress(rp, nodeAccount.Address, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Get the forecast
+	response.Forecast, err = services.GetQueueForecast(rp, bc, cfg, lookbackDays)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+
+}
@@ -47,6 +47,16 @@ func RegisterRoutes(mux *http.ServeMux, c *cli.Command) {
 		response.WriteResponse(w, resp, err)
 	})
 
+	mux.HandleFunc("/api/queue/forecast", func(w http.ResponseWriter, r *http.Request) {
+		lookbackDays, err := parseUint32Param(r, "lookbackDays")
+		if err != nil {
+			response.WriteErrorResponse(w, err)
+			return
+		}
+		resp, err := getQueueForecast(c, uint64(lookbackDays))
+		response.WriteResponse(w, resp, err)
+	})
+
 	mux.HandleFunc("/api/queue/can-assign-deposits", func(w http.ResponseWriter, r *http.Request) {
 		m, err := parseUint32Param(r, "max")
 		if err != nil {
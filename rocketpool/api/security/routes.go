@@ -37,6 +37,16 @@ func RegisterRoutes(mux *http.ServeMux, c *cli.Command) {
 		response.WriteResponse(w, resp, err)
 	})
 
+	mux.HandleFunc("/api/security/proposal-diff", func(w http.ResponseWriter, r *http.Request) {
+		id, err := parseUint64(r, "id")
+		if err != nil {
+			response.WriteErrorResponse(w, err)
+			return
+		}
+		resp, err := getProposalDiff(c, id)
+		response.WriteResponse(w, resp, err)
+	})
+
 	mux.HandleFunc("/api/security/can-propose-leave", func(w http.ResponseWriter, r *http.Request) {
 		resp, err := canProposeLeave(c)
 		response.WriteResponse(w, resp, err)
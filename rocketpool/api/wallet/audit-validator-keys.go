@@ -0,0 +1,197 @@
+package wallet
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v3"
+
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// The number of indices past the wallet's next key index to check when no bound is provided
+const defaultAuditLookahead uint = 100
+
+func auditValidatorKeys(c *cli.Command, bound uint) (*api.AuditValidatorKeysResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	m, err := services.GetNetworkStateProvider(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the node's validators
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	nodeState, err := m.GetHeadStateForNode(nodeAccount.Address)
+	if err != nil {
+		return nil, fmt.Errorf("error getting network state: %w", err)
+	}
+	validators := getNodeValidatorsFromState(nodeState, nodeAccount.Address)
+
+	// Derive the keys in the audited range
+	nextAccount, err := w.GetValidatorKeyCount()
+	if err != nil {
+		return nil, err
+	}
+	if bound == 0 {
+		bound = nextAccount + defaultAuditLookahead
+	}
+	derivedKeys, err := w.GetValidatorKeys(0, bound)
+	if err != nil {
+		return nil, err
+	}
+
+	return compareValidatorKeys(validators, derivedKeys, nextAccount, w.GetValidatorKeyKeystores)
+
+}
+
+// Compare the node's on-chain validators against its derived keys and the keys in each of its keystores
+func compareValidatorKeys(
+	validators []nodeValidator,
+	derivedKeys []wallet.ValidatorKey,
+	nextAccount uint,
+	getKeystores func(types.ValidatorPubkey) (map[string]bool, error),
+) (*api.AuditValidatorKeysResponse, error) {
+
+	// Response
+	response := api.AuditValidatorKeysResponse{
+		NextAccount:     nextAccount,
+		DerivedKeyCount: uint(len(derivedKeys)),
+		Keystores:       []string{},
+		ValidatorCount:  len(validators),
+		MissingKeys:     []api.AuditedValidatorKey{},
+		OrphanedKeys:    []api.AuditedValidatorKey{},
+		PartialKeys:     []api.AuditedValidatorKey{},
+		UnusedIndices:   []uint{},
+	}
+
+	derivedIndices := make(map[types.ValidatorPubkey]uint, len(derivedKeys))
+	for _, key := range derivedKeys {
+		derivedIndices[key.PublicKey] = key.WalletIndex
+	}
+
+	// Build an audit entry for a key, recording which keystores have it
+	keystoreNamesSet := false
+	audit := func(pubkey types.ValidatorPubkey) (api.AuditedValidatorKey, error) {
+		presence, err := getKeystores(pubkey)
+		if err != nil {
+			return api.AuditedValidatorKey{}, fmt.Errorf("error checking keystores for validator %s: %w", pubkey.Hex(), err)
+		}
+		key := api.AuditedValidatorKey{
+			Pubkey:           pubkey,
+			Keystores:        []string{},
+			MissingKeystores: []string{},
+		}
+		if index, exists := derivedIndices[pubkey]; exists {
+			key.WalletIndex = &index
+		}
+		for name, hasKey := range presence {
+			if hasKey {
+				key.Keystores = append(key.Keystores, name)
+			} else {
+				key.MissingKeystores = append(key.MissingKeystores, name)
+			}
+			if !keystoreNamesSet {
+				response.Keystores = append(response.Keystores, name)
+			}
+		}
+		keystoreNamesSet = true
+		sort.Strings(key.Keystores)
+		sort.Strings(key.MissingKeystores)
+		return key, nil
+	}
+
+	// Check the on-chain validators
+	onChain := make(map[types.ValidatorPubkey]bool, len(validators))
+	for _, validator := range validators {
+		onChain[validator.pubkey] = true
+		key, err := audit(validator.pubkey)
+		if err != nil {
+			return nil, err
+		}
+		key.MinipoolAddress = validator.minipoolAddress
+		key.MegapoolValidatorId = validator.megapoolValidatorId
+
+		if key.WalletIndex != nil && (response.HighestValidatorIndex == nil || *key.WalletIndex > *response.HighestValidatorIndex) {
+			response.HighestValidatorIndex = key.WalletIndex
+		}
+		if len(key.Keystores) == 0 {
+			response.MissingKeys = append(response.MissingKeys, key)
+		} else if len(key.MissingKeystores) > 0 {
+			response.PartialKeys = append(response.PartialKeys, key)
+		}
+	}
+
+	// Check the derived keys that don't belong to a validator
+	for _, derivedKey := range derivedKeys {
+		if onChain[derivedKey.PublicKey] {
+			continue
+		}
+		key, err := audit(derivedKey.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		if len(key.Keystores) > 0 {
+			response.OrphanedKeys = append(response.OrphanedKeys, key)
+			if len(key.MissingKeystores) > 0 {
+				response.PartialKeys = append(response.PartialKeys, key)
+			}
+		} else if derivedKey.WalletIndex < nextAccount {
+			response.UnusedIndices = append(response.UnusedIndices, derivedKey.WalletIndex)
+		}
+	}
+
+	sort.Strings(response.Keystores)
+	return &response, nil
+
+}
+
+// Get all of the node's minipool and megapool validators that have a pubkey assigned from the network state
+func getNodeValidatorsFromState(s *state.NetworkState, nodeAddress common.Address) []nodeValidator {
+	zeroPubkey := types.ValidatorPubkey{}
+	validators := []nodeValidator{}
+	for _, mpd := range s.MinipoolDetailsByNode[nodeAddress] {
+		if mpd.Pubkey == zeroPubkey {
+			continue
+		}
+		address := mpd.MinipoolAddress
+		validators = append(validators, nodeValidator{
+			pubkey:          mpd.Pubkey,
+			minipoolAddress: &address,
+		})
+	}
+
+	node, exists := s.NodeDetailsByAddress[nodeAddress]
+	if !exists || !node.MegapoolDeployed {
+		return validators
+	}
+	for _, pubkey := range s.MegapoolToPubkeysMap[node.MegapoolAddress] {
+		validator := nodeValidator{
+			pubkey: pubkey,
+		}
+		if info, exists := s.GetMegapoolValidatorInfo(node.MegapoolAddress, pubkey); exists {
+			id := info.ValidatorId
+			validator.megapoolValidatorId = &id
+		}
+		validators = append(validators, validator)
+	}
+	return validators
+}
@@ -0,0 +1,82 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+)
+
+func TestCompareValidatorKeys(t *testing.T) {
+	pubkey := func(b byte) types.ValidatorPubkey {
+		var pubkey types.ValidatorPubkey
+		pubkey[0] = b
+		return pubkey
+	}
+
+	// Indices 0-5 are derived and the wallet's next key index is 4
+	derivedKeys := []wallet.ValidatorKey{}
+	for i := uint(0); i < 6; i++ {
+		derivedKeys = append(derivedKeys, wallet.ValidatorKey{PublicKey: pubkey(byte(i + 1)), WalletIndex: i})
+	}
+
+	// Index 0 is fully stored, index 1 is missing from teku, index 2 is missing everywhere,
+	// index 3 was never used, index 4 is an orphaned key, and index 5 belongs to a megapool validator
+	// that is beyond the wallet's next key index. 0xFF is a validator that isn't derivable at all.
+	minipool := common.HexToAddress("0x1234")
+	megapoolValidatorId := uint32(7)
+	validators := []nodeValidator{
+		{pubkey: pubkey(1), minipoolAddress: &minipool},
+		{pubkey: pubkey(2)},
+		{pubkey: pubkey(3)},
+		{pubkey: pubkey(6), megapoolValidatorId: &megapoolValidatorId},
+		{pubkey: pubkey(0xFF)},
+	}
+	stored := map[types.ValidatorPubkey]map[string]bool{
+		pubkey(1): {"lighthouse": true, "teku": true},
+		pubkey(2): {"lighthouse": true, "teku": false},
+		pubkey(5): {"lighthouse": true, "teku": true},
+		pubkey(6): {"lighthouse": true, "teku": true},
+	}
+	getKeystores := func(pubkey types.ValidatorPubkey) (map[string]bool, error) {
+		if presence, exists := stored[pubkey]; exists {
+			return presence, nil
+		}
+		return map[string]bool{"lighthouse": false, "teku": false}, nil
+	}
+
+	response, err := compareValidatorKeys(validators, derivedKeys, 4, getKeystores)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(response.Keystores) != 2 || response.Keystores[0] != "lighthouse" || response.Keystores[1] != "teku" {
+		t.Errorf("expected keystores [lighthouse teku], got %v", response.Keystores)
+	}
+	if len(response.MissingKeys) != 2 {
+		t.Fatalf("expected 2 missing keys, got %d", len(response.MissingKeys))
+	}
+	if key := response.MissingKeys[0]; key.Pubkey != pubkey(3) || key.WalletIndex == nil || *key.WalletIndex != 2 {
+		t.Errorf("expected the first missing key to be derivable at index 2, got %+v", key)
+	}
+	if key := response.MissingKeys[1]; key.Pubkey != pubkey(0xFF) || key.WalletIndex != nil {
+		t.Errorf("expected the second missing key to be underivable, got %+v", key)
+	}
+	if len(response.PartialKeys) != 1 || response.PartialKeys[0].Pubkey != pubkey(2) {
+		t.Fatalf("expected 1 partial key for index 1, got %+v", response.PartialKeys)
+	}
+	if missing := response.PartialKeys[0].MissingKeystores; len(missing) != 1 || missing[0] != "teku" {
+		t.Errorf("expected the partial key to be missing from teku, got %v", missing)
+	}
+	if len(response.OrphanedKeys) != 1 || response.OrphanedKeys[0].Pubkey != pubkey(5) {
+		t.Errorf("expected 1 orphaned key for index 4, got %+v", response.OrphanedKeys)
+	}
+	if len(response.UnusedIndices) != 1 || response.UnusedIndices[0] != 3 {
+		t.Errorf("expected index 3 to be unused, got %v", response.UnusedIndices)
+	}
+	if response.HighestValidatorIndex == nil || *response.HighestValidatorIndex != 5 {
+		t.Errorf("expected the highest validator index to be 5, got %v", response.HighestValidatorIndex)
+	}
+}
@@ -1,13 +1,18 @@
 package wallet
 
 import (
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/goccy/go-json"
 	"github.com/urfave/cli/v3"
 
+	"github.com/rocket-pool/smartnode/bindings/types"
 	"github.com/rocket-pool/smartnode/rocketpool/api/response"
+	hexutils "github.com/rocket-pool/smartnode/shared/hex"
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
@@ -94,6 +99,51 @@ func RegisterRoutes(mux *http.ServeMux, c *cli.Command) {
 		response.WriteResponse(w, resp, err)
 	})
 
+	mux.HandleFunc("/api/wallet/export-validator-keys", func(w http.ResponseWriter, r *http.Request) {
+		filter, err := parseValidatorKeyFilter(r)
+		if err != nil {
+			response.WriteErrorResponse(w, err)
+			return
+		}
+		resp, err := exportValidatorKeys(c, filter)
+		response.WriteResponse(w, resp, err)
+	})
+
+	mux.HandleFunc("/api/wallet/import-validator-keys", func(w http.ResponseWriter, r *http.Request) {
+		var keys []api.ImportedValidatorKey
+		if err := json.Unmarshal([]byte(r.FormValue("keys")), &keys); err != nil {
+			response.WriteErrorResponse(w, &response.BadRequestError{Err: fmt.Errorf("invalid keys JSON: %w", err)})
+			return
+		}
+		filter, err := parseValidatorKeyFilter(r)
+		if err != nil {
+			response.WriteErrorResponse(w, err)
+			return
+		}
+		resp, err := importValidatorKeys(c, keys, filter)
+		response.WriteResponse(w, resp, err)
+	})
+
+	mux.HandleFunc("/api/wallet/audit-validator-keys", func(w http.ResponseWriter, r *http.Request) {
+		var bound uint64
+		if boundStr := r.URL.Query().Get("bound"); boundStr != "" {
+			var err error
+			bound, err = strconv.ParseUint(boundStr, 10, 32)
+			if err != nil {
+				response.WriteErrorResponse(w, &response.BadRequestError{Err: fmt.Errorf("invalid bound '%s': %w", boundStr, err)})
+				return
+			}
+		}
+		resp, err := auditValidatorKeys(c, uint(bound))
+		response.WriteResponse(w, resp, err)
+	})
+
+	mux.HandleFunc("/api/wallet/sign-tx", func(w http.ResponseWriter, r *http.Request) {
+		serializedTx := r.FormValue("serializedTx")
+		resp, err := signTransaction(c, serializedTx)
+		response.WriteResponse(w, resp, err)
+	})
+
 	mux.HandleFunc("/api/wallet/masquerade", func(w http.ResponseWriter, r *http.Request) {
 		address := common.HexToAddress(r.FormValue("address"))
 		observe := r.FormValue("observe") == "true"
@@ -131,3 +181,40 @@ func RegisterRoutes(mux *http.ServeMux, c *cli.Command) {
 		response.WriteResponse(w, resp, err)
 	})
 }
+
+// Reads the optional comma-separated pubkeys, minipools and megapoolValidatorIds parameters
+func parseValidatorKeyFilter(r *http.Request) (validatorKeyFilter, error) {
+	filter := validatorKeyFilter{}
+	for _, part := range splitListParam(r.FormValue("pubkeys")) {
+		pubkey, err := types.HexToValidatorPubkey(hexutils.RemovePrefix(part))
+		if err != nil {
+			return filter, &response.BadRequestError{Err: fmt.Errorf("invalid pubkey %s: %w", part, err)}
+		}
+		filter.pubkeys = append(filter.pubkeys, pubkey)
+	}
+	for _, part := range splitListParam(r.FormValue("minipools")) {
+		if !common.IsHexAddress(part) {
+			return filter, &response.BadRequestError{Err: fmt.Errorf("invalid minipool address %s", part)}
+		}
+		filter.minipools = append(filter.minipools, common.HexToAddress(part))
+	}
+	for _, part := range splitListParam(r.FormValue("megapoolValidatorIds")) {
+		id, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			return filter, &response.BadRequestError{Err: fmt.Errorf("invalid megapool validator ID %s", part)}
+		}
+		filter.megapoolValidatorIds = append(filter.megapoolValidatorIds, uint32(id))
+	}
+	return filter, nil
+}
+
+func splitListParam(raw string) []string {
+	parts := []string{}
+	for _, part := range strings.Split(raw, ",") {
+		part = strings.TrimSpace(part)
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return parts
+}
@@ -0,0 +1,70 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/urfave/cli/v3"
+
+	hexutils "github.com/rocket-pool/smartnode/shared/hex"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Sign a transaction that was exported unsigned. This only needs the node wallet, so it works on an offline machine.
+func signTransaction(c *cli.Command, serializedTx string) (*api.SignTransactionResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.SignTransactionResponse{}
+
+	// Make sure the transaction is for the network this node is on
+	chainID := new(big.Int).SetUint64(uint64(cfg.Smartnode.GetChainID()))
+	tx, err := api.DecodeUnsignedTransaction(serializedTx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Sign the transaction
+	txBytes, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("error serializing transaction: %w", err)
+	}
+	signedBytes, err := w.Sign(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	var signedTx types.Transaction
+	if err := signedTx.UnmarshalBinary(signedBytes); err != nil {
+		return nil, fmt.Errorf("error decoding signed transaction: %w", err)
+	}
+
+	response.SignedTransaction = api.SignedTransaction{
+		From:         nodeAccount.Address,
+		Nonce:        signedTx.Nonce(),
+		TxHash:       signedTx.Hash(),
+		SerializedTx: hexutils.AddPrefix(hex.EncodeToString(signedBytes)),
+	}
+
+	// Return response
+	return &response, nil
+
+}
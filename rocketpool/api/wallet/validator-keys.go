@@ -0,0 +1,358 @@
+package wallet
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/urfave/cli/v3"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/bindings/megapool"
+	"github.com/rocket-pool/smartnode/bindings/minipool"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/bindings/types"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// A validator belonging to one of the node's minipools or to its megapool
+type nodeValidator struct {
+	pubkey              types.ValidatorPubkey
+	minipoolAddress     *common.Address
+	megapoolValidatorId *uint32
+}
+
+// Selects a subset of the node's validators; an empty filter selects all of them
+type validatorKeyFilter struct {
+	pubkeys              []types.ValidatorPubkey
+	minipools            []common.Address
+	megapoolValidatorIds []uint32
+}
+
+func (f validatorKeyFilter) isEmpty() bool {
+	return len(f.pubkeys) == 0 && len(f.minipools) == 0 && len(f.megapoolValidatorIds) == 0
+}
+
+func (f validatorKeyFilter) matches(v nodeValidator) bool {
+	if f.isEmpty() {
+		return true
+	}
+	for _, pubkey := range f.pubkeys {
+		if pubkey == v.pubkey {
+			return true
+		}
+	}
+	if v.minipoolAddress != nil {
+		for _, address := range f.minipools {
+			if address == *v.minipoolAddress {
+				return true
+			}
+		}
+	}
+	if v.megapoolValidatorId != nil {
+		for _, id := range f.megapoolValidatorIds {
+			if id == *v.megapoolValidatorId {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func exportValidatorKeys(c *cli.Command, filter validatorKeyFilter) (*api.ExportValidatorKeysResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.ExportValidatorKeysResponse{
+		Keys:        []api.ExportedValidatorKey{},
+		MissingKeys: []types.ValidatorPubkey{},
+	}
+
+	// Get the node's validators
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	validators, err := getNodeValidators(rp, nodeAccount.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map the derived keys to their paths so the exported keystores can record them
+	derivedPaths, err := getDerivedValidatorKeyPaths(w)
+	if err != nil {
+		return nil, err
+	}
+
+	encryptor := eth2ks.New(eth2ks.WithCipher("scrypt"))
+	for _, validator := range validators {
+		if !filter.matches(validator) {
+			continue
+		}
+
+		// Load the key from the node's keystores
+		key, err := w.LoadValidatorKey(validator.pubkey)
+		if err != nil || key == nil {
+			response.MissingKeys = append(response.MissingKeys, validator.pubkey)
+			continue
+		}
+
+		// Encrypt it with a fresh password
+		password, err := keystore.GenerateRandomPassword()
+		if err != nil {
+			return nil, fmt.Errorf("error generating password for validator %s: %w", validator.pubkey.Hex(), err)
+		}
+		encryptedKey, err := encryptor.Encrypt(key.Marshal(), password)
+		if err != nil {
+			return nil, fmt.Errorf("error encrypting key for validator %s: %w", validator.pubkey.Hex(), err)
+		}
+
+		response.Keys = append(response.Keys, api.ExportedValidatorKey{
+			Keystore: api.ValidatorKeystore{
+				Crypto:  encryptedKey,
+				Version: encryptor.Version(),
+				UUID:    uuid.New(),
+				Path:    derivedPaths[validator.pubkey],
+				Pubkey:  validator.pubkey,
+			},
+			Password:            password,
+			MinipoolAddress:     validator.minipoolAddress,
+			MegapoolValidatorId: validator.megapoolValidatorId,
+		})
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func importValidatorKeys(c *cli.Command, keys []api.ImportedValidatorKey, filter validatorKeyFilter) (*api.ImportValidatorKeysResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.ImportValidatorKeysResponse{
+		ImportedKeys: []types.ValidatorPubkey{},
+		SkippedKeys:  []types.ValidatorPubkey{},
+	}
+
+	// Get the node's validators
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	validators, err := getNodeValidators(rp, nodeAccount.Address)
+	if err != nil {
+		return nil, err
+	}
+	validatorMap := make(map[types.ValidatorPubkey]nodeValidator, len(validators))
+	for _, validator := range validators {
+		validatorMap[validator.pubkey] = validator
+	}
+
+	// Decrypt and check every keystore before storing any of them
+	privateKeys := make([]*eth2types.BLSPrivateKey, 0, len(keys))
+	paths := make([]string, 0, len(keys))
+	unknown := []string{}
+	for _, key := range keys {
+		privateKey, err := decryptValidatorKeystore(key.Keystore, key.Password)
+		if err != nil {
+			return nil, err
+		}
+
+		validator, exists := validatorMap[key.Keystore.Pubkey]
+		if !exists {
+			unknown = append(unknown, key.Keystore.Pubkey.Hex())
+			continue
+		}
+		if !filter.matches(validator) {
+			response.SkippedKeys = append(response.SkippedKeys, key.Keystore.Pubkey)
+			continue
+		}
+		privateKeys = append(privateKeys, privateKey)
+		paths = append(paths, key.Keystore.Path)
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("the following keystores don't belong to any of this node's minipools or megapool validators:\n%s", strings.Join(unknown, "\n"))
+	}
+
+	// Store the keys
+	for i, privateKey := range privateKeys {
+		if err := w.StoreValidatorKey(privateKey, paths[i]); err != nil {
+			return nil, fmt.Errorf("error storing keystore: %w", err)
+		}
+		response.ImportedKeys = append(response.ImportedKeys, types.BytesToValidatorPubkey(privateKey.PublicKey().Marshal()))
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Get all of the node's minipool and megapool validators that have a pubkey assigned
+func getNodeValidators(rp *rocketpool.RocketPool, nodeAddress common.Address) ([]nodeValidator, error) {
+
+	// Get the minipool pubkeys
+	minipoolAddresses, err := minipool.GetNodeMinipoolAddresses(rp, nodeAddress, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting node minipool addresses: %w", err)
+	}
+	minipoolPubkeys := make([]types.ValidatorPubkey, len(minipoolAddresses))
+	var wg errgroup.Group
+	for i, address := range minipoolAddresses {
+		wg.Go(func() error {
+			pubkey, err := minipool.GetMinipoolPubkey(rp, address, nil)
+			if err != nil {
+				return fmt.Errorf("error getting pubkey for minipool %s: %w", address.Hex(), err)
+			}
+			minipoolPubkeys[i] = pubkey
+			return nil
+		})
+	}
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	zeroPubkey := types.ValidatorPubkey{}
+	validators := []nodeValidator{}
+	for i, pubkey := range minipoolPubkeys {
+		if pubkey == zeroPubkey {
+			continue
+		}
+		address := minipoolAddresses[i]
+		validators = append(validators, nodeValidator{
+			pubkey:          pubkey,
+			minipoolAddress: &address,
+		})
+	}
+
+	// Get the megapool pubkeys, which are returned in validator ID order
+	megapoolDeployed, err := megapool.GetMegapoolDeployed(rp, nodeAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+	if megapoolDeployed {
+		megapoolAddress, err := megapool.GetMegapoolExpectedAddress(rp, nodeAddress, nil)
+		if err != nil {
+			return nil, err
+		}
+		mp, err := megapool.NewMegaPoolV1(rp, megapoolAddress, nil)
+		if err != nil {
+			return nil, err
+		}
+		megapoolPubkeys, err := mp.GetMegapoolPubkeys(nil)
+		if err != nil {
+			return nil, err
+		}
+		for i, pubkey := range megapoolPubkeys {
+			if pubkey == zeroPubkey {
+				continue
+			}
+			id := uint32(i)
+			validators = append(validators, nodeValidator{
+				pubkey:              pubkey,
+				megapoolValidatorId: &id,
+			})
+		}
+	}
+
+	return validators, nil
+
+}
+
+// Get the derivation path of every validator key the wallet has generated so far
+func getDerivedValidatorKeyPaths(w wallet.Wallet) (map[types.ValidatorPubkey]string, error) {
+	count, err := w.GetValidatorKeyCount()
+	if err != nil {
+		return nil, err
+	}
+	keys, err := w.GetValidatorKeys(0, count)
+	if err != nil {
+		return nil, err
+	}
+	paths := make(map[types.ValidatorPubkey]string, len(keys))
+	for _, key := range keys {
+		paths[key.PublicKey] = key.DerivationPath
+	}
+	return paths, nil
+}
+
+// Decrypt an EIP-2335 keystore and make sure the key inside matches the pubkey it claims to be for
+func decryptValidatorKeystore(keystore api.ValidatorKeystore, password string) (*eth2types.BLSPrivateKey, error) {
+
+	// Initialize the BLS library
+	if err := eth2types.InitBLS(); err != nil {
+		return nil, fmt.Errorf("error initializing BLS: %w", err)
+	}
+
+	// Get the encryption function it uses
+	kdf, exists := keystore.Crypto["kdf"]
+	if !exists {
+		return nil, fmt.Errorf("error processing keystore for validator %s: \"crypto\" didn't contain a subkey named \"kdf\"", keystore.Pubkey.Hex())
+	}
+	kdfMap, ok := kdf.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("error processing keystore for validator %s: \"crypto.kdf\" is not an object", keystore.Pubkey.Hex())
+	}
+	function, exists := kdfMap["function"]
+	if !exists {
+		return nil, fmt.Errorf("error processing keystore for validator %s: \"crypto.kdf\" didn't contain a subkey named \"function\"", keystore.Pubkey.Hex())
+	}
+	functionString, ok := function.(string)
+	if !ok {
+		return nil, fmt.Errorf("error processing keystore for validator %s: \"crypto.kdf.function\" is not a string", keystore.Pubkey.Hex())
+	}
+
+	// Decrypt the private key
+	encryptor := eth2ks.New(eth2ks.WithCipher(functionString))
+	decryptedKey, err := encryptor.Decrypt(keystore.Crypto, password)
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting keystore for validator %s: %w", keystore.Pubkey.Hex(), err)
+	}
+	privateKey, err := eth2types.BLSPrivateKeyFromBytes(decryptedKey)
+	if err != nil {
+		return nil, fmt.Errorf("error recreating private key for validator %s: %w", keystore.Pubkey.Hex(), err)
+	}
+
+	// Verify the private key matches the public key
+	reconstructedPubkey := types.BytesToValidatorPubkey(privateKey.PublicKey().Marshal())
+	if reconstructedPubkey != keystore.Pubkey {
+		return nil, fmt.Errorf("keystore claims to be for validator %s but it's for validator %s", keystore.Pubkey.Hex(), reconstructedPubkey.Hex())
+	}
+
+	return privateKey, nil
+
+}
@@ -0,0 +1,92 @@
+package wallet
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Builds a pbkdf2 keystore (fast enough for tests) for a freshly generated key
+func newTestKeystore(t *testing.T, password string) (api.ValidatorKeystore, *eth2types.BLSPrivateKey) {
+	t.Helper()
+	if err := eth2types.InitBLS(); err != nil {
+		t.Fatalf("error initializing BLS: %v", err)
+	}
+	key, err := eth2types.GenerateBLSPrivateKey()
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+	encryptor := eth2ks.New(eth2ks.WithCipher("pbkdf2"))
+	crypto, err := encryptor.Encrypt(key.Marshal(), password)
+	if err != nil {
+		t.Fatalf("error encrypting key: %v", err)
+	}
+	return api.ValidatorKeystore{
+		Crypto:  crypto,
+		Version: encryptor.Version(),
+		Pubkey:  types.BytesToValidatorPubkey(key.PublicKey().Marshal()),
+	}, key
+}
+
+func TestDecryptValidatorKeystore(t *testing.T) {
+	keystore, key := newTestKeystore(t, "correct horse battery staple")
+
+	decrypted, err := decryptValidatorKeystore(keystore, "correct horse battery staple")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(decrypted.Marshal()) != string(key.Marshal()) {
+		t.Error("decrypted key doesn't match the original")
+	}
+
+	if _, err := decryptValidatorKeystore(keystore, "wrong password"); err == nil {
+		t.Error("expected an error for the wrong password")
+	}
+}
+
+func TestDecryptValidatorKeystoreRejectsMismatchedPubkey(t *testing.T) {
+	keystore, _ := newTestKeystore(t, "password")
+	other, _ := newTestKeystore(t, "password")
+	keystore.Pubkey = other.Pubkey
+
+	_, err := decryptValidatorKeystore(keystore, "password")
+	if err == nil || !strings.Contains(err.Error(), "claims to be for validator") {
+		t.Errorf("expected a pubkey mismatch error, got %v", err)
+	}
+}
+
+func TestValidatorKeyFilter(t *testing.T) {
+	minipoolAddress := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	megapoolId := uint32(3)
+	minipoolValidator := nodeValidator{pubkey: types.ValidatorPubkey{1}, minipoolAddress: &minipoolAddress}
+	megapoolValidator := nodeValidator{pubkey: types.ValidatorPubkey{2}, megapoolValidatorId: &megapoolId}
+
+	tests := []struct {
+		name         string
+		filter       validatorKeyFilter
+		wantMinipool bool
+		wantMegapool bool
+	}{
+		{"empty filter selects everything", validatorKeyFilter{}, true, true},
+		{"by pubkey", validatorKeyFilter{pubkeys: []types.ValidatorPubkey{{2}}}, false, true},
+		{"by minipool", validatorKeyFilter{minipools: []common.Address{minipoolAddress}}, true, false},
+		{"by megapool validator ID", validatorKeyFilter{megapoolValidatorIds: []uint32{3}}, false, true},
+		{"megapool ID doesn't match minipools", validatorKeyFilter{megapoolValidatorIds: []uint32{0}}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.matches(minipoolValidator); got != tt.wantMinipool {
+				t.Errorf("minipool validator: got %v, want %v", got, tt.wantMinipool)
+			}
+			if got := tt.filter.matches(megapoolValidator); got != tt.wantMegapool {
+				t.Errorf("megapool validator: got %v, want %v", got, tt.wantMegapool)
+			}
+		})
+	}
+}
@@ -0,0 +1,296 @@
+package node
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v3"
+
+	"github.com/rocket-pool/smartnode/bindings/dao/protocol"
+	"github.com/rocket-pool/smartnode/bindings/network"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/bindings/transactions"
+	"github.com/rocket-pool/smartnode/bindings/transactions/gaslimit"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	log "github.com/rocket-pool/smartnode/shared/logger"
+	"github.com/rocket-pool/smartnode/shared/math"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
+	"github.com/rocket-pool/smartnode/shared/services/proposals"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+)
+
+// Votes are submitted regardless of the gas threshold once the voting phase is this close to ending
+const autoVoteDeadlineWindow = 24 * time.Hour
+
+// Automatically vote on pDAO proposals task
+type autoVotePdaoProps struct {
+	c              *cli.Command
+	log            log.ColorLogger
+	cfg            *config.RocketPoolConfig
+	w              wallet.Wallet
+	rp             *rocketpool.RocketPool
+	propMgr        *proposals.ProposalManager
+	gasThreshold   float64
+	maxFee         *big.Int
+	maxPriorityFee *big.Int
+	gasLimit       uint64
+
+	// The proposal state each proposal was last decided on, so every decision is only made and logged once per phase
+	decided map[uint64]types.ProtocolDaoProposalState
+}
+
+// Create automatically vote on pDAO proposals task
+func newAutoVotePdaoProps(c *cli.Command, logger log.ColorLogger) (*autoVotePdaoProps, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the user-requested max fee
+	maxFeeGwei := cfg.Smartnode.ManualMaxFee.Value.(float64)
+	var maxFee *big.Int
+	if maxFeeGwei == 0 {
+		maxFee = nil
+	} else {
+		maxFee = math.GweiToWei(maxFeeGwei)
+	}
+
+	// Get the user-requested priority fee
+	priorityFeeGwei := cfg.Smartnode.PriorityFee.Value.(float64)
+	var priorityFee *big.Int
+	if priorityFeeGwei == 0 {
+		logger.Printlnf("WARNING: priority fee was missing or 0, setting a default of %.2f.", rpgas.DefaultPriorityFeeGwei)
+		priorityFee = math.GweiToWei(rpgas.DefaultPriorityFeeGwei)
+	} else {
+		priorityFee = math.GweiToWei(priorityFeeGwei)
+	}
+
+	// Make a proposal manager
+	propMgr, err := proposals.NewProposalManager(&logger, cfg, rp, bc)
+	if err != nil {
+		return nil, fmt.Errorf("error creating proposal manager: %w", err)
+	}
+
+	// Return task
+	return &autoVotePdaoProps{
+		c:              c,
+		log:            logger,
+		cfg:            cfg,
+		w:              w,
+		rp:             rp,
+		propMgr:        propMgr,
+		gasThreshold:   cfg.Smartnode.AutoTxGasThreshold.Value.(float64),
+		maxFee:         maxFee,
+		maxPriorityFee: priorityFee,
+		gasLimit:       0,
+		decided:        map[uint64]types.ProtocolDaoProposalState{},
+	}, nil
+
+}
+
+// Vote on the active pDAO proposals according to the node's voting policy
+func (t *autoVotePdaoProps) run(state *state.NetworkState) error {
+
+	// The policy file is the opt-in
+	policy, err := proposals.LoadVotePolicy(t.cfg.Smartnode.GetVotePolicyPath())
+	if err != nil {
+		return err
+	}
+	if policy == nil {
+		return nil
+	}
+
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+	opts := &bind.CallOpts{
+		BlockNumber: big.NewInt(0).SetUint64(state.ElBlockNumber),
+	}
+
+	t.log.Println("Checking for Protocol DAO proposals to vote on...")
+	for _, prop := range state.ProtocolDaoProposalDetails {
+		if prop.State != types.ProtocolDaoProposalState_ActivePhase1 && prop.State != types.ProtocolDaoProposalState_ActivePhase2 {
+			delete(t.decided, prop.ID)
+			continue
+		}
+		if t.decided[prop.ID] == prop.State {
+			continue
+		}
+		if err := t.voteOnProposal(policy, prop, nodeAccount.Address, opts); err != nil {
+			t.log.Printlnf("Error voting on proposal %d: %s", prop.ID, err.Error())
+		}
+	}
+
+	return nil
+
+}
+
+// Decide on and submit the node's vote for a proposal in its current phase
+func (t *autoVotePdaoProps) voteOnProposal(policy *proposals.VotePolicy, prop protocol.ProtocolDaoProposalDetails, nodeAddress common.Address, opts *bind.CallOpts) error {
+	// Nothing to do if the node already voted
+	nodeVote, err := protocol.GetAddressVoteDirection(t.rp, prop.ID, nodeAddress, opts)
+	if err != nil {
+		return err
+	}
+	if nodeVote != types.VoteDirection_NoVote {
+		t.decided[prop.ID] = prop.State
+		return nil
+	}
+
+	policyProposal, err := t.getPolicyProposal(prop)
+	if err != nil {
+		return err
+	}
+
+	var phaseEnd time.Time
+	var decision proposals.VoteDecision
+	var submitVote func(direction types.VoteDirection, opts *bind.TransactOpts) (gaslimit.Limits, func() (common.Hash, error), error)
+	if prop.State == types.ProtocolDaoProposalState_ActivePhase1 {
+		// Phase 1 is for delegates, voting with the power delegated to them
+		phaseEnd = prop.Phase1EndTime
+		votingPower, nodeIndex, proof, err := t.propMgr.GetArtifactsForVoting(prop.TargetBlock, nodeAddress)
+		if err != nil {
+			return fmt.Errorf("error getting voting artifacts: %w", err)
+		}
+		if votingPower.Sign() == 0 {
+			t.log.Printlnf("Proposal %d: no voting power was delegated to the node, waiting for phase 2.", prop.ID)
+			t.decided[prop.ID] = prop.State
+			return nil
+		}
+		decision = policy.Decide(policyProposal, types.VoteDirection_NoVote)
+		submitVote = func(direction types.VoteDirection, txOpts *bind.TransactOpts) (gaslimit.Limits, func() (common.Hash, error), error) {
+			gasLimits, err := protocol.EstimateVoteOnProposalGas(t.rp, prop.ID, direction, votingPower, nodeIndex, proof, txOpts)
+			return gasLimits, func() (common.Hash, error) {
+				return protocol.VoteOnProposal(t.rp, prop.ID, direction, votingPower, nodeIndex, proof, txOpts)
+			}, err
+		}
+	} else {
+		// Phase 2 is for overriding the delegate's vote
+		phaseEnd = prop.Phase2EndTime
+		delegate, err := network.GetVotingDelegate(t.rp, nodeAddress, prop.TargetBlock, opts)
+		if err != nil {
+			return fmt.Errorf("error getting voting delegate: %w", err)
+		}
+		if delegate == nodeAddress {
+			t.log.Printlnf("Proposal %d: the node was its own delegate, so it can't override a vote in phase 2.", prop.ID)
+			t.decided[prop.ID] = prop.State
+			return nil
+		}
+		votingPower, err := network.GetVotingPower(t.rp, nodeAddress, prop.TargetBlock, opts)
+		if err != nil {
+			return fmt.Errorf("error getting voting power: %w", err)
+		}
+		if votingPower.Sign() == 0 {
+			t.log.Printlnf("Proposal %d: the node had no voting power when the proposal was made.", prop.ID)
+			t.decided[prop.ID] = prop.State
+			return nil
+		}
+		delegateVote, err := protocol.GetAddressVoteDirection(t.rp, prop.ID, delegate, opts)
+		if err != nil {
+			return fmt.Errorf("error getting the delegate's vote: %w", err)
+		}
+		decision = policy.Decide(policyProposal, delegateVote)
+		if decision.Vote.Direction() == delegateVote {
+			t.log.Printlnf("Proposal %d: keeping delegate %s's vote (%s); %s.", prop.ID, delegate.Hex(), decision.Vote, decision.Reason)
+			t.decided[prop.ID] = prop.State
+			return nil
+		}
+		submitVote = func(direction types.VoteDirection, txOpts *bind.TransactOpts) (gaslimit.Limits, func() (common.Hash, error), error) {
+			gasLimits, err := protocol.EstimateOverrideVoteGas(t.rp, prop.ID, direction, txOpts)
+			return gasLimits, func() (common.Hash, error) {
+				return protocol.OverrideVote(t.rp, prop.ID, direction, txOpts)
+			}, err
+		}
+	}
+
+	if decision.Vote == proposals.PolicyVoteNone {
+		t.log.Printlnf("Proposal %d: not voting; %s.", prop.ID, decision.Reason)
+		t.decided[prop.ID] = prop.State
+		return nil
+	}
+	t.log.Printlnf("Proposal %d: voting %s; %s.", prop.ID, decision.Vote, decision.Reason)
+
+	// Get the gas limit
+	txOpts, err := t.w.GetNodeAccountTransactor()
+	if err != nil {
+		return err
+	}
+	gasLimits, submit, err := submitVote(decision.Vote.Direction(), txOpts)
+	if err != nil {
+		return fmt.Errorf("error estimating the gas required to vote: %w", err)
+	}
+
+	// Get the max fee
+	maxFee := t.maxFee
+	if maxFee == nil || maxFee.Uint64() == 0 {
+		maxFee, err = rpgas.GetHeadlessMaxFeeWeiWithLatestBlock(t.cfg, t.rp)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Print the gas info; only wait for cheaper gas if there's time left in the phase
+	if !gasLimits.PrintAndCheck(true, t.gasThreshold, &t.log, maxFee, t.gasLimit) {
+		if time.Until(phaseEnd) > autoVoteDeadlineWindow {
+			return nil
+		}
+		t.log.Println("NOTICE: The voting phase ends soon, voting despite the automatic TX gas threshold.")
+	}
+
+	txOpts.GasFeeCap = maxFee
+	txOpts.GasTipCap = GetPriorityFee(t.maxPriorityFee, maxFee)
+	txOpts.GasLimit = gasLimits.Safe
+
+	// Vote
+	hash, err := submit()
+	if err != nil {
+		return err
+	}
+	err = transactions.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, &t.log)
+	if err != nil {
+		return err
+	}
+
+	t.log.Printlnf("Successfully voted %s on proposal %d.", decision.Vote, prop.ID)
+	t.decided[prop.ID] = prop.State
+	return nil
+}
+
+// Get the details of a proposal the voting policy needs
+func (t *autoVotePdaoProps) getPolicyProposal(prop protocol.ProtocolDaoProposalDetails) (proposals.PolicyProposal, error) {
+	policyProposal := proposals.PolicyProposal{
+		ID:       prop.ID,
+		Proposer: prop.ProposerAddress,
+		Kind:     proposals.ProposalKindUnknown,
+	}
+	if len(prop.Payload) < 4 {
+		return policyProposal, nil
+	}
+	method, args, err := protocol.DecodeProposalPayload(t.rp, prop.Payload)
+	if err != nil {
+		return policyProposal, fmt.Errorf("error decoding payload: %w", err)
+	}
+	policyProposal.Kind, policyProposal.SpendAmount = proposals.GetProposalKind(method.RawName, args)
+	return policyProposal, nil
+}
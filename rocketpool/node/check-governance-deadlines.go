@@ -0,0 +1,237 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v3"
+
+	"github.com/rocket-pool/smartnode/bindings/dao/protocol"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/rocketpool/api/governance"
+	log "github.com/rocket-pool/smartnode/shared/logger"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/alerting"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// How often to check for governance deadlines; proposals are only checked on this interval to limit the Snapshot API calls
+const governanceDeadlineCheckInterval = 30 * time.Minute
+
+// A challenge against one of the node's pDAO proposals that hasn't been responded to yet
+type openChallenge struct {
+	proposalID uint64
+	index      uint64
+	deadline   time.Time
+}
+
+// Check governance deadlines task
+type checkGovernanceDeadlines struct {
+	c   *cli.Command
+	log log.ColorLogger
+	cfg *config.RocketPoolConfig
+	w   wallet.Wallet
+	rp  *rocketpool.RocketPool
+
+	// The time of the last check
+	lastCheck time.Time
+
+	// The deadlines that have already been alerted on, so each one is only sent once
+	alerted map[string]bool
+}
+
+// Create check governance deadlines task
+func newCheckGovernanceDeadlines(c *cli.Command, logger log.ColorLogger) (*checkGovernanceDeadlines, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return task
+	return &checkGovernanceDeadlines{
+		c:       c,
+		log:     logger,
+		cfg:     cfg,
+		w:       w,
+		rp:      rp,
+		alerted: map[string]bool{},
+	}, nil
+
+}
+
+// Alert on the open proposals the node hasn't voted on whose current voting phase closes soon, and on the unanswered challenges
+// against the node's own pDAO proposals whose response window closes soon
+func (t *checkGovernanceDeadlines) run() error {
+
+	// Only do the work if someone will hear about it
+	if t.cfg.Alertmanager.EnableAlerting.Value != true || t.cfg.Alertmanager.AlertEnabled_GovernanceDeadlines.Value != true {
+		return nil
+	}
+	if time.Since(t.lastCheck) < governanceDeadlineCheckInterval {
+		return nil
+	}
+
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+
+	// Check the two independently so a problem with one doesn't hide the other's alerts
+	alertWindow := time.Duration(t.cfg.Alertmanager.GovernanceDeadlineHours.Value.(uint64)) * time.Hour
+	err = errors.Join(
+		t.checkVoteDeadlines(nodeAccount.Address, alertWindow),
+		t.checkChallengeDeadlines(nodeAccount.Address, alertWindow),
+	)
+	if err != nil {
+		return err
+	}
+	t.lastCheck = time.Now()
+
+	return nil
+
+}
+
+// Alert on the open proposals the node hasn't voted on whose current voting phase closes soon
+func (t *checkGovernanceDeadlines) checkVoteDeadlines(nodeAddress common.Address, alertWindow time.Duration) error {
+	events, snapshotError, err := governance.GetUpcomingEvents(t.c, nodeAddress)
+	if err != nil {
+		return fmt.Errorf("error getting upcoming governance events: %w", err)
+	}
+	if snapshotError != "" {
+		t.log.Printlnf("WARNING: couldn't check Snapshot proposals: %s", snapshotError)
+	}
+
+	for _, event := range getGovernanceDeadlineAlerts(events, time.Now(), alertWindow) {
+		key := fmt.Sprintf("%s-%s-%s", event.DAO, event.ProposalID, event.NextPhase)
+		if t.alerted[key] {
+			continue
+		}
+		t.log.Printlnf("WARNING: the node hasn't voted on %s proposal %s yet, and voting closes at %s.", event.DAO, event.ProposalID, event.Time.Format(time.RFC822))
+		if err := alerting.AlertGovernanceVoteClosing(t.cfg, string(event.DAO), event.ProposalID, event.Title, event.Time); err != nil {
+			t.log.Printlnf("error sending governance deadline alert: %s", err.Error())
+			continue
+		}
+		t.alerted[key] = true
+	}
+	return nil
+}
+
+// Alert on the unanswered challenges against the node's pDAO proposals whose response window closes soon
+func (t *checkGovernanceDeadlines) checkChallengeDeadlines(nodeAddress common.Address, alertWindow time.Duration) error {
+	challenges, err := t.getOpenChallenges(nodeAddress)
+	if err != nil {
+		return fmt.Errorf("error getting open challenges against the node's proposals: %w", err)
+	}
+
+	for _, challenge := range getChallengeDeadlineAlerts(challenges, time.Now(), alertWindow) {
+		key := fmt.Sprintf("challenge-%d-%d", challenge.proposalID, challenge.index)
+		if t.alerted[key] {
+			continue
+		}
+		t.log.Printlnf("WARNING: the challenge against index %d of pDAO proposal %d hasn't been responded to yet, and the response window closes at %s.", challenge.index, challenge.proposalID, challenge.deadline.Format(time.RFC822))
+		if err := alerting.AlertProposalChallengeUnanswered(t.cfg, challenge.proposalID, challenge.index, challenge.deadline); err != nil {
+			t.log.Printlnf("error sending challenge alert: %s", err.Error())
+			continue
+		}
+		t.alerted[key] = true
+	}
+	return nil
+}
+
+// Get the challenges against the node's pDAO proposals that are still in the challenge phase and haven't been responded to
+func (t *checkGovernanceDeadlines) getOpenChallenges(nodeAddress common.Address) ([]openChallenge, error) {
+	props, err := protocol.GetProposals(t.rp, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting Protocol DAO proposals: %w", err)
+	}
+
+	// Challenges can only be made after a proposal's target block
+	ids := []uint64{}
+	propMap := map[uint64]protocol.ProtocolDaoProposalDetails{}
+	var startBlock *big.Int
+	for _, prop := range props {
+		if prop.ProposerAddress != nodeAddress || prop.State != types.ProtocolDaoProposalState_Pending {
+			continue
+		}
+		ids = append(ids, prop.ID)
+		propMap[prop.ID] = prop
+		targetBlock := big.NewInt(int64(prop.TargetBlock))
+		if startBlock == nil || targetBlock.Cmp(startBlock) < 0 {
+			startBlock = targetBlock
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	latestBlock, err := t.rp.Client.BlockNumber(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("error getting latest block number: %w", err)
+	}
+	intervalSize := big.NewInt(int64(t.cfg.Geth.EventLogInterval))
+	verifierAddresses := t.cfg.Smartnode.GetPreviousRocketDAOProtocolVerifierAddresses()
+	events, err := protocol.GetChallengeSubmittedEvents(t.rp, ids, intervalSize, startBlock, big.NewInt(0).SetUint64(latestBlock), verifierAddresses, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error scanning for ChallengeSubmitted events: %w", err)
+	}
+
+	challenges := []openChallenge{}
+	for _, event := range events {
+		propID := event.ProposalID.Uint64()
+		index := event.Index.Uint64()
+		state, err := protocol.GetChallengeState(t.rp, propID, index, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error checking state of challenge on proposal %d, index %d: %w", propID, index, err)
+		}
+		if state != types.ChallengeState_Challenged {
+			continue
+		}
+		challenges = append(challenges, openChallenge{
+			proposalID: propID,
+			index:      index,
+			deadline:   event.Timestamp.Add(propMap[propID].ChallengeWindow),
+		})
+	}
+	return challenges, nil
+}
+
+// Get the events for proposals the node still needs to vote on whose current phase closes within the alert window
+func getGovernanceDeadlineAlerts(events []api.GovernanceEvent, now time.Time, alertWindow time.Duration) []api.GovernanceEvent {
+	alerts := []api.GovernanceEvent{}
+	for _, event := range events {
+		if !event.NeedsVote || event.Time.Before(now) || event.Time.Sub(now) > alertWindow {
+			continue
+		}
+		alerts = append(alerts, event)
+	}
+	return alerts
+}
+
+// Get the open challenges whose response window closes within the alert window; challenges whose window already closed are skipped
+func getChallengeDeadlineAlerts(challenges []openChallenge, now time.Time, alertWindow time.Duration) []openChallenge {
+	alerts := []openChallenge{}
+	for _, challenge := range challenges {
+		if challenge.deadline.Before(now) || challenge.deadline.Sub(now) > alertWindow {
+			continue
+		}
+		alerts = append(alerts, challenge)
+	}
+	return alerts
+}
@@ -0,0 +1,44 @@
+package node
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func TestGetGovernanceDeadlineAlerts(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	events := []api.GovernanceEvent{
+		// Closing soon and not voted on
+		{ProposalID: "1", NeedsVote: true, Time: now.Add(2 * time.Hour)},
+		// Closing soon but already voted on
+		{ProposalID: "2", Voted: true, Time: now.Add(2 * time.Hour)},
+		// Not voted on but still far away
+		{ProposalID: "3", NeedsVote: true, Time: now.Add(48 * time.Hour)},
+		// Already closed
+		{ProposalID: "4", NeedsVote: true, Time: now.Add(-time.Minute)},
+	}
+
+	alerts := getGovernanceDeadlineAlerts(events, now, 24*time.Hour)
+	if len(alerts) != 1 || alerts[0].ProposalID != "1" {
+		t.Fatalf("got alerts %v, want only proposal 1", alerts)
+	}
+}
+
+func TestGetChallengeDeadlineAlerts(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	challenges := []openChallenge{
+		// Closing soon
+		{proposalID: 1, index: 2, deadline: now.Add(2 * time.Hour)},
+		// Still far away
+		{proposalID: 2, index: 2, deadline: now.Add(48 * time.Hour)},
+		// Already closed
+		{proposalID: 3, index: 2, deadline: now.Add(-time.Minute)},
+	}
+
+	alerts := getChallengeDeadlineAlerts(challenges, now, 24*time.Hour)
+	if len(alerts) != 1 || alerts[0].proposalID != 1 {
+		t.Fatalf("got alerts %v, want only proposal 1", alerts)
+	}
+}
@@ -0,0 +1,207 @@
+package node
+
+import (
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v3"
+
+	"github.com/rocket-pool/smartnode/bindings/types"
+	log "github.com/rocket-pool/smartnode/shared/logger"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/alerting"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+)
+
+// Check validator duties task
+type checkValidatorDuties struct {
+	c   *cli.Command
+	log log.ColorLogger
+	cfg *config.RocketPoolConfig
+	w   wallet.Wallet
+	bc  beacon.Client
+
+	// The duty performance of the node's validators in each recently finalized epoch, keyed by validator index
+	epochs map[uint64]map[string]*beacon.DutyPerformance
+
+	// The last epoch that was checked
+	lastCheckedEpoch uint64
+}
+
+// A duty a validator missed more often than the configured threshold allows
+type missedDutyAlert struct {
+	index  string
+	duty   alerting.DutyKind
+	missed uint64
+}
+
+// Create check validator duties task
+func newCheckValidatorDuties(c *cli.Command, logger log.ColorLogger) (*checkValidatorDuties, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return task
+	return &checkValidatorDuties{
+		c:      c,
+		log:    logger,
+		cfg:    cfg,
+		w:      w,
+		bc:     bc,
+		epochs: map[uint64]map[string]*beacon.DutyPerformance{},
+	}, nil
+
+}
+
+// Check the duties of the node's validators in the newly finalized epochs and alert on any that are missing too many
+func (t *checkValidatorDuties) run(state *state.NetworkState) error {
+
+	// Only do the work if someone will hear about it
+	if t.cfg.Alertmanager.EnableAlerting.Value != true || t.cfg.Alertmanager.AlertEnabled_MissedDuties.Value != true {
+		return nil
+	}
+	window := t.cfg.Alertmanager.MissedDutiesWindow.Value.(uint64)
+	if window == 0 {
+		return nil
+	}
+
+	// Get the node's active validators
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+	head, err := t.bc.GetBeaconHead()
+	if err != nil {
+		return err
+	}
+
+	// Attestations can be included up to the end of the following epoch, so the newest complete one is the one before the finalized epoch
+	if head.FinalizedEpoch == 0 {
+		return nil
+	}
+	latestEpoch := head.FinalizedEpoch - 1
+	firstEpoch := uint64(0)
+	if latestEpoch+1 > window {
+		firstEpoch = latestEpoch + 1 - window
+	}
+	if t.lastCheckedEpoch >= firstEpoch && len(t.epochs) > 0 {
+		firstEpoch = t.lastCheckedEpoch + 1
+	}
+	if firstEpoch > latestEpoch {
+		return nil
+	}
+
+	pubkeys := getActiveValidatorPubkeys(state, nodeAccount.Address, latestEpoch)
+	if len(pubkeys) == 0 {
+		return nil
+	}
+	indices := make([]string, 0, len(pubkeys))
+	for index := range pubkeys {
+		indices = append(indices, index)
+	}
+
+	// Check the new epochs
+	t.log.Printlnf("Checking the duties of %d validator(s) in epochs %d to %d...", len(indices), firstEpoch, latestEpoch)
+	for epoch := firstEpoch; epoch <= latestEpoch; epoch++ {
+		performance, err := beacon.GetEpochDutyPerformance(t.bc, epoch, state.BeaconConfig.SlotsPerEpoch, indices)
+		if err != nil {
+			return err
+		}
+		t.epochs[epoch] = performance
+		t.lastCheckedEpoch = epoch
+	}
+
+	// Forget the epochs that fell out of the window
+	totals := map[string]beacon.DutyPerformance{}
+	for epoch, performance := range t.epochs {
+		if epoch+window <= latestEpoch {
+			delete(t.epochs, epoch)
+			continue
+		}
+		for index, validatorPerformance := range performance {
+			total := totals[index]
+			total.Add(*validatorPerformance)
+			totals[index] = total
+		}
+	}
+
+	// Alert on the validators that missed too much
+	attestationThreshold := t.cfg.Alertmanager.MissedAttestationsThreshold.Value.(uint64)
+	syncThreshold := t.cfg.Alertmanager.MissedSyncDutiesThreshold.Value.(uint64)
+	for _, alert := range getMissedDutyAlerts(totals, attestationThreshold, syncThreshold) {
+		pubkey, exists := pubkeys[alert.index]
+		if !exists {
+			continue
+		}
+		t.log.Printlnf("WARNING: validator %s (index %s) missed %d %s duties in the last %d finalized epoch(s).", pubkey.Hex(), alert.index, alert.missed, alert.duty, len(t.epochs))
+		if err := alerting.AlertValidatorMissedDuties(t.cfg, pubkey.Hex(), alert.duty, alert.missed, uint64(len(t.epochs))); err != nil {
+			t.log.Printlnf("error sending missed duties alert: %s", err.Error())
+		}
+	}
+
+	return nil
+
+}
+
+// Get the pubkeys of the node's minipool and megapool validators that are active in the given epoch, keyed by validator index
+func getActiveValidatorPubkeys(networkState *state.NetworkState, nodeAddress common.Address, epoch uint64) map[string]types.ValidatorPubkey {
+	pubkeys := map[string]types.ValidatorPubkey{}
+	addValidator := func(pubkey types.ValidatorPubkey, validator beacon.ValidatorStatus) {
+		if validator.Exists && validator.ActivationEpoch <= epoch && epoch < validator.ExitEpoch {
+			pubkeys[validator.Index] = pubkey
+		}
+	}
+
+	for _, mpd := range networkState.MinipoolDetailsByNode[nodeAddress] {
+		addValidator(mpd.Pubkey, networkState.MinipoolValidatorDetails[mpd.Pubkey])
+	}
+
+	nodeDetails, exists := networkState.NodeDetailsByAddress[nodeAddress]
+	if exists && nodeDetails.MegapoolDeployed {
+		for _, pubkey := range networkState.MegapoolToPubkeysMap[nodeDetails.MegapoolAddress] {
+			addValidator(pubkey, networkState.MegapoolValidatorDetails[pubkey])
+		}
+	}
+
+	return pubkeys
+}
+
+// Get the duties each validator missed at least as often as the thresholds allow; a threshold of 0 disables that duty's alert.
+// Every missed proposal is alerted on.
+func getMissedDutyAlerts(totals map[string]beacon.DutyPerformance, attestationThreshold uint64, syncThreshold uint64) []missedDutyAlert {
+	alerts := []missedDutyAlert{}
+	for index, total := range totals {
+		if attestationThreshold > 0 && total.MissedAttestations >= attestationThreshold {
+			alerts = append(alerts, missedDutyAlert{index: index, duty: alerting.DutyKindAttestation, missed: total.MissedAttestations})
+		}
+		if missed := total.MissedProposals(); missed > 0 {
+			alerts = append(alerts, missedDutyAlert{index: index, duty: alerting.DutyKindProposal, missed: missed})
+		}
+		if syncThreshold > 0 && total.MissedSyncDuties >= syncThreshold {
+			alerts = append(alerts, missedDutyAlert{index: index, duty: alerting.DutyKindSyncCommittee, missed: total.MissedSyncDuties})
+		}
+	}
+
+	// Keep the log output stable between runs
+	sort.Slice(alerts, func(i, j int) bool {
+		if alerts[i].index != alerts[j].index {
+			return alerts[i].index < alerts[j].index
+		}
+		return alerts[i].duty < alerts[j].duty
+	})
+	return alerts
+}
@@ -0,0 +1,40 @@
+package node
+
+import (
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/services/alerting"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+func TestGetMissedDutyAlerts(t *testing.T) {
+	totals := map[string]beacon.DutyPerformance{
+		// Healthy validator
+		"10": {AttestationDuties: 8, MissedAttestations: 1, ProposalDuties: 1, Proposals: 1},
+		// Offline validator on the sync committee
+		"11": {AttestationDuties: 8, MissedAttestations: 8, SyncDuties: 256, MissedSyncDuties: 256},
+		// Missed a proposal but attested fine
+		"12": {AttestationDuties: 8, ProposalDuties: 1},
+	}
+
+	alerts := getMissedDutyAlerts(totals, 3, 32)
+	expected := []missedDutyAlert{
+		{index: "11", duty: alerting.DutyKindAttestation, missed: 8},
+		{index: "11", duty: alerting.DutyKindSyncCommittee, missed: 256},
+		{index: "12", duty: alerting.DutyKindProposal, missed: 1},
+	}
+	if len(alerts) != len(expected) {
+		t.Fatalf("expected %d alerts, got %+v", len(expected), alerts)
+	}
+	for i := range expected {
+		if alerts[i] != expected[i] {
+			t.Errorf("alert %d: expected %+v, got %+v", i, expected[i], alerts[i])
+		}
+	}
+
+	// A threshold of 0 disables the attestation and sync committee alerts, but not the proposal one
+	alerts = getMissedDutyAlerts(totals, 0, 0)
+	if len(alerts) != 1 || alerts[0].duty != alerting.DutyKindProposal {
+		t.Errorf("expected only the missed proposal alert, got %+v", alerts)
+	}
+}
@@ -0,0 +1,144 @@
+package collectors
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/rocket-pool/smartnode/bindings/dao/trustednode"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// How often the duty report is rebuilt; it scans weeks of event logs, so it isn't refreshed on every scrape
+const oDaoDutiesRefreshInterval = time.Hour
+
+// Represents the collector for the node's oDAO duty performance
+type ODaoDutiesCollector struct {
+	// The number of rounds of each duty per status over the lookback window
+	rounds *prometheus.Desc
+
+	// The number of rounds of each duty where the node's submission didn't match consensus
+	mismatched *prometheus.Desc
+
+	// Whether or not ODAO collection is enabled
+	enabled bool
+
+	// The Rocket Pool contract manager
+	rp *rocketpool.RocketPool
+
+	// The BC manager
+	bc *services.BeaconClientManager
+
+	// The Rocket Pool config
+	cfg *config.RocketPoolConfig
+
+	// The node's address
+	nodeAddress common.Address
+
+	// The latest summaries of the node's duties
+	lock        sync.Mutex
+	summaries   []api.TNDAODutySummary
+	lastRefresh time.Time
+	refreshing  bool
+
+	// Prefix for logging
+	logPrefix string
+}
+
+// Create a new ODaoDutiesCollector instance
+func NewODaoDutiesCollector(rp *rocketpool.RocketPool, bc *services.BeaconClientManager, nodeAddress common.Address, cfg *config.RocketPoolConfig) *ODaoDutiesCollector {
+	subsystem := "odao_duties"
+	return &ODaoDutiesCollector{
+		rounds: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "rounds"),
+			"The number of rounds of each oDAO duty the node submitted on time, late, missed, or that haven't been finalized",
+			[]string{"duty", "status"}, nil,
+		),
+		mismatched: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "mismatched"),
+			"The number of rounds of each oDAO duty where the node's submission didn't match the finalized consensus",
+			[]string{"duty"}, nil,
+		),
+		enabled:     cfg.EnableODaoMetrics.Value.(bool),
+		rp:          rp,
+		bc:          bc,
+		cfg:         cfg,
+		nodeAddress: nodeAddress,
+		logPrefix:   "ODAO Duties Collector",
+	}
+}
+
+// Write metric descriptions to the Prometheus channel
+func (collector *ODaoDutiesCollector) Describe(channel chan<- *prometheus.Desc) {
+	channel <- collector.rounds
+	channel <- collector.mismatched
+}
+
+// Collect the latest metric values and pass them to Prometheus
+func (collector *ODaoDutiesCollector) Collect(channel chan<- prometheus.Metric) {
+
+	if !collector.enabled {
+		return
+	}
+
+	collector.lock.Lock()
+	if !collector.refreshing && time.Since(collector.lastRefresh) >= oDaoDutiesRefreshInterval {
+		collector.refreshing = true
+		go collector.refresh()
+	}
+	summaries := collector.summaries
+	collector.lock.Unlock()
+
+	for _, summary := range summaries {
+		duty := string(summary.Duty)
+		channel <- prometheus.MustNewConstMetric(
+			collector.rounds, prometheus.GaugeValue, float64(summary.OnTime), duty, string(api.TNDAODutyStatus_OnTime))
+		channel <- prometheus.MustNewConstMetric(
+			collector.rounds, prometheus.GaugeValue, float64(summary.Late), duty, string(api.TNDAODutyStatus_Late))
+		channel <- prometheus.MustNewConstMetric(
+			collector.rounds, prometheus.GaugeValue, float64(summary.Missed), duty, string(api.TNDAODutyStatus_Missed))
+		channel <- prometheus.MustNewConstMetric(
+			collector.rounds, prometheus.GaugeValue, float64(summary.Unfinalized), duty, string(api.TNDAODutyStatus_Unfinalized))
+		channel <- prometheus.MustNewConstMetric(
+			collector.mismatched, prometheus.GaugeValue, float64(summary.Mismatched), duty)
+	}
+}
+
+// Rebuild the node's duty report
+func (collector *ODaoDutiesCollector) refresh() {
+	summaries, err := collector.getSummaries()
+
+	collector.lock.Lock()
+	defer collector.lock.Unlock()
+	collector.refreshing = false
+	collector.lastRefresh = time.Now()
+	if err != nil {
+		collector.logError(err)
+		return
+	}
+	collector.summaries = summaries
+}
+
+func (collector *ODaoDutiesCollector) getSummaries() ([]api.TNDAODutySummary, error) {
+	isMember, err := trustednode.GetMemberExists(collector.rp, collector.nodeAddress, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error checking if the node is an oDAO member: %w", err)
+	}
+	if !isMember {
+		return nil, nil
+	}
+	duties, err := services.GetODaoDuties(collector.rp, collector.bc, collector.cfg, collector.nodeAddress, services.DefaultODaoDutiesLookbackDays)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the oDAO duty report: %w", err)
+	}
+	return duties.Summaries, nil
+}
+
+// Log error messages
+func (collector *ODaoDutiesCollector) logError(err error) {
+	fmt.Printf("[%s] %s\n", collector.logPrefix, err.Error())
+}
@@ -0,0 +1,142 @@
+package collectors
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/rocket-pool/smartnode/bindings/megapool"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// How often the queue forecast is rebuilt; it scans days of event logs, so it isn't refreshed on every scrape
+const queueForecastRefreshInterval = time.Hour
+
+// Represents the collector for the megapool queue forecast of the node's validators
+type QueueForecastCollector struct {
+	// The position of each of the node's queued validators in the combined assignment order
+	position *prometheus.Desc
+
+	// The forecast assignment time of each of the node's queued validators, as a Unix timestamp
+	assignmentTime *prometheus.Desc
+
+	// The Rocket Pool contract manager
+	rp *rocketpool.RocketPool
+
+	// The BC manager
+	bc *services.BeaconClientManager
+
+	// The Rocket Pool config
+	cfg *config.RocketPoolConfig
+
+	// The node's address
+	nodeAddress common.Address
+
+	// The latest forecast for the node's validators
+	lock        sync.Mutex
+	entries     []api.QueueForecastEntry
+	lastRefresh time.Time
+	refreshing  bool
+
+	// Prefix for logging
+	logPrefix string
+}
+
+// Create a new QueueForecastCollector instance
+func NewQueueForecastCollector(rp *rocketpool.RocketPool, bc *services.BeaconClientManager, nodeAddress common.Address, cfg *config.RocketPoolConfig) *QueueForecastCollector {
+	subsystem := "queue_forecast"
+	return &QueueForecastCollector{
+		position: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "position"),
+			"The position of the node's queued megapool validator in the combined express and standard queue",
+			[]string{"validator_id"}, nil,
+		),
+		assignmentTime: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "assignment_time"),
+			"The forecast time the node's queued megapool validator will be assigned, as a Unix timestamp",
+			[]string{"validator_id", "estimate"}, nil,
+		),
+		rp:          rp,
+		bc:          bc,
+		cfg:         cfg,
+		nodeAddress: nodeAddress,
+		logPrefix:   "Queue Forecast Collector",
+	}
+}
+
+// Write metric descriptions to the Prometheus channel
+func (collector *QueueForecastCollector) Describe(channel chan<- *prometheus.Desc) {
+	channel <- collector.position
+	channel <- collector.assignmentTime
+}
+
+// Collect the latest metric values and pass them to Prometheus
+func (collector *QueueForecastCollector) Collect(channel chan<- prometheus.Metric) {
+	collector.lock.Lock()
+	if !collector.refreshing && time.Since(collector.lastRefresh) >= queueForecastRefreshInterval {
+		collector.refreshing = true
+		go collector.refresh()
+	}
+	entries := collector.entries
+	collector.lock.Unlock()
+
+	for _, entry := range entries {
+		validatorId := strconv.FormatUint(uint64(entry.ValidatorId), 10)
+		channel <- prometheus.MustNewConstMetric(
+			collector.position, prometheus.GaugeValue, float64(entry.Position), validatorId)
+		if !entry.ExpectedTime.IsZero() {
+			channel <- prometheus.MustNewConstMetric(
+				collector.assignmentTime, prometheus.GaugeValue, float64(entry.ExpectedTime.Unix()), validatorId, "expected")
+		}
+		channel <- prometheus.MustNewConstMetric(
+			collector.assignmentTime, prometheus.GaugeValue, float64(entry.EarliestTime.Unix()), validatorId, "earliest")
+		if !entry.Unbounded {
+			channel <- prometheus.MustNewConstMetric(
+				collector.assignmentTime, prometheus.GaugeValue, float64(entry.LatestTime.Unix()), validatorId, "latest")
+		}
+	}
+}
+
+// Rebuild the forecast for the node's validators
+func (collector *QueueForecastCollector) refresh() {
+	entries, err := collector.getNodeForecast()
+
+	collector.lock.Lock()
+	defer collector.lock.Unlock()
+	collector.refreshing = false
+	collector.lastRefresh = time.Now()
+	if err != nil {
+		collector.logError(err)
+		return
+	}
+	collector.entries = entries
+}
+
+func (collector *QueueForecastCollector) getNodeForecast() ([]api.QueueForecastEntry, error) {
+	megapoolDeployed, err := megapool.GetMegapoolDeployed(collector.rp, collector.nodeAddress, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error checking if the node's megapool is deployed: %w", err)
+	}
+	if !megapoolDeployed {
+		return nil, nil
+	}
+	megapoolAddress, err := megapool.GetMegapoolExpectedAddress(collector.rp, collector.nodeAddress, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the node's megapool address: %w", err)
+	}
+	forecast, err := services.GetQueueForecast(collector.rp, collector.bc, collector.cfg, services.DefaultQueueForecastLookbackDays)
+	if err != nil {
+		return nil, fmt.Errorf("error forecasting the megapool queue: %w", err)
+	}
+	return services.GetMegapoolQueueForecast(forecast, megapoolAddress), nil
+}
+
+// Log error messages
+func (collector *QueueForecastCollector) logError(err error) {
+	fmt.Printf("[%s] %s\n", collector.logPrefix, err.Error())
+}
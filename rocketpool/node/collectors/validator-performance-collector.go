@@ -0,0 +1,307 @@
+package collectors
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/shared/math"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+)
+
+// Represents the collector for the performance of each of the node's validators
+type ValidatorPerformanceCollector struct {
+	// The Beacon Chain balance of each validator
+	balance *prometheus.Desc
+
+	// The effective balance of each validator
+	effectiveBalance *prometheus.Desc
+
+	// The Beacon Chain status of each validator
+	status *prometheus.Desc
+
+	// The number of attestation duties each validator had in the epochs checked so far
+	attestationDuties *prometheus.Desc
+
+	// The number of attestations each validator missed in the epochs checked so far
+	missedAttestations *prometheus.Desc
+
+	// The number of blocks each validator proposed in the epochs checked so far
+	proposals *prometheus.Desc
+
+	// The number of slots each validator was due to sign as a sync committee member in the epochs checked so far
+	syncDuties *prometheus.Desc
+
+	// The number of sync committee signatures each validator missed in the epochs checked so far
+	missedSyncDuties *prometheus.Desc
+
+	// Whether each validator is on the current sync committee
+	syncCommittee *prometheus.Desc
+
+	// The Rocket Pool contract manager
+	rp *rocketpool.RocketPool
+
+	// The beacon client
+	bc beacon.Client
+
+	// The node's address
+	nodeAddress common.Address
+
+	// The thread-safe locker for the network state
+	stateLocker *StateLocker
+
+	// The duty performance of each validator, keyed by validator index
+	performance map[string]*beacon.DutyPerformance
+
+	// The last epoch that was checked for duties
+	lastCheckedEpoch uint64
+
+	// Serializes epoch processing between concurrent scrapes
+	lock *sync.Mutex
+
+	// Prefix for logging
+	logPrefix string
+}
+
+// One of the node's validators, with the labels used to identify it
+type nodeValidator struct {
+	pubkey      types.ValidatorPubkey
+	validatorId string
+	minipool    string
+	status      beacon.ValidatorStatus
+}
+
+// Create a new ValidatorPerformanceCollector instance
+func NewValidatorPerformanceCollector(rp *rocketpool.RocketPool, bc beacon.Client, nodeAddress common.Address, stateLocker *StateLocker) *ValidatorPerformanceCollector {
+	subsystem := "validator"
+	labels := []string{"pubkey", "validator_id", "minipool"}
+	return &ValidatorPerformanceCollector{
+		balance: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "balance"),
+			"The validator's balance on the Beacon Chain, in ETH",
+			labels, nil,
+		),
+		effectiveBalance: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "effective_balance"),
+			"The validator's effective balance on the Beacon Chain, in ETH",
+			labels, nil,
+		),
+		status: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "status"),
+			"The validator's status on the Beacon Chain",
+			append(labels, "status"), nil,
+		),
+		attestationDuties: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "attestation_duties_total"),
+			"The number of attestation duties the validator had in the finalized epochs checked since the node daemon started",
+			labels, nil,
+		),
+		missedAttestations: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "missed_attestations_total"),
+			"The number of attestations the validator missed in the finalized epochs checked since the node daemon started",
+			labels, nil,
+		),
+		proposals: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "proposals_total"),
+			"The number of blocks the validator proposed in the finalized epochs checked since the node daemon started",
+			labels, nil,
+		),
+		syncDuties: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "sync_duties_total"),
+			"The number of slots the validator was due to sign as a sync committee member in the finalized epochs checked since the node daemon started",
+			labels, nil,
+		),
+		missedSyncDuties: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "missed_sync_duties_total"),
+			"The number of sync committee signatures the validator missed in the finalized epochs checked since the node daemon started",
+			labels, nil,
+		),
+		syncCommittee: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "sync_committee"),
+			"Whether the validator is on the current sync committee",
+			labels, nil,
+		),
+		rp:          rp,
+		bc:          bc,
+		nodeAddress: nodeAddress,
+		stateLocker: stateLocker,
+		performance: map[string]*beacon.DutyPerformance{},
+		lock:        &sync.Mutex{},
+		logPrefix:   "Validator Performance Collector",
+	}
+}
+
+// Write metric descriptions to the Prometheus channel
+func (collector *ValidatorPerformanceCollector) Describe(channel chan<- *prometheus.Desc) {
+	channel <- collector.balance
+	channel <- collector.effectiveBalance
+	channel <- collector.status
+	channel <- collector.attestationDuties
+	channel <- collector.missedAttestations
+	channel <- collector.proposals
+	channel <- collector.syncDuties
+	channel <- collector.missedSyncDuties
+	channel <- collector.syncCommittee
+}
+
+// Collect the latest metric values and pass them to Prometheus
+func (collector *ValidatorPerformanceCollector) Collect(channel chan<- prometheus.Metric) {
+	// Get the latest state
+	state := collector.stateLocker.GetState()
+	if state == nil {
+		return
+	}
+
+	// Get the validators on the Beacon Chain
+	validators := getNodeValidators(state, collector.nodeAddress)
+	if len(validators) == 0 {
+		return
+	}
+	indices := make([]string, len(validators))
+	for i, validator := range validators {
+		indices[i] = validator.status.Index
+	}
+
+	head, err := collector.bc.GetBeaconHead()
+	if err != nil {
+		collector.logError(fmt.Errorf("error getting Beacon chain head: %w", err))
+		return
+	}
+
+	var wg errgroup.Group
+	var syncDuties map[string]bool
+	wg.Go(func() error {
+		var err error
+		syncDuties, err = collector.bc.GetValidatorSyncDuties(indices, head.Epoch)
+		if err != nil {
+			return fmt.Errorf("error getting sync duties: %w", err)
+		}
+		return nil
+	})
+
+	// Check the newest epoch whose attestations have all been finalized; attestations can be
+	// included up to the end of the following epoch, so that's the one before the finalized epoch
+	var performance map[string]beacon.DutyPerformance
+	wg.Go(func() error {
+		if head.FinalizedEpoch > 0 {
+			if err := collector.checkEpoch(head.FinalizedEpoch-1, indices, state.BeaconConfig.SlotsPerEpoch); err != nil {
+				collector.logError(fmt.Errorf("error checking duties for epoch %d: %w", head.FinalizedEpoch-1, err))
+			}
+		}
+		performance = collector.getPerformance()
+		return nil
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		collector.logError(err)
+		return
+	}
+
+	for _, validator := range validators {
+		labels := []string{validator.pubkey.Hex(), validator.validatorId, validator.minipool}
+		channel <- prometheus.MustNewConstMetric(
+			collector.balance, prometheus.GaugeValue, math.GweiToEth(validator.status.Balance), labels...)
+		channel <- prometheus.MustNewConstMetric(
+			collector.effectiveBalance, prometheus.GaugeValue, math.GweiToEth(validator.status.EffectiveBalance), labels...)
+		channel <- prometheus.MustNewConstMetric(
+			collector.status, prometheus.GaugeValue, 1, append(labels, string(validator.status.Status))...)
+
+		validatorPerformance := performance[validator.status.Index]
+		channel <- prometheus.MustNewConstMetric(
+			collector.attestationDuties, prometheus.CounterValue, float64(validatorPerformance.AttestationDuties), labels...)
+		channel <- prometheus.MustNewConstMetric(
+			collector.missedAttestations, prometheus.CounterValue, float64(validatorPerformance.MissedAttestations), labels...)
+		channel <- prometheus.MustNewConstMetric(
+			collector.proposals, prometheus.CounterValue, float64(validatorPerformance.Proposals), labels...)
+		channel <- prometheus.MustNewConstMetric(
+			collector.syncDuties, prometheus.CounterValue, float64(validatorPerformance.SyncDuties), labels...)
+		channel <- prometheus.MustNewConstMetric(
+			collector.missedSyncDuties, prometheus.CounterValue, float64(validatorPerformance.MissedSyncDuties), labels...)
+
+		syncCommittee := float64(0)
+		if syncDuties[validator.status.Index] {
+			syncCommittee = 1
+		}
+		channel <- prometheus.MustNewConstMetric(
+			collector.syncCommittee, prometheus.GaugeValue, syncCommittee, labels...)
+	}
+}
+
+// Check the duties of the given validators in an epoch, if it hasn't been checked yet.
+// Epochs that finalized while the node daemon wasn't running aren't backfilled.
+func (collector *ValidatorPerformanceCollector) checkEpoch(epoch uint64, indices []string, slotsPerEpoch uint64) error {
+	collector.lock.Lock()
+	defer collector.lock.Unlock()
+	if epoch <= collector.lastCheckedEpoch {
+		return nil
+	}
+
+	epochPerformance, err := beacon.GetEpochDutyPerformance(collector.bc, epoch, slotsPerEpoch, indices)
+	if err != nil {
+		return err
+	}
+	for index, performance := range epochPerformance {
+		total, exists := collector.performance[index]
+		if !exists {
+			total = &beacon.DutyPerformance{}
+			collector.performance[index] = total
+		}
+		total.Add(*performance)
+	}
+
+	collector.lastCheckedEpoch = epoch
+	return nil
+}
+
+// Get a copy of the performance of every validator checked so far
+func (collector *ValidatorPerformanceCollector) getPerformance() map[string]beacon.DutyPerformance {
+	collector.lock.Lock()
+	defer collector.lock.Unlock()
+	performance := make(map[string]beacon.DutyPerformance, len(collector.performance))
+	for index, validatorPerformance := range collector.performance {
+		performance[index] = *validatorPerformance
+	}
+	return performance
+}
+
+// Get all of the node's validators that are on the Beacon Chain, both minipool and megapool
+func getNodeValidators(networkState *state.NetworkState, nodeAddress common.Address) []nodeValidator {
+	var validators []nodeValidator
+
+	for _, mpd := range networkState.MinipoolDetailsByNode[nodeAddress] {
+		validator := networkState.MinipoolValidatorDetails[mpd.Pubkey]
+		if validator.Exists {
+			validators = append(validators, nodeValidator{
+				pubkey:   mpd.Pubkey,
+				minipool: mpd.MinipoolAddress.Hex(),
+				status:   validator,
+			})
+		}
+	}
+
+	nodeDetails, exists := networkState.NodeDetailsByAddress[nodeAddress]
+	if exists && nodeDetails.MegapoolDeployed {
+		for _, pubkey := range networkState.MegapoolToPubkeysMap[nodeDetails.MegapoolAddress] {
+			validator := networkState.MegapoolValidatorDetails[pubkey]
+			if !validator.Exists {
+				continue
+			}
+			validatorId := ""
+			info, exists := networkState.GetMegapoolValidatorInfo(nodeDetails.MegapoolAddress, pubkey)
+			if exists {
+				validatorId = strconv.FormatUint(uint64(info.ValidatorId), 10)
+			}
+			validators = append(validators, nodeValidator{
+				pubkey:      pubkey,
+				validatorId: validatorId,
+				status:      validator,
+			})
+		}
+	}
+
+	return validators
+}
+
+// Log error messages
+func (collector *ValidatorPerformanceCollector) logError(err error) {
+	fmt.Printf("[%s] %s\n", collector.logPrefix, err.Error())
+}
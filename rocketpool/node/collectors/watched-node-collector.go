@@ -0,0 +1,245 @@
+package collectors
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/math"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	rprewards "github.com/rocket-pool/smartnode/shared/services/rewards"
+)
+
+// Represents the collector for the nodes on the watch list
+type WatchedNodeCollector struct {
+	// Whether the node is registered with Rocket Pool
+	registered *prometheus.Desc
+
+	// The total amount of RPL staked by the node (legacy and megapool)
+	rplStake *prometheus.Desc
+
+	// The amount of ETH bonded by the node
+	ethBonded *prometheus.Desc
+
+	// The amount of ETH borrowed from the protocol by the node
+	ethBorrowed *prometheus.Desc
+
+	// The ETH balance of the node wallet
+	ethBalance *prometheus.Desc
+
+	// The RPL balance of the node wallet
+	rplBalance *prometheus.Desc
+
+	// Whether the node is opted into the Smoothing Pool
+	smoothingPoolOptedIn *prometheus.Desc
+
+	// The node's share of its fee distributor balance
+	feeDistributorShare *prometheus.Desc
+
+	// The rewards pending distribution in the node's megapool
+	megapoolPendingRewards *prometheus.Desc
+
+	// The ETH refund owed to the node by its megapool
+	megapoolRefundValue *prometheus.Desc
+
+	// The debt the node owes to its megapool
+	megapoolDebt *prometheus.Desc
+
+	// The number of the node's validators in each Beacon chain status
+	validators *prometheus.Desc
+
+	// The RPL rewards from the intervals the node hasn't claimed yet
+	unclaimedRplRewards *prometheus.Desc
+
+	// The ETH rewards from the intervals the node hasn't claimed yet
+	unclaimedEthRewards *prometheus.Desc
+
+	// The Rocket Pool contract manager
+	rp *rocketpool.RocketPool
+
+	// The Rocket Pool config
+	cfg *config.RocketPoolConfig
+
+	// The thread-safe locker for the watched nodes' network state
+	stateLocker *StateLocker
+
+	// Prefix for logging
+	logPrefix string
+}
+
+// Create a new WatchedNodeCollector instance
+func NewWatchedNodeCollector(rp *rocketpool.RocketPool, cfg *config.RocketPoolConfig, stateLocker *StateLocker) *WatchedNodeCollector {
+	subsystem := "watched_node"
+	return &WatchedNodeCollector{
+		registered: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "registered"),
+			"Whether the watched node is registered with Rocket Pool",
+			[]string{"node"}, nil,
+		),
+		rplStake: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "rpl_stake"),
+			"The total amount of RPL staked by the watched node",
+			[]string{"node"}, nil,
+		),
+		ethBonded: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "eth_bonded"),
+			"The amount of ETH bonded by the watched node",
+			[]string{"node"}, nil,
+		),
+		ethBorrowed: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "eth_borrowed"),
+			"The amount of ETH borrowed from the protocol by the watched node",
+			[]string{"node"}, nil,
+		),
+		ethBalance: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "eth_balance"),
+			"The ETH balance of the watched node's wallet",
+			[]string{"node"}, nil,
+		),
+		rplBalance: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "rpl_balance"),
+			"The RPL balance of the watched node's wallet",
+			[]string{"node"}, nil,
+		),
+		smoothingPoolOptedIn: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "smoothing_pool_opted_in"),
+			"Whether the watched node is opted into the Smoothing Pool",
+			[]string{"node"}, nil,
+		),
+		feeDistributorShare: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "fee_distributor_share"),
+			"The watched node's share of its fee distributor balance",
+			[]string{"node"}, nil,
+		),
+		megapoolPendingRewards: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "megapool_pending_rewards"),
+			"The rewards pending distribution in the watched node's megapool",
+			[]string{"node"}, nil,
+		),
+		megapoolRefundValue: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "megapool_refund_value"),
+			"The ETH refund owed to the watched node by its megapool",
+			[]string{"node"}, nil,
+		),
+		megapoolDebt: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "megapool_debt"),
+			"The debt the watched node owes to its megapool",
+			[]string{"node"}, nil,
+		),
+		validators: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "validators"),
+			"The number of the watched node's validators in each Beacon chain status",
+			[]string{"node", "status"}, nil,
+		),
+		unclaimedRplRewards: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "unclaimed_rpl_rewards"),
+			"The RPL rewards from the intervals the watched node hasn't claimed yet",
+			[]string{"node"}, nil,
+		),
+		unclaimedEthRewards: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "unclaimed_eth_rewards"),
+			"The ETH rewards from the intervals the watched node hasn't claimed yet",
+			[]string{"node"}, nil,
+		),
+		rp:          rp,
+		cfg:         cfg,
+		stateLocker: stateLocker,
+		logPrefix:   "Watched Node Collector",
+	}
+}
+
+// Write metric descriptions to the Prometheus channel
+func (collector *WatchedNodeCollector) Describe(channel chan<- *prometheus.Desc) {
+	channel <- collector.registered
+	channel <- collector.rplStake
+	channel <- collector.ethBonded
+	channel <- collector.ethBorrowed
+	channel <- collector.ethBalance
+	channel <- collector.rplBalance
+	channel <- collector.smoothingPoolOptedIn
+	channel <- collector.feeDistributorShare
+	channel <- collector.megapoolPendingRewards
+	channel <- collector.megapoolRefundValue
+	channel <- collector.megapoolDebt
+	channel <- collector.validators
+	channel <- collector.unclaimedRplRewards
+	channel <- collector.unclaimedEthRewards
+}
+
+// Collect the latest metric values and pass them to Prometheus
+func (collector *WatchedNodeCollector) Collect(channel chan<- prometheus.Metric) {
+	// Get the latest state; this is nil when nothing is being watched
+	state := collector.stateLocker.GetState()
+	if state == nil {
+		return
+	}
+
+	// Get the rewards the registered nodes haven't claimed yet
+	registered := []common.Address{}
+	for _, node := range state.NodeDetails {
+		if node.Exists {
+			registered = append(registered, node.NodeAddress)
+		}
+	}
+	unclaimedRewards, err := rprewards.GetUnclaimedRewards(collector.rp, collector.cfg, registered)
+	if err != nil {
+		collector.logError(fmt.Errorf("error getting unclaimed rewards: %w", err))
+	}
+
+	for _, node := range state.NodeDetails {
+		address := node.NodeAddress.Hex()
+		channel <- prometheus.MustNewConstMetric(
+			collector.registered, prometheus.GaugeValue, boolToFloat(node.Exists), address)
+		if !node.Exists {
+			continue
+		}
+
+		rplStake := big.NewInt(0).Add(node.LegacyStakedRPL, node.MegapoolStakedRPL)
+		channel <- prometheus.MustNewConstMetric(
+			collector.rplStake, prometheus.GaugeValue, math.WeiToEth(rplStake), address)
+		channel <- prometheus.MustNewConstMetric(
+			collector.ethBonded, prometheus.GaugeValue, math.WeiToEth(node.EthBonded), address)
+		channel <- prometheus.MustNewConstMetric(
+			collector.ethBorrowed, prometheus.GaugeValue, math.WeiToEth(node.EthBorrowed), address)
+		channel <- prometheus.MustNewConstMetric(
+			collector.ethBalance, prometheus.GaugeValue, math.WeiToEth(node.BalanceETH), address)
+		channel <- prometheus.MustNewConstMetric(
+			collector.rplBalance, prometheus.GaugeValue, math.WeiToEth(node.BalanceRPL), address)
+		channel <- prometheus.MustNewConstMetric(
+			collector.smoothingPoolOptedIn, prometheus.GaugeValue, boolToFloat(node.SmoothingPoolRegistrationState), address)
+		if node.DistributorBalanceNodeETH != nil {
+			channel <- prometheus.MustNewConstMetric(
+				collector.feeDistributorShare, prometheus.GaugeValue, math.WeiToEth(node.DistributorBalanceNodeETH), address)
+		}
+
+		if megapool, exists := state.MegapoolDetails[node.MegapoolAddress]; node.MegapoolDeployed && exists {
+			channel <- prometheus.MustNewConstMetric(
+				collector.megapoolPendingRewards, prometheus.GaugeValue, math.WeiToEth(megapool.PendingRewards), address)
+			channel <- prometheus.MustNewConstMetric(
+				collector.megapoolRefundValue, prometheus.GaugeValue, math.WeiToEth(megapool.RefundValue), address)
+			channel <- prometheus.MustNewConstMetric(
+				collector.megapoolDebt, prometheus.GaugeValue, math.WeiToEth(megapool.NodeDebt), address)
+		}
+
+		if rewards, exists := unclaimedRewards[node.NodeAddress]; exists {
+			channel <- prometheus.MustNewConstMetric(
+				collector.unclaimedRplRewards, prometheus.GaugeValue, math.WeiToEth(rewards.Rpl), address)
+			channel <- prometheus.MustNewConstMetric(
+				collector.unclaimedEthRewards, prometheus.GaugeValue, math.WeiToEth(rewards.Eth), address)
+		}
+
+		counts := state.GetNodeValidatorCounts(node.NodeAddress)
+		for status, count := range map[string]int{
+			"not_on_beacon": counts.NotOnBeacon,
+			"pending":       counts.Pending,
+			"active":        counts.Active,
+			"exiting":       counts.Exiting,
+			"exited":        counts.Exited,
+			"slashed":       counts.Slashed,
+		} {
+			channel <- prometheus.MustNewConstMetric(
+				collector.validators, prometheus.GaugeValue, float64(count), address, status)
+		}
+	}
+}
+
+// Log error messages
+func (collector *WatchedNodeCollector) logError(err error) {
+	fmt.Printf("[%s] %s\n", collector.logPrefix, err.Error())
+}
+
+func boolToFloat(value bool) float64 {
+	if value {
+		return 1
+	}
+	return 0
+}
@@ -1,6 +1,7 @@
 package node
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/docker/docker/client"
@@ -188,6 +189,11 @@ func (t *defendChallengeExit) defendChallenge(rp *rocketpool.RocketPool, mp mega
 		return err
 	}
 
+	// Check the proofs against our own Beacon Node before submitting them
+	if err := services.VerifyValidatorProof(t.bc, validatorProof, slotProof); err != nil {
+		return fmt.Errorf("error verifying the validator proof for megapool validator %d: %w", validatorId, err)
+	}
+
 	t.log.Printlnf("[FINISHED] The beacon state proof has been successfully created.")
 	var gasLimits gaslimit.Limits
 
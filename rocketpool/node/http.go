@@ -1,16 +1,27 @@
 package node
 
 import (
+	"bytes"
 	"context"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"log"
+	"math/big"
 	"net/http"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/urfave/cli/v3"
 
+	megapoolroutes "github.com/rocket-pool/smartnode/rocketpool/api/megapool"
+	"github.com/rocket-pool/smartnode/rocketpool/api/response"
 	"github.com/rocket-pool/smartnode/rocketpool/node/routes"
+	hexutils "github.com/rocket-pool/smartnode/shared/hex"
+	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/types/api"
 )
 
 // statusRecorder wraps http.ResponseWriter to capture the written status code.
@@ -40,6 +51,81 @@ func loggingMiddleware(next http.Handler) http.Handler {
 	})
 }
 
+// bufferedResponseWriter holds a handler's response so it can be replaced before being sent.
+type bufferedResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (b *bufferedResponseWriter) Header() http.Header {
+	return b.header
+}
+
+func (b *bufferedResponseWriter) WriteHeader(code int) {
+	b.status = code
+}
+
+func (b *bufferedResponseWriter) Write(data []byte) (int, error) {
+	return b.body.Write(data)
+}
+
+// unsignedTransactionMiddleware handles requests with exportUnsigned=true. The transaction the route
+// builds is recorded instead of sent, and returned unsigned in place of the route's normal response.
+func unsignedTransactionMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("exportUnsigned") != "true" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		r, recorder := services.WithUnsignedTransactionRecorder(r)
+		buffer := &bufferedResponseWriter{header: http.Header{}, status: http.StatusOK}
+		next.ServeHTTP(buffer, r)
+
+		// Pass failures and routes that don't build a transaction through as-is
+		from, txs := recorder.GetTransactions()
+		if buffer.status != http.StatusOK || len(txs) == 0 {
+			for key, values := range buffer.header {
+				w.Header()[key] = values
+			}
+			w.WriteHeader(buffer.status)
+			_, _ = w.Write(buffer.body.Bytes())
+			return
+		}
+		if len(txs) > 1 {
+			response.WriteErrorResponse(w, &response.BadRequestError{Err: errors.New("this action sends more than one transaction, so it can't be exported unsigned")})
+			return
+		}
+
+		unsignedTx, err := getUnsignedTransaction(from, txs[0])
+		response.WriteResponse(w, &api.UnsignedTransactionResponse{UnsignedTransaction: unsignedTx}, err)
+	})
+}
+
+// Summarize an unsigned transaction for export
+func getUnsignedTransaction(from common.Address, tx *types.Transaction) (*api.UnsignedTransaction, error) {
+	serializedTx, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("error serializing transaction: %w", err)
+	}
+	maxCost := big.NewInt(0).Mul(big.NewInt(0).SetUint64(tx.Gas()), tx.GasFeeCap())
+	maxCost.Add(maxCost, tx.Value())
+	return &api.UnsignedTransaction{
+		From:                 from,
+		To:                   tx.To(),
+		ChainID:              tx.ChainId(),
+		Nonce:                tx.Nonce(),
+		Value:                tx.Value(),
+		Data:                 hexutils.AddPrefix(hex.EncodeToString(tx.Data())),
+		GasLimit:             tx.Gas(),
+		MaxFeePerGas:         tx.GasFeeCap(),
+		MaxPriorityFeePerGas: tx.GasTipCap(),
+		MaxCost:              maxCost,
+		SerializedTx:         hexutils.AddPrefix(hex.EncodeToString(serializedTx)),
+	}, nil
+}
+
 // startHTTP starts the node's HTTP API server and returns immediately.
 // The server runs in the background for the lifetime of the process.
 func startHTTP(ctx context.Context, c *cli.Command, cfg *config.RocketPoolConfig) {
@@ -62,7 +148,7 @@ func startHTTP(ctx context.Context, c *cli.Command, cfg *config.RocketPoolConfig
 
 	srv := &http.Server{
 		Addr:    fmt.Sprintf("%s:%d", host, port),
-		Handler: loggingMiddleware(mux),
+		Handler: loggingMiddleware(unsignedTransactionMiddleware(mux)),
 	}
 
 	go func() {
@@ -77,3 +163,40 @@ func startHTTP(ctx context.Context, c *cli.Command, cfg *config.RocketPoolConfig
 		_ = srv.Shutdown(context.Background())
 	}()
 }
+
+// startProofServer starts the megapool proof server on its own port if the node has opted into it.
+// Only the proof routes are served there, so it can be opened to other machines without exposing the
+// rest of the node's API.
+func startProofServer(ctx context.Context, c *cli.Command, cfg *config.RocketPoolConfig) {
+	if !cfg.Smartnode.EnableProofServer.Value.(bool) {
+		return
+	}
+	port, ok := cfg.Smartnode.ProofServerPort.Value.(uint16)
+	if !ok || port == 0 {
+		log.Println("Warning: ProofServerPort not configured, megapool proof server will not start.")
+		return
+	}
+
+	mux := http.NewServeMux()
+	megapoolroutes.RegisterProofRoutes(mux, c)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		response.WriteErrorResponse(w, &response.NotFoundError{Path: r.URL.Path})
+	})
+
+	srv := &http.Server{
+		Addr:    fmt.Sprintf("0.0.0.0:%d", port),
+		Handler: loggingMiddleware(mux),
+	}
+
+	go func() {
+		log.Printf("Megapool proof server listening on 0.0.0.0:%d\n", port)
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Printf("Megapool proof server error: %v\n", err)
+		}
+	}()
+
+	go func() {
+		<-ctx.Done()
+		_ = srv.Shutdown(context.Background())
+	}()
+}
@@ -0,0 +1,338 @@
+package node
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/docker/docker/client"
+	"github.com/urfave/cli/v3"
+
+	"github.com/rocket-pool/smartnode/bindings/megapool"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/bindings/transactions"
+	megapoolapi "github.com/rocket-pool/smartnode/rocketpool/api/megapool"
+
+	log "github.com/rocket-pool/smartnode/shared/logger"
+	"github.com/rocket-pool/smartnode/shared/math"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+)
+
+// Manage megapool debt and refunds task
+type manageMegapoolDebtAndRefunds struct {
+	c                    *cli.Command
+	log                  log.ColorLogger
+	cfg                  *config.RocketPoolConfig
+	w                    wallet.Wallet
+	rp                   *rocketpool.RocketPool
+	d                    *client.Client
+	gasThreshold         float64
+	repayDebtThreshold   *big.Int
+	claimRefundThreshold *big.Int
+	disabled             bool
+	maxFee               *big.Int
+	maxPriorityFee       *big.Int
+	gasLimit             uint64
+}
+
+// Create manage megapool debt and refunds task
+func newManageMegapoolDebtAndRefunds(c *cli.Command, logger log.ColorLogger) (*manageMegapoolDebtAndRefunds, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	d, err := services.GetDocker(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if automatic transactions are disabled
+	gasThreshold := cfg.Smartnode.AutoTxGasThreshold.Value.(float64)
+	repayDebtThreshold := cfg.Smartnode.AutoRepayDebtThreshold.Value.(float64)
+	claimRefundThreshold := cfg.Smartnode.AutoClaimRefundThreshold.Value.(float64)
+	disabled := false
+	if gasThreshold == 0 {
+		logger.Println("Automatic tx gas threshold is 0, disabling auto-repay and auto-claim for megapool debt and refunds.")
+		disabled = true
+	} else if repayDebtThreshold == 0 && claimRefundThreshold == 0 {
+		disabled = true
+	}
+
+	// Get the user-requested max fee
+	maxFeeGwei := cfg.Smartnode.ManualMaxFee.Value.(float64)
+	var maxFee *big.Int
+	if maxFeeGwei == 0 {
+		maxFee = nil
+	} else {
+		maxFee = math.GweiToWei(maxFeeGwei)
+	}
+
+	// Get the user-requested priority fee
+	priorityFeeGwei := cfg.Smartnode.PriorityFee.Value.(float64)
+	var priorityFee *big.Int
+	if priorityFeeGwei == 0 {
+		logger.Printlnf("WARNING: priority fee was missing or 0, setting a default of %.2f.", rpgas.DefaultPriorityFeeGwei)
+		priorityFee = math.GweiToWei(rpgas.DefaultPriorityFeeGwei)
+	} else {
+		priorityFee = math.GweiToWei(priorityFeeGwei)
+	}
+
+	// Return task
+	return &manageMegapoolDebtAndRefunds{
+		c:                    c,
+		log:                  logger,
+		cfg:                  cfg,
+		w:                    w,
+		rp:                   rp,
+		d:                    d,
+		gasThreshold:         gasThreshold,
+		repayDebtThreshold:   math.EthToWei(repayDebtThreshold),
+		claimRefundThreshold: math.EthToWei(claimRefundThreshold),
+		disabled:             disabled,
+		maxFee:               maxFee,
+		maxPriorityFee:       priorityFee,
+		gasLimit:             0,
+	}, nil
+
+}
+
+// Repay megapool debt and claim megapool refunds
+func (t *manageMegapoolDebtAndRefunds) run(state *state.NetworkState) error {
+
+	// Check if the task is disabled
+	if t.disabled {
+		return nil
+	}
+
+	// Log
+	t.log.Println("Checking for megapool debt to repay and refunds to claim...")
+
+	// Get node account
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+
+	// Check if the megapool is deployed
+	megapoolDeployed, err := megapool.GetMegapoolDeployed(t.rp, nodeAccount.Address, nil)
+	if err != nil {
+		return err
+	}
+	if !megapoolDeployed {
+		return nil
+	}
+
+	// Load the megapool
+	megapoolAddress, err := megapool.GetMegapoolExpectedAddress(t.rp, nodeAccount.Address, nil)
+	if err != nil {
+		return err
+	}
+	mp, err := megapool.NewMegaPoolV1(t.rp, megapoolAddress, nil)
+	if err != nil {
+		return err
+	}
+
+	// Repay any debt from the node wallet's balance above the threshold
+	if t.repayDebtThreshold.Sign() > 0 {
+		debt, err := mp.GetDebt(nil)
+		if err != nil {
+			return err
+		}
+		if debt.Sign() > 0 {
+			balance, err := t.rp.Client.BalanceAt(context.Background(), nodeAccount.Address, nil)
+			if err != nil {
+				return err
+			}
+			if getDebtRepayment(balance, t.repayDebtThreshold, debt, big.NewInt(0)).Sign() > 0 {
+				if err := t.repayDebt(mp, balance, debt); err != nil {
+					return err
+				}
+			} else {
+				t.log.Printlnf("Megapool has %.6f ETH of debt, but the node wallet balance (%.6f ETH) isn't above the auto-repay threshold (%.6f ETH).", math.RoundDown(math.WeiToEth(debt), 6), math.RoundDown(math.WeiToEth(balance), 6), math.RoundDown(math.WeiToEth(t.repayDebtThreshold), 6))
+			}
+		}
+	}
+
+	// Claim the refund if it's worth the gas
+	if t.claimRefundThreshold.Sign() > 0 {
+		refund, err := mp.GetRefundValue(nil)
+		if err != nil {
+			return err
+		}
+		if refund.Sign() > 0 {
+			if err := t.claimRefund(mp, refund); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Return
+	return nil
+
+}
+
+// Repay part or all of the megapool's debt from the node wallet's balance
+func (t *manageMegapoolDebtAndRefunds) repayDebt(mp megapool.Megapool, balance *big.Int, debt *big.Int) error {
+
+	// Make sure the repayment can go through
+	canRepay, err := megapoolapi.CanRepayDebt(t.c, getDebtRepayment(balance, t.repayDebtThreshold, debt, big.NewInt(0)))
+	if err != nil {
+		return err
+	}
+	if !canRepay.CanRepay {
+		t.log.Println("The debt can't be repaid right now, skipping.")
+		return nil
+	}
+
+	// Get the max fee
+	maxFee := t.maxFee
+	if maxFee == nil || maxFee.Uint64() == 0 {
+		maxFee, err = rpgas.GetHeadlessMaxFeeWeiWithLatestBlock(t.cfg, t.rp)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Leave enough for gas so the repayment doesn't take the wallet below the threshold
+	gasLimits := canRepay.GasLimits
+	gasCost := new(big.Int).Mul(new(big.Int).SetUint64(gasLimits.Safe), maxFee)
+	amount := getDebtRepayment(balance, t.repayDebtThreshold, debt, gasCost)
+	if amount.Sign() == 0 {
+		t.log.Printlnf("Megapool has %.6f ETH of debt, but the node wallet balance (%.6f ETH) isn't above the auto-repay threshold (%.6f ETH) plus gas (%.6f ETH), skipping.", math.RoundDown(math.WeiToEth(debt), 6), math.RoundDown(math.WeiToEth(balance), 6), math.RoundDown(math.WeiToEth(t.repayDebtThreshold), 6), math.RoundDown(math.WeiToEth(gasCost), 6))
+		return nil
+	}
+
+	// Log
+	t.log.Printlnf("Repaying %.6f ETH of the megapool's %.6f ETH debt...", math.RoundDown(math.WeiToEth(amount), 6), math.RoundDown(math.WeiToEth(debt), 6))
+
+	// Print the gas info
+	if !gasLimits.PrintAndCheck(true, t.gasThreshold, &t.log, maxFee, t.gasLimit) {
+		return nil
+	}
+
+	// Get transactor
+	opts, err := t.w.GetNodeAccountTransactor()
+	if err != nil {
+		return err
+	}
+	opts.Value = amount
+	opts.GasFeeCap = maxFee
+	opts.GasTipCap = GetPriorityFee(t.maxPriorityFee, maxFee)
+	opts.GasLimit = gasLimits.Safe
+
+	// Repay the debt
+	hash, err := mp.RepayDebt(opts)
+	if err != nil {
+		return err
+	}
+
+	// Print TX info and wait for it to be included in a block
+	err = transactions.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, &t.log)
+	if err != nil {
+		return err
+	}
+
+	// Log
+	t.log.Printlnf("Successfully repaid %.6f ETH of megapool debt.", math.RoundDown(math.WeiToEth(amount), 6))
+
+	// Return
+	return nil
+
+}
+
+// Claim the megapool's refund
+func (t *manageMegapoolDebtAndRefunds) claimRefund(mp megapool.Megapool, refund *big.Int) error {
+
+	// Make sure the refund can be claimed
+	canClaim, err := megapoolapi.CanClaimRefund(t.c)
+	if err != nil {
+		return err
+	}
+	if !canClaim.CanClaim {
+		return nil
+	}
+
+	// Get the max fee
+	maxFee := t.maxFee
+	if maxFee == nil || maxFee.Uint64() == 0 {
+		maxFee, err = rpgas.GetHeadlessMaxFeeWeiWithLatestBlock(t.cfg, t.rp)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Only claim the refund if it's larger than the threshold after paying for gas
+	gasLimits := canClaim.GasLimits
+	gasCost := new(big.Int).Mul(new(big.Int).SetUint64(gasLimits.Safe), maxFee)
+	if !isRefundWorthClaiming(refund, t.claimRefundThreshold, gasCost) {
+		t.log.Printlnf("Megapool refund of %.6f ETH is not above the auto-claim threshold (%.6f ETH) plus gas (%.6f ETH), skipping.", math.RoundDown(math.WeiToEth(refund), 6), math.RoundDown(math.WeiToEth(t.claimRefundThreshold), 6), math.RoundDown(math.WeiToEth(gasCost), 6))
+		return nil
+	}
+
+	// Log
+	t.log.Printlnf("Claiming the megapool's %.6f ETH refund...", math.RoundDown(math.WeiToEth(refund), 6))
+
+	// Print the gas info
+	if !gasLimits.PrintAndCheck(true, t.gasThreshold, &t.log, maxFee, t.gasLimit) {
+		return nil
+	}
+
+	// Get transactor
+	opts, err := t.w.GetNodeAccountTransactor()
+	if err != nil {
+		return err
+	}
+	opts.GasFeeCap = maxFee
+	opts.GasTipCap = GetPriorityFee(t.maxPriorityFee, maxFee)
+	opts.GasLimit = gasLimits.Safe
+
+	// Claim the refund
+	hash, err := mp.ClaimRefund(opts)
+	if err != nil {
+		return err
+	}
+
+	// Print TX info and wait for it to be included in a block
+	err = transactions.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, &t.log)
+	if err != nil {
+		return err
+	}
+
+	// Log
+	t.log.Printlnf("Successfully claimed the megapool's %.6f ETH refund.", math.RoundDown(math.WeiToEth(refund), 6))
+
+	// Return
+	return nil
+
+}
+
+// Get the amount of debt to repay, using only the node wallet's balance above the reserve once the gas to repay it is paid
+func getDebtRepayment(balance *big.Int, reserve *big.Int, debt *big.Int, gasCost *big.Int) *big.Int {
+	excess := new(big.Int).Sub(balance, reserve)
+	excess.Sub(excess, gasCost)
+	if excess.Sign() <= 0 {
+		return big.NewInt(0)
+	}
+	if excess.Cmp(debt) > 0 {
+		return new(big.Int).Set(debt)
+	}
+	return excess
+}
+
+// Check if a refund is larger than the minimum once the gas to claim it is paid
+func isRefundWorthClaiming(refund *big.Int, minimum *big.Int, gasCost *big.Int) bool {
+	return refund.Cmp(new(big.Int).Add(minimum, gasCost)) > 0
+}
@@ -0,0 +1,52 @@
+package node
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/math"
+)
+
+func TestGetDebtRepayment(t *testing.T) {
+	reserve := math.EthToWei(1)
+	debt := math.EthToWei(2)
+
+	// Only the balance above the reserve is used
+	if amount := getDebtRepayment(math.EthToWei(1.5), reserve, debt, big.NewInt(0)); amount.Cmp(math.EthToWei(0.5)) != 0 {
+		t.Errorf("expected a partial repayment of 0.5 ETH, got %s", amount)
+	}
+
+	// Never more than the debt
+	if amount := getDebtRepayment(math.EthToWei(10), reserve, debt, big.NewInt(0)); amount.Cmp(debt) != 0 {
+		t.Errorf("expected the full debt to be repaid, got %s", amount)
+	}
+
+	// Nothing when the balance is at or below the reserve
+	if amount := getDebtRepayment(reserve, reserve, debt, big.NewInt(0)); amount.Sign() != 0 {
+		t.Errorf("expected no repayment, got %s", amount)
+	}
+
+	// The gas to repay comes out of the balance above the reserve too
+	gasCost := math.EthToWei(0.1)
+	if amount := getDebtRepayment(math.EthToWei(1.5), reserve, debt, gasCost); amount.Cmp(math.EthToWei(0.4)) != 0 {
+		t.Errorf("expected a partial repayment of 0.4 ETH after gas, got %s", amount)
+	}
+	if amount := getDebtRepayment(math.EthToWei(1.05), reserve, debt, gasCost); amount.Sign() != 0 {
+		t.Errorf("expected no repayment when gas would dip into the reserve, got %s", amount)
+	}
+}
+
+func TestIsRefundWorthClaiming(t *testing.T) {
+	minimum := math.EthToWei(0.1)
+	gasCost := math.EthToWei(0.01)
+
+	if !isRefundWorthClaiming(math.EthToWei(0.2), minimum, gasCost) {
+		t.Error("expected a refund above the minimum plus gas to be claimed")
+	}
+	if isRefundWorthClaiming(math.EthToWei(0.105), minimum, gasCost) {
+		t.Error("expected a refund that only clears the minimum before gas to be skipped")
+	}
+	if isRefundWorthClaiming(big.NewInt(0), minimum, big.NewInt(0)) {
+		t.Error("expected an empty refund to be skipped")
+	}
+}
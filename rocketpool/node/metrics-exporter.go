@@ -16,15 +16,25 @@ import (
 	"github.com/rocket-pool/smartnode/rocketpool/node/collectors"
 	log "github.com/rocket-pool/smartnode/shared/logger"
 	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/config"
 )
 
-func runMetricsServer(ctx context.Context, c *cli.Command, logger log.ColorLogger, stateLocker *collectors.StateLocker) error {
+// Check if the metrics exporter should run
+func isMetricsEnabled(cfg *config.RocketPoolConfig) bool {
+	return cfg.EnableMetrics.Value == true || strings.ToLower(os.Getenv("ENABLE_METRICS")) == "true"
+}
+
+// Register the collectors for the node wallet's node with the metrics registry
+func registerNodeCollectors(c *cli.Command, logger log.ColorLogger, registry *prometheus.Registry, stateLocker *collectors.StateLocker) error {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
 	if err != nil {
 		return err
 	}
+	if !isMetricsEnabled(cfg) {
+		return nil
+	}
 	w, err := services.GetWallet(c)
 	if err != nil {
 		return err
@@ -46,15 +56,6 @@ func runMetricsServer(ctx context.Context, c *cli.Command, logger log.ColorLogge
 		return err
 	}
 
-	// Return if metrics are disabled
-	if cfg.EnableMetrics.Value == false {
-		if strings.ToLower(os.Getenv("ENABLE_METRICS")) == "true" {
-			logger.Printlnf("ENABLE_METRICS override set to true, will start Metrics exporter anyway!")
-		} else {
-			return nil
-		}
-	}
-
 	nodeAccount, err := w.GetNodeAccount()
 	if err != nil {
 		return fmt.Errorf("Error getting node account: %w", err)
@@ -72,9 +73,11 @@ func runMetricsServer(ctx context.Context, c *cli.Command, logger log.ColorLogge
 	smoothingPoolCollector := collectors.NewSmoothingPoolCollector(rp, ec, stateLocker)
 	governanceCollector := collectors.NewGovernanceCollector(rp)
 	versionUpdateCollector := collectors.NewVersionUpdateCollector(logger.Printlnf)
+	queueForecastCollector := collectors.NewQueueForecastCollector(rp, bc, nodeAccount.Address, cfg)
+	oDaoDutiesCollector := collectors.NewODaoDutiesCollector(rp, bc, nodeAccount.Address, cfg)
+	validatorPerformanceCollector := collectors.NewValidatorPerformanceCollector(rp, bc, nodeAccount.Address, stateLocker)
 
-	// Set up Prometheus
-	registry := prometheus.NewRegistry()
+	// Add them to the registry
 	registry.MustRegister(demandCollector)
 	registry.MustRegister(performanceCollector)
 	registry.MustRegister(supplyCollector)
@@ -86,6 +89,9 @@ func runMetricsServer(ctx context.Context, c *cli.Command, logger log.ColorLogge
 	registry.MustRegister(smoothingPoolCollector)
 	registry.MustRegister(governanceCollector)
 	registry.MustRegister(versionUpdateCollector)
+	registry.MustRegister(queueForecastCollector)
+	registry.MustRegister(oDaoDutiesCollector)
+	registry.MustRegister(validatorPerformanceCollector)
 
 	// Set up snapshot checking if enabled
 	if cfg.Smartnode.GetRocketSignerRegistryAddress() != "" {
@@ -100,6 +106,27 @@ func runMetricsServer(ctx context.Context, c *cli.Command, logger log.ColorLogge
 
 	}
 
+	return nil
+
+}
+
+// Serve the metrics in the registry. Collectors can be added to the registry while it's being served.
+func runMetricsServer(ctx context.Context, c *cli.Command, logger log.ColorLogger, registry *prometheus.Registry) error {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return err
+	}
+
+	// Return if metrics are disabled
+	if !isMetricsEnabled(cfg) {
+		return nil
+	}
+	if cfg.EnableMetrics.Value == false {
+		logger.Printlnf("ENABLE_METRICS override set to true, will start Metrics exporter anyway!")
+	}
+
 	// Start the HTTP server
 	handler := promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
 	metricsAddress := c.Root().String("metricsAddress")
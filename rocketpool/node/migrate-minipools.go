@@ -0,0 +1,489 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/docker/docker/client"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/urfave/cli/v3"
+
+	"github.com/rocket-pool/smartnode/bindings/node"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/bindings/transactions"
+	rptypes "github.com/rocket-pool/smartnode/bindings/types"
+	rpstate "github.com/rocket-pool/smartnode/bindings/utils/state"
+	minipoolapi "github.com/rocket-pool/smartnode/rocketpool/api/minipool"
+	nodeapi "github.com/rocket-pool/smartnode/rocketpool/api/node"
+
+	log "github.com/rocket-pool/smartnode/shared/logger"
+	"github.com/rocket-pool/smartnode/shared/math"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
+	"github.com/rocket-pool/smartnode/shared/services/migration"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+)
+
+// How long to wait for a megapool deposit to be included before submitting it again with the same nonce
+const migrationDepositTimeout = 30 * time.Minute
+
+// Migrate minipools task
+type migrateMinipools struct {
+	c              *cli.Command
+	log            log.ColorLogger
+	cfg            *config.RocketPoolConfig
+	w              wallet.Wallet
+	rp             *rocketpool.RocketPool
+	d              *client.Client
+	manager        *migration.PlanManager
+	gasThreshold   float64
+	disabled       bool
+	maxFee         *big.Int
+	maxPriorityFee *big.Int
+	gasLimit       uint64
+}
+
+// Create migrate minipools task
+func newMigrateMinipools(c *cli.Command, logger log.ColorLogger) (*migrateMinipools, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	d, err := services.GetDocker(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Exits don't cost gas, but closing minipools and depositing do
+	gasThreshold := cfg.Smartnode.AutoTxGasThreshold.Value.(float64)
+	disabled := false
+	if gasThreshold == 0 {
+		logger.Println("Automatic tx gas threshold is 0, minipool migrations will only submit exits.")
+		disabled = true
+	}
+
+	// Get the user-requested max fee
+	maxFeeGwei := cfg.Smartnode.ManualMaxFee.Value.(float64)
+	var maxFee *big.Int
+	if maxFeeGwei == 0 {
+		maxFee = nil
+	} else {
+		maxFee = math.GweiToWei(maxFeeGwei)
+	}
+
+	// Get the user-requested priority fee
+	priorityFeeGwei := cfg.Smartnode.PriorityFee.Value.(float64)
+	var priorityFee *big.Int
+	if priorityFeeGwei == 0 {
+		logger.Printlnf("WARNING: priority fee was missing or 0, setting a default of %.2f.", rpgas.DefaultPriorityFeeGwei)
+		priorityFee = math.GweiToWei(rpgas.DefaultPriorityFeeGwei)
+	} else {
+		priorityFee = math.GweiToWei(priorityFeeGwei)
+	}
+
+	// Return task
+	return &migrateMinipools{
+		c:              c,
+		log:            logger,
+		cfg:            cfg,
+		w:              w,
+		rp:             rp,
+		d:              d,
+		manager:        migration.NewPlanManager(cfg.Smartnode.GetMinipoolMigrationPlanPath()),
+		gasThreshold:   gasThreshold,
+		disabled:       disabled,
+		maxFee:         maxFee,
+		maxPriorityFee: priorityFee,
+		gasLimit:       0,
+	}, nil
+
+}
+
+// Move each minipool in the migration plan on to its next stage
+func (t *migrateMinipools) run(state *state.NetworkState) error {
+
+	// Check for a plan in progress
+	plan, err := t.manager.Load()
+	if err != nil {
+		return err
+	}
+	if plan == nil || plan.IsComplete() {
+		return nil
+	}
+
+	// Log
+	t.log.Println("Checking the minipool migration plan...")
+
+	// Get node account
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+	minipoolDetails := map[common.Address]*rpstate.NativeMinipoolDetails{}
+	for _, mpd := range state.MinipoolDetailsByNode[nodeAccount.Address] {
+		minipoolDetails[mpd.MinipoolAddress] = mpd
+	}
+
+	// Deposits change the bond requirement for the next one, so only queue one per cycle
+	deposited := false
+	for i := range plan.Minipools {
+		mp := &plan.Minipools[i]
+		if mp.Stage == migration.StageDeposited {
+			continue
+		}
+
+		// Stop if the plan was cancelled while the previous minipool was being worked on
+		current, err := t.manager.Load()
+		if err != nil {
+			return err
+		}
+		if current == nil || !current.CreatedTime.Equal(plan.CreatedTime) {
+			t.log.Println("The minipool migration plan was cancelled.")
+			return nil
+		}
+
+		switch mp.Stage {
+		case migration.StageExitPending:
+			t.exitMinipool(mp)
+		case migration.StageExiting:
+			t.closeMinipool(mp, minipoolDetails[mp.MinipoolAddress], state, nodeAccount.Address)
+		case migration.StageClosed:
+			if !deposited {
+				deposited = t.depositMegapoolValidator(mp, plan.UseExpressTickets, state, nodeAccount.Address)
+			}
+		}
+
+		// Save after every minipool so progress isn't lost if the daemon stops
+		err = t.manager.UpdateMinipool(plan.CreatedTime, *mp)
+		if errors.Is(err, migration.ErrPlanCancelled) {
+			t.log.Println("The minipool migration plan was cancelled.")
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	// Log
+	if plan.IsComplete() {
+		t.log.Printlnf("All %d minipool(s) in the migration plan have been moved to the megapool.", len(plan.Minipools))
+	}
+
+	// Return
+	return nil
+
+}
+
+// Retry the exit of a minipool that couldn't be exited when the plan was created
+func (t *migrateMinipools) exitMinipool(mp *migration.MinipoolMigration) {
+	t.log.Printlnf("Exiting minipool %s...", mp.MinipoolAddress.Hex())
+	if _, err := minipoolapi.ExitMinipool(t.c, mp.MinipoolAddress); err != nil {
+		t.fail(mp, fmt.Errorf("error exiting the minipool: %w", err))
+		return
+	}
+	mp.Advance(migration.StageExiting)
+	t.log.Printlnf("Submitted the exit for minipool %s.", mp.MinipoolAddress.Hex())
+}
+
+// Close a minipool once its validator's balance has been withdrawn
+func (t *migrateMinipools) closeMinipool(mp *migration.MinipoolMigration, mpd *rpstate.NativeMinipoolDetails, state *state.NetworkState, nodeAddress common.Address) {
+
+	// Check if it was closed some other way
+	if mpd == nil {
+		t.fail(mp, fmt.Errorf("the minipool was not found in the network state"))
+		return
+	}
+	if mpd.Finalised {
+		mp.Advance(migration.StageClosed)
+		t.log.Printlnf("Minipool %s has already been closed.", mp.MinipoolAddress.Hex())
+		return
+	}
+
+	// Wait for the withdrawal sweep
+	validatorStatus, exists := state.MinipoolValidatorDetails[mpd.Pubkey]
+	if !exists || validatorStatus.Status != beacon.ValidatorState_WithdrawalDone {
+		return
+	}
+	if t.disabled {
+		t.log.Printlnf("Minipool %s is ready to close, but automatic transactions are disabled. Close it with `rocketpool minipool close`.", mp.MinipoolAddress.Hex())
+		return
+	}
+
+	// Get transactor
+	opts, err := t.w.GetNodeAccountTransactor()
+	if err != nil {
+		t.fail(mp, err)
+		return
+	}
+
+	// Make sure the minipool can be closed
+	details, err := minipoolapi.GetMinipoolCloseDetails(t.rp, mp.MinipoolAddress, nodeAddress, opts)
+	if err != nil {
+		t.fail(mp, fmt.Errorf("error checking if the minipool can be closed: %w", err))
+		return
+	}
+	if !details.CanClose {
+		return
+	}
+
+	// Get the max fee
+	maxFee := t.maxFee
+	if maxFee == nil || maxFee.Uint64() == 0 {
+		maxFee, err = rpgas.GetHeadlessMaxFeeWeiWithLatestBlock(t.cfg, t.rp)
+		if err != nil {
+			t.fail(mp, err)
+			return
+		}
+	}
+
+	// Print the gas info
+	t.log.Printlnf("Closing minipool %s (%.6f ETH to the node)...", mp.MinipoolAddress.Hex(), math.RoundDown(math.WeiToEth(details.NodeShare), 6))
+	if !details.GasLimits.PrintAndCheck(true, t.gasThreshold, &t.log, maxFee, t.gasLimit) {
+		return
+	}
+	opts.GasFeeCap = maxFee
+	opts.GasTipCap = GetPriorityFee(t.maxPriorityFee, maxFee)
+	opts.GasLimit = details.GasLimits.Safe
+
+	// Close the minipool
+	response, err := minipoolapi.CloseMinipool(t.c, mp.MinipoolAddress, opts, false)
+	if err != nil {
+		t.fail(mp, fmt.Errorf("error closing the minipool: %w", err))
+		return
+	}
+
+	// Print TX info and wait for it to be included in a block
+	if err := transactions.PrintAndWaitForTransaction(t.cfg, response.TxHash, t.rp.Client, &t.log); err != nil {
+		t.fail(mp, fmt.Errorf("error waiting for the close transaction: %w", err))
+		return
+	}
+	mp.CloseTxHash = response.TxHash
+	mp.Advance(migration.StageClosed)
+	t.log.Printlnf("Successfully closed minipool %s.", mp.MinipoolAddress.Hex())
+
+}
+
+// Queue the megapool validator that replaces a closed minipool, returning true if a deposit was submitted or is still pending
+func (t *migrateMinipools) depositMegapoolValidator(mp *migration.MinipoolMigration, useExpressTickets bool, state *state.NetworkState, nodeAddress common.Address) bool {
+
+	if t.disabled {
+		return false
+	}
+
+	// Check on a deposit from a previous cycle that wasn't confirmed
+	var nonce *big.Int
+	if mp.DepositTxHash != (common.Hash{}) {
+		// Get the nonce before the receipt so a deposit that's included in between isn't mistaken for a replaced one
+		confirmedNonce, err := t.rp.Client.NonceAt(context.Background(), nodeAddress, nil)
+		if err != nil {
+			t.fail(mp, fmt.Errorf("error getting the node account nonce: %w", err))
+			return true
+		}
+		receipt, err := t.rp.Client.TransactionReceipt(context.Background(), mp.DepositTxHash)
+		switch {
+		case err == nil && receipt.Status == types.ReceiptStatusSuccessful:
+			mp.Advance(migration.StageDeposited)
+			t.log.Printlnf("The deposit replacing minipool %s has been included.", mp.MinipoolAddress.Hex())
+			return true
+		case err == nil:
+			t.log.Printlnf("Deposit transaction %s for minipool %s failed, depositing again.", mp.DepositTxHash.Hex(), mp.MinipoolAddress.Hex())
+			mp.DepositTxHash = common.Hash{}
+			mp.ValidatorPubkey = rptypes.ValidatorPubkey{}
+			mp.ResubmittedDeposits = nil
+		case !errors.Is(err, ethereum.NotFound):
+			t.fail(mp, fmt.Errorf("error getting the receipt for deposit transaction %s: %w", mp.DepositTxHash.Hex(), err))
+			return true
+		case confirmedNonce > mp.DepositTxNonce:
+			// Another transaction used the deposit's nonce; it may be an earlier submission of the same deposit
+			included, err := t.checkResubmittedDeposits(mp)
+			if err != nil {
+				t.fail(mp, err)
+				return true
+			}
+			if included {
+				mp.Advance(migration.StageDeposited)
+				t.log.Printlnf("The deposit replacing minipool %s has been included.", mp.MinipoolAddress.Hex())
+				return true
+			}
+			t.log.Printlnf("Deposit transaction %s for minipool %s was dropped or replaced, depositing again.", mp.DepositTxHash.Hex(), mp.MinipoolAddress.Hex())
+			mp.DepositTxHash = common.Hash{}
+			mp.ValidatorPubkey = rptypes.ValidatorPubkey{}
+			mp.ResubmittedDeposits = nil
+		case time.Since(mp.DepositTime) < migrationDepositTimeout:
+			// Still pending, so don't submit another one
+			t.log.Printlnf("Waiting for deposit transaction %s for minipool %s...", mp.DepositTxHash.Hex(), mp.MinipoolAddress.Hex())
+			return true
+		default:
+			// Reuse the nonce so at most one of the two deposits can be included
+			t.log.Printlnf("Deposit transaction %s for minipool %s hasn't been included after %s, submitting it again.", mp.DepositTxHash.Hex(), mp.MinipoolAddress.Hex(), migrationDepositTimeout)
+			nonce = new(big.Int).SetUint64(mp.DepositTxNonce)
+		}
+	}
+
+	// Get the bond for the next validator
+	bonded := big.NewInt(0)
+	var activeValidatorCount uint32
+	if nodeDetails, exists := state.NodeDetailsByAddress[nodeAddress]; exists && nodeDetails.MegapoolDeployed {
+		if mpDetails, exists := state.MegapoolDetails[nodeDetails.MegapoolAddress]; exists {
+			if mpDetails.NodeBond != nil {
+				bonded.Add(bonded, mpDetails.NodeBond)
+			}
+			if mpDetails.NodeQueuedBond != nil {
+				bonded.Add(bonded, mpDetails.NodeQueuedBond)
+			}
+			activeValidatorCount = mpDetails.ActiveValidatorCount
+		}
+	}
+	bondRequirement, err := node.GetBondRequirement(t.rp, big.NewInt(int64(activeValidatorCount)+1), nil)
+	if err != nil {
+		t.fail(mp, fmt.Errorf("error getting the bond requirement: %w", err))
+		return false
+	}
+	amount := getMigrationBondAmount(bondRequirement, bonded)
+	var expressTickets int64
+	if useExpressTickets {
+		expressTickets = 1
+	}
+
+	// Make sure the deposit can go through
+	canDeposit, err := nodeapi.CanNodeDeposits(t.c, 1, amount, 0, big.NewInt(0), expressTickets)
+	if err != nil {
+		t.fail(mp, fmt.Errorf("error checking the megapool deposit: %w", err))
+		return false
+	}
+	if !canDeposit.CanDeposit {
+		var reason error
+		switch {
+		case canDeposit.NodeHasDebt:
+			reason = fmt.Errorf("the megapool has debt that must be repaid first")
+		case canDeposit.DepositDisabled:
+			reason = fmt.Errorf("node deposits are currently disabled")
+		default:
+			reason = fmt.Errorf("the node wallet and credit balance don't cover the %.6f ETH bond; send ETH to the node wallet to continue", math.RoundDown(math.WeiToEth(amount), 6))
+		}
+		t.fail(mp, reason)
+		t.log.Printlnf("Waiting to deposit the megapool validator replacing minipool %s: %s.", mp.MinipoolAddress.Hex(), reason.Error())
+		return false
+	}
+
+	// Get the max fee
+	maxFee := t.maxFee
+	if maxFee == nil || maxFee.Uint64() == 0 {
+		maxFee, err = rpgas.GetHeadlessMaxFeeWeiWithLatestBlock(t.cfg, t.rp)
+		if err != nil {
+			t.fail(mp, err)
+			return false
+		}
+	}
+
+	// Print the gas info
+	t.log.Printlnf("Depositing a megapool validator with a %.6f ETH bond to replace minipool %s...", math.RoundDown(math.WeiToEth(amount), 6), mp.MinipoolAddress.Hex())
+	if !canDeposit.GasLimits.PrintAndCheck(true, t.gasThreshold, &t.log, maxFee, t.gasLimit) {
+		return false
+	}
+
+	// Get transactor
+	opts, err := t.w.GetNodeAccountTransactor()
+	if err != nil {
+		t.fail(mp, err)
+		return false
+	}
+	opts.GasFeeCap = maxFee
+	opts.GasTipCap = GetPriorityFee(t.maxPriorityFee, maxFee)
+	opts.GasLimit = canDeposit.GasLimits.Safe
+	if nonce == nil {
+		pendingNonce, err := t.rp.Client.PendingNonceAt(context.Background(), nodeAddress)
+		if err != nil {
+			t.fail(mp, fmt.Errorf("error getting the node account nonce: %w", err))
+			return false
+		}
+		nonce = new(big.Int).SetUint64(pendingNonce)
+	}
+	opts.Nonce = nonce
+
+	// Deposit
+	response, err := nodeapi.NodeDeposits(t.c, 1, amount, 0, big.NewInt(0), canDeposit.CanUseCredit, expressTickets, true, opts)
+	if err != nil {
+		t.fail(mp, fmt.Errorf("error depositing the megapool validator: %w", err))
+		return false
+	}
+
+	// Print TX info and wait for it to be included in a block
+	if mp.DepositTxHash != (common.Hash{}) {
+		mp.ResubmittedDeposits = append(mp.ResubmittedDeposits, migration.DepositAttempt{
+			TxHash:          mp.DepositTxHash,
+			ValidatorPubkey: mp.ValidatorPubkey,
+		})
+	}
+	mp.DepositTxHash = response.TxHash
+	mp.DepositTxNonce = nonce.Uint64()
+	mp.DepositTime = time.Now()
+	if len(response.ValidatorPubkeys) > 0 {
+		mp.ValidatorPubkey = response.ValidatorPubkeys[0]
+	}
+	if err := transactions.PrintAndWaitForTransaction(t.cfg, response.TxHash, t.rp.Client, &t.log); err != nil {
+		t.fail(mp, fmt.Errorf("error waiting for the deposit transaction: %w", err))
+		return true
+	}
+	mp.Advance(migration.StageDeposited)
+	t.log.Printlnf("Successfully deposited megapool validator %s to replace minipool %s.", mp.ValidatorPubkey.Hex(), mp.MinipoolAddress.Hex())
+	return true
+
+}
+
+// Check if an earlier submission of a deposit that was resubmitted with the same nonce was included instead of the latest one.
+// If so, it becomes the minipool's deposit.
+func (t *migrateMinipools) checkResubmittedDeposits(mp *migration.MinipoolMigration) (bool, error) {
+	for _, deposit := range mp.ResubmittedDeposits {
+		receipt, err := t.rp.Client.TransactionReceipt(context.Background(), deposit.TxHash)
+		if errors.Is(err, ethereum.NotFound) {
+			continue
+		}
+		if err != nil {
+			return false, fmt.Errorf("error getting the receipt for deposit transaction %s: %w", deposit.TxHash.Hex(), err)
+		}
+		if receipt.Status == types.ReceiptStatusSuccessful {
+			mp.DepositTxHash = deposit.TxHash
+			mp.ValidatorPubkey = deposit.ValidatorPubkey
+			mp.ResubmittedDeposits = nil
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Record and log why a minipool couldn't move on to its next stage
+func (t *migrateMinipools) fail(mp *migration.MinipoolMigration, err error) {
+	mp.Fail(err)
+	t.log.Printlnf("Minipool %s: %s", mp.MinipoolAddress.Hex(), err.Error())
+}
+
+// Get the bond for the next megapool validator, clamped between 1 and 32 ETH like the deposit command
+func getMigrationBondAmount(bondRequirement *big.Int, bonded *big.Int) *big.Int {
+	amount := new(big.Int).Sub(bondRequirement, bonded)
+	if minimum := math.EthToWei(1); amount.Cmp(minimum) < 0 {
+		return minimum
+	}
+	if maximum := math.EthToWei(32); amount.Cmp(maximum) > 0 {
+		return maximum
+	}
+	return amount
+}
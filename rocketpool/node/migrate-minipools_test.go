@@ -0,0 +1,27 @@
+package node
+
+import (
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/math"
+)
+
+func TestGetMigrationBondAmount(t *testing.T) {
+	testCases := []struct {
+		name        string
+		requirement float64
+		bonded      float64
+		expected    float64
+	}{
+		{"first validator", 4, 0, 4},
+		{"partially bonded", 8, 4, 4},
+		{"over-bonded", 8, 12, 1},
+		{"no megapool bond", 40, 0, 32},
+	}
+	for _, tc := range testCases {
+		amount := getMigrationBondAmount(math.EthToWei(tc.requirement), math.EthToWei(tc.bonded))
+		if amount.Cmp(math.EthToWei(tc.expected)) != 0 {
+			t.Errorf("%s: expected %.0f ETH, got %.6f ETH", tc.name, tc.expected, math.WeiToEth(amount))
+		}
+	}
+}
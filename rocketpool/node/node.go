@@ -14,6 +14,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/fatih/color"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/urfave/cli/v3"
 
 	"github.com/rocket-pool/smartnode/bindings/utils"
@@ -57,6 +58,13 @@ const (
 	ProvisionExpressTickets        = color.FgMagenta
 	SetUseLatestDelegateColor      = color.FgBlue
 	CheckPortConnectivityColor     = color.FgHiYellow
+	WatchNodesColor                = color.FgCyan
+	PrecomputeMegapoolProofsColor  = color.FgHiBlue
+	ManageMegapoolDebtColor        = color.FgHiCyan
+	MigrateMinipoolsColor          = color.FgHiGreen
+	CheckValidatorDutiesColor      = color.FgHiRed
+	AutoVotePdaoPropsColor         = color.FgHiYellow
+	CheckGovernanceDeadlinesColor  = color.FgHiMagenta
 )
 
 // Register node command
@@ -110,6 +118,7 @@ func run(c *cli.Command) error {
 	// Start the HTTP API server immediately so the CLI can reach it while
 	// the daemon waits for the wallet and services to become ready.
 	startHTTP(ctx, c, cfg)
+	startProofServer(ctx, c, cfg)
 
 	for {
 		// Exit if the process received SIGINT/SIGTERM
@@ -140,16 +149,98 @@ func run(c *cli.Command) error {
 		break
 	}
 
-	// Wait until the node wallet stored on disk is registered
-	if err := services.WaitNodeRegistered(ctx, c, true); err != nil {
+	// Get services
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
 		return err
 	}
 
-	// Get services
-	rp, err := services.GetRocketPool(c)
+	// Initialize loggers
+	errorLog := log.NewColorLogger(ErrorColor)
+	updateLog := log.NewColorLogger(UpdateColor)
+	observeLog := log.NewColorLogger(ObserveWarningColor)
+
+	// Create the state provider. In live mode this is a NetworkStateManager
+	// backed by the real EC/BC; in --network-state mode it is a
+	// StaticNetworkStateProvider that serves from the pre-loaded snapshot.
+	var m state.NetworkStateProvider
+	if services.IsStaticStateMode(c) {
+		m, err = services.GetNetworkStateProvider(c)
+		if err != nil {
+			return fmt.Errorf("error getting network state provider: %w", err)
+		}
+	} else {
+		m = state.NewNetworkStateManager(rp, cfg.Smartnode.GetStateManagerContracts(), bc, &updateLog)
+	}
+	stateLocker := collectors.NewStateLocker()
+	watchedStateLocker := collectors.NewStateLocker()
+
+	// The watch list doesn't need a node wallet, so it starts before waiting for one to be registered
+	watchNodes, err := newWatchNodes(c, log.NewColorLogger(WatchNodesColor), m, watchedStateLocker)
 	if err != nil {
 		return err
 	}
+	metricsLog := log.NewColorLogger(MetricsColor)
+	metricsRegistry := prometheus.NewRegistry()
+	metricsRegistry.MustRegister(collectors.NewWatchedNodeCollector(rp, cfg, watchedStateLocker))
+
+	// Wait group to handle the various threads
+	wg := new(sync.WaitGroup)
+	wg.Add(2)
+
+	// Run watch list loop
+	go func() {
+		defer wg.Done()
+		for {
+			// Exit if the process received SIGINT/SIGTERM
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+
+			// Wait for the clients to sync so the watched nodes' state is accurate
+			if err := services.WaitEthClientSynced(c, false); err != nil {
+				if !sleepWithContext(ctx, taskCooldown) {
+					return
+				}
+				continue
+			}
+			if err := services.WaitBeaconClientSynced(c, false); err != nil {
+				if !sleepWithContext(ctx, taskCooldown) {
+					return
+				}
+				continue
+			}
+
+			// Update the state of the nodes on the watch list
+			if err := watchNodes.run(); err != nil {
+				errorLog.Println(err)
+			}
+			if !sleepWithContext(ctx, tasksInterval) {
+				return
+			}
+		}
+	}()
+
+	// Run metrics loop
+	go func() {
+		defer wg.Done()
+		if err := runMetricsServer(ctx, c, metricsLog, metricsRegistry); err != nil {
+			errorLog.Println(err)
+		}
+	}()
+
+	// Wait until the node wallet stored on disk is registered
+	if err := services.WaitNodeRegistered(ctx, c, true); err != nil {
+		return err
+	}
+
+	// Get the node wallet
 	isObserveMode := wallet.CheckObserveMode(cfg.Smartnode.GetNodeAddressPath())
 	var w wallet.Wallet
 	if isObserveMode {
@@ -160,10 +251,6 @@ func run(c *cli.Command) error {
 	if err != nil {
 		return err
 	}
-	bc, err := services.GetBeaconClient(c)
-	if err != nil {
-		return err
-	}
 
 	protocolVersion, err := utils.GetCurrentVersion(rp, nil)
 	if err != nil {
@@ -177,24 +264,10 @@ func run(c *cli.Command) error {
 		return fmt.Errorf("error getting node account: %w", err)
 	}
 
-	// Initialize loggers
-	errorLog := log.NewColorLogger(ErrorColor)
-	updateLog := log.NewColorLogger(UpdateColor)
-	observeLog := log.NewColorLogger(ObserveWarningColor)
-
-	// Create the state provider. In live mode this is a NetworkStateManager
-	// backed by the real EC/BC; in --network-state mode it is a
-	// StaticNetworkStateProvider that serves from the pre-loaded snapshot.
-	var m state.NetworkStateProvider
-	if services.IsStaticStateMode(c) {
-		m, err = services.GetNetworkStateProvider(c)
-		if err != nil {
-			return fmt.Errorf("error getting network state provider: %w", err)
-		}
-	} else {
-		m = state.NewNetworkStateManager(rp, cfg.Smartnode.GetStateManagerContracts(), bc, &updateLog)
+	// Report the node's own metrics now that it's registered
+	if err := registerNodeCollectors(c, metricsLog, metricsRegistry, stateLocker); err != nil {
+		errorLog.Println(err)
 	}
-	stateLocker := collectors.NewStateLocker()
 
 	// Initialize tasks
 	manageFeeRecipient, err := newManageFeeRecipient(c, log.NewColorLogger(ManageFeeRecipientColor))
@@ -237,6 +310,14 @@ func run(c *cli.Command) error {
 	if err != nil {
 		return err
 	}
+	manageMegapoolDebtAndRefunds, err := newManageMegapoolDebtAndRefunds(c, log.NewColorLogger(ManageMegapoolDebtColor))
+	if err != nil {
+		return err
+	}
+	migrateMinipools, err := newMigrateMinipools(c, log.NewColorLogger(MigrateMinipoolsColor))
+	if err != nil {
+		return err
+	}
 	var verifyPdaoProps *verifyPdaoProps
 	// Make sure the user opted into this duty
 	verifyEnabled := cfg.Smartnode.VerifyProposals.Value.(bool)
@@ -247,22 +328,40 @@ func run(c *cli.Command) error {
 		}
 	}
 
+	autoVotePdaoProps, err := newAutoVotePdaoProps(c, log.NewColorLogger(AutoVotePdaoPropsColor))
+	if err != nil {
+		return err
+	}
+
 	var prestakeMegapoolValidator *prestakeMegapoolValidator
 	prestakeMegapoolValidator, err = newPrestakeMegapoolValidator(c, log.NewColorLogger(PrestakeMegapoolValidatorColor))
 	if err != nil {
 		return err
 	}
+	var precomputeMegapoolProofs *precomputeMegapoolProofs
+	// Only keep beacon states in memory if the user opted into serving proofs
+	if cfg.Smartnode.EnableProofServer.Value.(bool) {
+		precomputeMegapoolProofs, err = newPrecomputeMegapoolProofs(c, log.NewColorLogger(PrecomputeMegapoolProofsColor))
+		if err != nil {
+			return err
+		}
+	}
+	checkValidatorDuties, err := newCheckValidatorDuties(c, log.NewColorLogger(CheckValidatorDutiesColor))
+	if err != nil {
+		return err
+	}
+	checkGovernanceDeadlines, err := newCheckGovernanceDeadlines(c, log.NewColorLogger(CheckGovernanceDeadlinesColor))
+	if err != nil {
+		return err
+	}
 	var checkPorts *connectivity.CheckPortConnectivity
 	checkPorts, err = connectivity.NewCheckPortConnectivity(c, cfg, log.NewColorLogger(CheckPortConnectivityColor))
 	if err != nil {
 		return err
 	}
 
-	// Wait group to handle the various threads
-	wg := new(sync.WaitGroup)
-	wg.Add(2)
-
 	// Run task loop
+	wg.Add(1)
 	go func() {
 		defer wg.Done()
 		// we assume clients are synced on startup so that we don't send unnecessary alerts
@@ -392,6 +491,14 @@ func run(c *cli.Command) error {
 				}
 			}
 
+			// Run the pDAO auto-voter
+			if err := autoVotePdaoProps.run(state); err != nil {
+				errorLog.Println(err)
+			}
+			if !sleepWithContext(ctx, taskCooldown) {
+				return
+			}
+
 			// Run the megapool prestake check
 			if prestakeMegapoolValidator != nil {
 				if err := prestakeMegapoolValidator.run(state); err != nil {
@@ -426,6 +533,16 @@ func run(c *cli.Command) error {
 				return
 			}
 
+			// Precompute the proofs served by the megapool proof server
+			if precomputeMegapoolProofs != nil {
+				if err := precomputeMegapoolProofs.run(state); err != nil {
+					errorLog.Println(err)
+				}
+				if !sleepWithContext(ctx, taskCooldown) {
+					return
+				}
+			}
+
 			// Run the megapool provision express ticket check
 			if err := provisionExpressTickets.run(state); err != nil {
 				errorLog.Println(err)
@@ -442,6 +559,22 @@ func run(c *cli.Command) error {
 				return
 			}
 
+			// Run the megapool debt repayment and refund claim check
+			if err := manageMegapoolDebtAndRefunds.run(state); err != nil {
+				errorLog.Println(err)
+			}
+			if !sleepWithContext(ctx, taskCooldown) {
+				return
+			}
+
+			// Move the minipools in the migration plan along
+			if err := migrateMinipools.run(state); err != nil {
+				errorLog.Println(err)
+			}
+			if !sleepWithContext(ctx, taskCooldown) {
+				return
+			}
+
 			// Run the set use latest delegate check
 			if err := setUseLatestDelegate.run(state); err != nil {
 				errorLog.Println(err)
@@ -450,6 +583,22 @@ func run(c *cli.Command) error {
 				return
 			}
 
+			// Check the node's validators for missed duties
+			if err := checkValidatorDuties.run(state); err != nil {
+				errorLog.Println(err)
+			}
+			if !sleepWithContext(ctx, taskCooldown) {
+				return
+			}
+
+			// Alert on governance deadlines
+			if err := checkGovernanceDeadlines.run(); err != nil {
+				errorLog.Println(err)
+			}
+			if !sleepWithContext(ctx, taskCooldown) {
+				return
+			}
+
 			// Run the port connectivity check
 			if err := checkPorts.Run(); err != nil {
 				errorLog.Println(err)
@@ -464,15 +613,7 @@ func run(c *cli.Command) error {
 		}
 	}()
 
-	// Run metrics loop
-	go func() {
-		defer wg.Done()
-		if err := runMetricsServer(ctx, c, log.NewColorLogger(MetricsColor), stateLocker); err != nil {
-			errorLog.Println(err)
-		}
-	}()
-
-	// Wait for both threads to stop
+	// Wait for all of the threads to stop
 	wg.Wait()
 	return nil
 }
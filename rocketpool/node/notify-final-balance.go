@@ -11,7 +11,6 @@ import (
 
 	"github.com/rocket-pool/smartnode/bindings/megapool"
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
-	"github.com/rocket-pool/smartnode/bindings/transactions"
 
 	log "github.com/rocket-pool/smartnode/shared/logger"
 	"github.com/rocket-pool/smartnode/shared/math"
@@ -164,24 +163,30 @@ func (t *notifyFinalBalance) run(state *state.NetworkState) error {
 		return nil
 	}
 
-	// Notify the validators
+	// Notify the validators, submitting all of the proofs before waiting for any of them
+	pending := newPendingTransactions(t.rp, nodeAccount.Address)
 	for validatorId, validatorDetails := range validatorDetailsToProve {
 		// Log
 		t.log.Printlnf("The validator id %d needs a final balance proof", validatorId)
 
-		err := t.createFinalBalanceProof(t.rp, mp, state, validatorId, validatorDetails, opts)
+		err := t.createFinalBalanceProof(t.rp, mp, state, validatorId, validatorDetails, pending, opts)
 		// dont return if there was an error, just log it so we can continue with the next validator
 		if err != nil {
 			t.log.Printlnf("Error creating final balance proof for validator %d: %w", validatorId, err)
 		}
 	}
 
+	// Wait for the submitted final balance notifications
+	for _, validatorId := range pending.wait(t.cfg, &t.log) {
+		t.log.Printlnf("Successfully notified validator %d final balance.", validatorId)
+	}
+
 	// Return
 	return nil
 
 }
 
-func (t *notifyFinalBalance) createFinalBalanceProof(rp *rocketpool.RocketPool, mp megapool.Megapool, state *state.NetworkState, validatorId uint32, validatorDetails beacon.ValidatorStatus, callopts *bind.CallOpts) error {
+func (t *notifyFinalBalance) createFinalBalanceProof(rp *rocketpool.RocketPool, mp megapool.Megapool, state *state.NetworkState, validatorId uint32, validatorDetails beacon.ValidatorStatus, pending *pendingTransactions, callopts *bind.CallOpts) error {
 
 	// Get transactor
 	opts, err := t.w.GetNodeAccountTransactor()
@@ -229,6 +234,11 @@ func (t *notifyFinalBalance) createFinalBalanceProof(rp *rocketpool.RocketPool,
 		Witnesses:      withdrawalProof.Witnesses,
 	}
 
+	// Check the proofs against our own Beacon Node before submitting them
+	if err := services.VerifyFinalBalanceProof(t.bc, finalBalanceProof, validatorProof, slotProof); err != nil {
+		return fmt.Errorf("error verifying the final balance proof for megapool validator %d: %w", validatorId, err)
+	}
+
 	t.log.Printlnf("The validator final balance proof has been successfully created.")
 
 	// Get the gas limit
@@ -255,21 +265,17 @@ func (t *notifyFinalBalance) createFinalBalanceProof(rp *rocketpool.RocketPool,
 	opts.GasFeeCap = maxFee
 	opts.GasTipCap = GetPriorityFee(t.maxPriorityFee, maxFee)
 	opts.GasLimit = gas.Uint64()
-
-	// Call Notify Final Balance
-	tx, err := megapool.NotifyFinalBalance(rp, mp.GetAddress(), validatorId, slotTimestamp, finalBalanceProof, validatorProof, slotProof, opts)
-	if err != nil {
+	if err := pending.prepare(opts); err != nil {
 		return err
 	}
 
-	// Print TX info and wait for it to be included in a block
-	err = transactions.PrintAndWaitForTransaction(t.cfg, tx.Hash(), t.rp.Client, &t.log)
+	// Call Notify Final Balance
+	tx, err := megapool.NotifyFinalBalance(rp, mp.GetAddress(), validatorId, slotTimestamp, finalBalanceProof, validatorProof, slotProof, opts)
 	if err != nil {
 		return err
 	}
-
-	// Log
-	t.log.Printlnf("Successfully notified validator %d final balance.", validatorId)
+	pending.add(validatorId, tx.Hash())
+	t.log.Printlnf("Submitted the final balance notification for validator %d with hash %s.", validatorId, tx.Hash().Hex())
 
 	// Return
 	return nil
@@ -11,7 +11,6 @@ import (
 
 	"github.com/rocket-pool/smartnode/bindings/megapool"
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
-	"github.com/rocket-pool/smartnode/bindings/transactions"
 	"github.com/rocket-pool/smartnode/bindings/types"
 
 	log "github.com/rocket-pool/smartnode/shared/logger"
@@ -174,6 +173,8 @@ func (t *notifyValidatorExit) run(state *state.NetworkState) error {
 	}
 	finalizedValidators := beaconState.GetValidators()
 
+	// Submit all of the exit proofs before waiting for any of them
+	pending := newPendingTransactions(t.rp, nodeAccount.Address)
 	for validatorId, validatorDetails := range validatorDetailsToProve {
 		pubkey := types.ValidatorPubkey(validatorDetails.Pubkey)
 
@@ -201,18 +202,24 @@ func (t *notifyValidatorExit) run(state *state.NetworkState) error {
 		}
 
 		// Call Notify Exit
-		err = t.createExitProof(t.rp, beaconState, mp, validatorId, state, pubkey, opts)
+		err = t.createExitProof(t.rp, beaconState, mp, validatorId, state, pubkey, pending, opts)
 		// dont return if there was an error, just log it so we can continue with the next validator
 		if err != nil {
 			t.log.Printlnf("Error creating exit proof for validator %d: %w", validatorId, err)
 		}
 	}
+
+	// Wait for the submitted exit notifications
+	for _, validatorId := range pending.wait(t.cfg, &t.log) {
+		t.log.Printlnf("Successfully notified validator %d exit.", validatorId)
+	}
+
 	// Return
 	return nil
 
 }
 
-func (t *notifyValidatorExit) createExitProof(rp *rocketpool.RocketPool, beaconState eth2.BeaconState, mp megapool.Megapool, validatorId uint32, state *state.NetworkState, validatorPubkey types.ValidatorPubkey, callopts *bind.CallOpts) error {
+func (t *notifyValidatorExit) createExitProof(rp *rocketpool.RocketPool, beaconState eth2.BeaconState, mp megapool.Megapool, validatorId uint32, state *state.NetworkState, validatorPubkey types.ValidatorPubkey, pending *pendingTransactions, callopts *bind.CallOpts) error {
 
 	// Get transactor
 	opts, err := t.w.GetNodeAccountTransactor()
@@ -228,6 +235,11 @@ func (t *notifyValidatorExit) createExitProof(rp *rocketpool.RocketPool, beaconS
 		return err
 	}
 
+	// Check the proofs against our own Beacon Node before submitting them
+	if err := services.VerifyValidatorProof(t.bc, validatorProof, slotProof); err != nil {
+		return fmt.Errorf("error verifying the validator proof for megapool validator %d: %w", validatorId, err)
+	}
+
 	t.log.Printlnf("[FINISHED] The validator exit proof has been successfully created.")
 
 	// Get the gas limit
@@ -254,21 +266,17 @@ func (t *notifyValidatorExit) createExitProof(rp *rocketpool.RocketPool, beaconS
 	opts.GasFeeCap = maxFee
 	opts.GasTipCap = GetPriorityFee(t.maxPriorityFee, maxFee)
 	opts.GasLimit = gas.Uint64()
-
-	// Call Notify Exit
-	tx, err := megapool.NotifyExit(rp, mp.GetAddress(), validatorId, slotTimestamp, validatorProof, slotProof, opts)
-	if err != nil {
+	if err := pending.prepare(opts); err != nil {
 		return err
 	}
 
-	// Print TX info and wait for it to be included in a block
-	err = transactions.PrintAndWaitForTransaction(t.cfg, tx.Hash(), t.rp.Client, &t.log)
+	// Call Notify Exit
+	tx, err := megapool.NotifyExit(rp, mp.GetAddress(), validatorId, slotTimestamp, validatorProof, slotProof, opts)
 	if err != nil {
 		return err
 	}
-
-	// Log
-	t.log.Printlnf("Successfully notified validator %d exit.", validatorId)
+	pending.add(validatorId, tx.Hash())
+	t.log.Printlnf("Submitted the exit notification for validator %d with hash %s.", validatorId, tx.Hash().Hex())
 
 	// Return
 	return nil
@@ -0,0 +1,77 @@
+package node
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/bindings/transactions"
+	log "github.com/rocket-pool/smartnode/shared/logger"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+)
+
+// The megapool manager only accepts one validator per stake / notify call, so tasks that act on several validators
+// submit their transactions back to back with consecutive nonces and wait for all of them at the end of the cycle
+type pendingTransactions struct {
+	rp    *rocketpool.RocketPool
+	from  common.Address
+	nonce *big.Int
+	txs   []pendingTransaction
+}
+
+type pendingTransaction struct {
+	validatorId uint32
+	hash        common.Hash
+}
+
+// Create a new set of pending transactions for the given sender
+func newPendingTransactions(rp *rocketpool.RocketPool, from common.Address) *pendingTransactions {
+	return &pendingTransactions{
+		rp:   rp,
+		from: from,
+	}
+}
+
+// Assign the next nonce in the batch to the transactor
+func (p *pendingTransactions) prepare(opts *bind.TransactOpts) error {
+	if p.nonce == nil {
+		nonce, err := p.rp.Client.PendingNonceAt(context.Background(), p.from)
+		if err != nil {
+			return err
+		}
+		p.nonce = new(big.Int).SetUint64(nonce)
+	}
+	opts.Nonce = new(big.Int).Set(p.nonce)
+	return nil
+}
+
+// Record a submitted transaction and move on to the next nonce
+func (p *pendingTransactions) add(validatorId uint32, hash common.Hash) {
+	p.txs = append(p.txs, pendingTransaction{
+		validatorId: validatorId,
+		hash:        hash,
+	})
+	p.nonce.Add(p.nonce, big.NewInt(1))
+}
+
+// Get the number of submitted transactions
+func (p *pendingTransactions) count() int {
+	return len(p.txs)
+}
+
+// Wait for all of the submitted transactions, returning the IDs of the validators whose transactions were included
+func (p *pendingTransactions) wait(cfg *config.RocketPoolConfig, logger *log.ColorLogger) []uint32 {
+	included := []uint32{}
+	for _, tx := range p.txs {
+		if err := transactions.PrintAndWaitForTransaction(cfg, tx.hash, p.rp.Client, logger); err != nil {
+			logger.Printlnf("Error waiting for the transaction for validator %d: %s", tx.validatorId, err.Error())
+			continue
+		}
+		included = append(included, tx.validatorId)
+	}
+	p.txs = nil
+	return included
+}
@@ -0,0 +1,109 @@
+package node
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/urfave/cli/v3"
+
+	log "github.com/rocket-pool/smartnode/shared/logger"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+)
+
+// Precompute megapool proofs task
+type precomputeMegapoolProofs struct {
+	c           *cli.Command
+	log         log.ColorLogger
+	w           wallet.Wallet
+	proofServer *services.ProofServer
+}
+
+// Create precompute megapool proofs task
+func newPrecomputeMegapoolProofs(c *cli.Command, logger log.ColorLogger) (*precomputeMegapoolProofs, error) {
+
+	// Get services
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return task
+	return &precomputeMegapoolProofs{
+		c:           c,
+		log:         logger,
+		w:           w,
+		proofServer: services.GetProofServer(),
+	}, nil
+
+}
+
+// Cache the latest finalized state and build the proofs the node's megapool validators will need from it.
+// Validator proofs for other nodes' validators are built from the cached states when they're requested,
+// but withdrawal proofs are only found for this node's validators.
+func (t *precomputeMegapoolProofs) run(state *state.NetworkState) error {
+
+	// Refresh the cached states
+	slot, err := t.proofServer.Refresh(t.c)
+	if err != nil {
+		return fmt.Errorf("error refreshing the megapool proof server's beacon states: %w", err)
+	}
+
+	// Get node account
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+	nodeDetails, exists := state.NodeDetailsByAddress[nodeAccount.Address]
+	if !exists || !nodeDetails.MegapoolDeployed {
+		return nil
+	}
+	megapoolAddress := nodeDetails.MegapoolAddress
+
+	// Find the validators that are waiting on a proof
+	for _, pubkey := range state.MegapoolToPubkeysMap[megapoolAddress] {
+		validatorDetails, exists := state.MegapoolValidatorDetails[pubkey]
+		if !exists || validatorDetails.Index == "" {
+			continue
+		}
+		validatorInfo, exists := state.GetMegapoolValidatorInfo(megapoolAddress, pubkey)
+		if !exists {
+			continue
+		}
+		validatorIndex, err := strconv.ParseUint(validatorDetails.Index, 10, 64)
+		if err != nil {
+			return err
+		}
+		info := validatorInfo.ValidatorInfo
+
+		// Exited validators need a withdrawal proof and a validator proof anchored in the same state
+		if validatorDetails.Status == beacon.ValidatorState_WithdrawalDone && info.Exiting && !info.Exited && validatorDetails.EffectiveBalance == 0 {
+			withdrawalSlot := validatorDetails.WithdrawableEpoch * state.BeaconConfig.SlotsPerEpoch
+			withdrawalProof, err := t.proofServer.GetWithdrawalProof(t.c, withdrawalSlot, validatorIndex)
+			if err != nil {
+				t.log.Printlnf("Error building the withdrawal proof for validator %d: %s", validatorIndex, err.Error())
+				continue
+			}
+			if _, err := t.proofServer.GetValidatorProof(t.c, withdrawalProof.Slot, validatorIndex); err != nil {
+				t.log.Printlnf("Error building the validator proof for validator %d at slot %d: %s", validatorIndex, withdrawalProof.Slot, err.Error())
+			}
+			continue
+		}
+
+		// Prestaked validators need a proof to stake, and exiting validators need one to notify the exit
+		needsStakeProof := info.InPrestake
+		needsExitProof := validatorDetails.WithdrawableEpoch < FarFutureEpoch && !info.Exited && !info.Exiting
+		if !needsStakeProof && !needsExitProof {
+			continue
+		}
+		if _, err := t.proofServer.GetValidatorProof(t.c, slot, validatorIndex); err != nil {
+			t.log.Printlnf("Error building the validator proof for validator %d at slot %d: %s", validatorIndex, slot, err.Error())
+		}
+	}
+
+	// Return
+	return nil
+
+}
@@ -1,6 +1,7 @@
 package node
 
 import (
+	"fmt"
 	"math/big"
 	"strconv"
 
@@ -10,7 +11,6 @@ import (
 
 	"github.com/rocket-pool/smartnode/bindings/megapool"
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
-	"github.com/rocket-pool/smartnode/bindings/transactions"
 	"github.com/rocket-pool/smartnode/bindings/types"
 
 	"github.com/rocket-pool/smartnode/rocketpool/validator"
@@ -170,8 +170,8 @@ func (t *stakeMegapoolValidator) run(state *state.NetworkState) error {
 		return err
 	}
 
-	// Iterate over validators to stake.
-	stakedValidators := 0
+	// Iterate over validators to stake, submitting them all before waiting for any
+	pending := newPendingTransactions(t.rp, nodeAccount.Address)
 	validatorsProcessed := 0
 	const batchSize = 6
 	for validatorId, validatorPubkey := range validatorsToStake {
@@ -196,16 +196,21 @@ func (t *stakeMegapoolValidator) run(state *state.NetworkState) error {
 		}
 
 		// Call Stake
-		err = t.stakeValidator(t.rp, beaconState, mp, validatorId, state, validatorPubkey, opts)
+		err = t.stakeValidator(t.rp, beaconState, mp, validatorId, state, validatorPubkey, pending, opts)
 		if err != nil {
 			t.log.Printlnf("Error staking validator %d: %w", validatorId, err)
 			break
 		}
-		stakedValidators++
 		validatorsProcessed++
 	}
 
-	if stakedValidators > 0 {
+	// Wait for the submitted stakes
+	stakedValidators := pending.wait(t.cfg, &t.log)
+	for _, validatorId := range stakedValidators {
+		t.log.Printlnf("Successfully staked validator %d.", validatorId)
+	}
+
+	if len(stakedValidators) > 0 {
 		if err := validator.RestartValidator(t.cfg, t.bc, &t.log, t.d); err != nil {
 			return err
 		}
@@ -214,7 +219,7 @@ func (t *stakeMegapoolValidator) run(state *state.NetworkState) error {
 	return nil
 }
 
-func (t *stakeMegapoolValidator) stakeValidator(rp *rocketpool.RocketPool, beaconState eth2.BeaconState, mp megapool.Megapool, validatorId uint32, state *state.NetworkState, validatorPubkey types.ValidatorPubkey, callopts *bind.CallOpts) error {
+func (t *stakeMegapoolValidator) stakeValidator(rp *rocketpool.RocketPool, beaconState eth2.BeaconState, mp megapool.Megapool, validatorId uint32, state *state.NetworkState, validatorPubkey types.ValidatorPubkey, pending *pendingTransactions, callopts *bind.CallOpts) error {
 
 	// Get transactor
 	opts, err := t.w.GetNodeAccountTransactor()
@@ -230,6 +235,11 @@ func (t *stakeMegapoolValidator) stakeValidator(rp *rocketpool.RocketPool, beaco
 		return err
 	}
 
+	// Check the proofs against our own Beacon Node before submitting them
+	if err := services.VerifyValidatorProof(t.bc, validatorProof, slotProof); err != nil {
+		return fmt.Errorf("error verifying the validator proof for megapool validator %d: %w", validatorId, err)
+	}
+
 	t.log.Printlnf("The beacon state proof has been successfully created.")
 
 	// Get the gas limit
@@ -256,21 +266,17 @@ func (t *stakeMegapoolValidator) stakeValidator(rp *rocketpool.RocketPool, beaco
 	opts.GasFeeCap = maxFee
 	opts.GasTipCap = GetPriorityFee(t.maxPriorityFee, maxFee)
 	opts.GasLimit = gas.Uint64()
-
-	// Call stake
-	tx, err := megapool.Stake(rp, mp.GetAddress(), validatorId, slotTimestamp, validatorProof, slotProof, opts)
-	if err != nil {
+	if err := pending.prepare(opts); err != nil {
 		return err
 	}
 
-	// Print TX info and wait for it to be included in a block
-	err = transactions.PrintAndWaitForTransaction(t.cfg, tx.Hash(), t.rp.Client, &t.log)
+	// Call stake
+	tx, err := megapool.Stake(rp, mp.GetAddress(), validatorId, slotTimestamp, validatorProof, slotProof, opts)
 	if err != nil {
 		return err
 	}
-
-	// Log
-	t.log.Printlnf("Successfully staked validator %d.", validatorId)
+	pending.add(validatorId, tx.Hash())
+	t.log.Printlnf("Submitted the stake for validator %d with hash %s.", validatorId, tx.Hash().Hex())
 
 	// Return
 	return nil
@@ -0,0 +1,101 @@
+package node
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v3"
+
+	"github.com/rocket-pool/smartnode/rocketpool/node/collectors"
+	log "github.com/rocket-pool/smartnode/shared/logger"
+	"github.com/rocket-pool/smartnode/shared/math"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/alerting"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+)
+
+// Watch nodes task
+type watchNodes struct {
+	c           *cli.Command
+	log         log.ColorLogger
+	cfg         *config.RocketPoolConfig
+	m           state.NetworkStateProvider
+	watchList   *wallet.WatchListManager
+	stateLocker *collectors.StateLocker
+}
+
+// Create watch nodes task
+func newWatchNodes(c *cli.Command, logger log.ColorLogger, m state.NetworkStateProvider, stateLocker *collectors.StateLocker) (*watchNodes, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return task
+	return &watchNodes{
+		c:           c,
+		log:         logger,
+		cfg:         cfg,
+		m:           m,
+		watchList:   wallet.NewWatchListManager(cfg.Smartnode.GetWatchedNodesPath()),
+		stateLocker: stateLocker,
+	}, nil
+
+}
+
+// Update the state of the watched nodes and alert on any that need attention
+func (t *watchNodes) run() error {
+
+	// Reload the watch list so changes made through the API are picked up without a restart
+	addresses, err := t.watchList.LoadAddresses()
+	if err != nil {
+		return err
+	}
+	if len(addresses) == 0 {
+		t.stateLocker.UpdateState(nil)
+		return nil
+	}
+
+	// Get the state for every watched node at once
+	t.log.Printlnf("Updating the state of %d watched node(s)...", len(addresses))
+	watchedState, err := t.m.GetHeadStateForNodes(addresses)
+	if err != nil {
+		return fmt.Errorf("error updating watched node state: %w", err)
+	}
+	t.stateLocker.UpdateState(watchedState)
+
+	// Check each node for problems
+	for _, address := range addresses {
+		node, exists := watchedState.NodeDetailsByAddress[address]
+		if !exists || !node.Exists {
+			t.log.Printlnf("Watched node %s is not registered with Rocket Pool.", address.Hex())
+			continue
+		}
+
+		counts := watchedState.GetNodeValidatorCounts(address)
+		if counts.Slashed > 0 {
+			t.log.Printlnf("WARNING: watched node %s has %d slashed validator(s).", address.Hex(), counts.Slashed)
+			if err := alerting.AlertWatchedNodeValidatorsSlashed(t.cfg, address, counts.Slashed); err != nil {
+				t.log.Printlnf("error sending watched node alert: %s", err.Error())
+			}
+		}
+
+		if !node.MegapoolDeployed {
+			continue
+		}
+		megapool, exists := watchedState.MegapoolDetails[node.MegapoolAddress]
+		if exists && megapool.NodeDebt != nil && megapool.NodeDebt.Sign() > 0 {
+			debt := math.RoundDown(math.WeiToEth(megapool.NodeDebt), 6)
+			t.log.Printlnf("WARNING: the megapool of watched node %s has %.6f ETH of debt.", address.Hex(), debt)
+			if err := alerting.AlertWatchedNodeMegapoolDebt(t.cfg, address, debt); err != nil {
+				t.log.Printlnf("error sending watched node alert: %s", err.Error())
+			}
+		}
+	}
+
+	return nil
+
+}
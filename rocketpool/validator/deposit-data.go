@@ -1,6 +1,8 @@
 package validator
 
 import (
+	"fmt"
+
 	"github.com/ethereum/go-ethereum/common"
 	eth2types "github.com/wealdtech/go-eth2-types/v2"
 
@@ -19,19 +21,8 @@ func GetDepositData(validatorKey *eth2types.BLSPrivateKey, withdrawalCredentials
 		Amount:                depositAmount,
 	}
 
-	// Get signing root
-	or, err := generic.SSZ.HashTreeRoot(&dd)
-	if err != nil {
-		return generic.DepositData{}, common.Hash{}, err
-	}
-
-	sr := generic.SigningRoot{
-		ObjectRoot: or[:],
-		Domain:     eth2types.Domain(eth2types.DomainDeposit, eth2Config.GenesisForkVersion, eth2types.ZeroGenesisValidatorsRoot),
-	}
-
 	// Get signing root with domain
-	srHash, err := generic.SSZ.HashTreeRoot(&sr)
+	srHash, err := getDepositSigningRoot(&dd, eth2Config)
 	if err != nil {
 		return generic.DepositData{}, common.Hash{}, err
 	}
@@ -54,3 +45,69 @@ func GetDepositData(validatorKey *eth2types.BLSPrivateKey, withdrawalCredentials
 	return depositData, depositDataRoot, nil
 
 }
+
+// Get the root of the deposit message (the deposit data without its signature)
+func GetDepositMessageRoot(depositData generic.DepositData) (common.Hash, error) {
+	dd := generic.DepositDataNoSignature{
+		PublicKey:             depositData.PublicKey,
+		WithdrawalCredentials: depositData.WithdrawalCredentials,
+		Amount:                depositData.Amount,
+	}
+	return generic.SSZ.HashTreeRoot(&dd)
+}
+
+// Check that the deposit data's signature was made by its validator key over the deposit domain of the given network,
+// and return the deposit data root
+func VerifyDepositData(depositData generic.DepositData, eth2Config beacon.Eth2Config) (bool, common.Hash, error) {
+
+	// Get the signing root the signature should cover
+	dd := generic.DepositDataNoSignature{
+		PublicKey:             depositData.PublicKey,
+		WithdrawalCredentials: depositData.WithdrawalCredentials,
+		Amount:                depositData.Amount,
+	}
+	srHash, err := getDepositSigningRoot(&dd, eth2Config)
+	if err != nil {
+		return false, common.Hash{}, err
+	}
+
+	// Get deposit data root
+	depositDataRoot, err := generic.SSZ.HashTreeRoot(&depositData)
+	if err != nil {
+		return false, common.Hash{}, err
+	}
+
+	// Verify the signature
+	if err := InitializeBLS(); err != nil {
+		return false, common.Hash{}, fmt.Errorf("Could not initialize BLS library: %w", err)
+	}
+	pubkey, err := eth2types.BLSPublicKeyFromBytes(depositData.PublicKey)
+	if err != nil {
+		return false, common.Hash{}, fmt.Errorf("invalid validator pubkey: %w", err)
+	}
+	signature, err := eth2types.BLSSignatureFromBytes(depositData.Signature)
+	if err != nil {
+		return false, depositDataRoot, nil
+	}
+	return signature.Verify(srHash[:], pubkey), depositDataRoot, nil
+
+}
+
+// Get the deposit signing root for deposit data, using the network's genesis fork version
+func getDepositSigningRoot(dd *generic.DepositDataNoSignature, eth2Config beacon.Eth2Config) (common.Hash, error) {
+
+	// Get signing root
+	or, err := generic.SSZ.HashTreeRoot(dd)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	sr := generic.SigningRoot{
+		ObjectRoot: or[:],
+		Domain:     eth2types.Domain(eth2types.DomainDeposit, eth2Config.GenesisForkVersion, eth2types.ZeroGenesisValidatorsRoot),
+	}
+
+	// Get signing root with domain
+	return generic.SSZ.HashTreeRoot(&sr)
+
+}
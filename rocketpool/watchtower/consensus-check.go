@@ -0,0 +1,201 @@
+package watchtower
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	log "github.com/rocket-pool/smartnode/shared/logger"
+	"github.com/rocket-pool/smartnode/shared/math"
+	"github.com/rocket-pool/smartnode/shared/services/alerting"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+)
+
+// How long the watchtower holds off submitting values for a block when every other member that already submitted for it disagrees
+const consensusMismatchDelay = time.Hour
+
+// How a submission field is displayed
+type submissionFieldKind int
+
+const (
+	submissionFieldPlain submissionFieldKind = iota
+	submissionFieldEth
+)
+
+// A value in an Oracle DAO submission
+type submissionField struct {
+	name  string
+	kind  submissionFieldKind
+	value *big.Int
+}
+
+// A value that differs between this node's submission and another member's
+type submissionFieldDiff struct {
+	name   string
+	kind   submissionFieldKind
+	ours   *big.Int
+	theirs *big.Int
+}
+
+// Another member's submission for the same block that disagrees with this node's
+type submissionMismatch struct {
+	member common.Address
+	diffs  []submissionFieldDiff
+}
+
+// Cross-checks this node's submissions against the ones other members already made for the same block
+type consensusCheck struct {
+	duty          string
+	cfg           *config.RocketPoolConfig
+	log           *log.ColorLogger
+	delay         time.Duration
+	firstMismatch map[uint64]time.Time
+	lock          sync.Mutex
+}
+
+// Create a consensus check for a duty, e.g. "network balances"
+func newConsensusCheck(duty string, cfg *config.RocketPoolConfig, logger *log.ColorLogger) *consensusCheck {
+	return &consensusCheck{
+		duty:          duty,
+		cfg:           cfg,
+		log:           logger,
+		delay:         consensusMismatchDelay,
+		firstMismatch: map[uint64]time.Time{},
+	}
+}
+
+// Compare this node's values for a block with the other members' submissions for it, and decide whether to submit them.
+// Any disagreement is logged along with the breakdown of how this node got its values, and alerted.
+// If every member that already submitted disagrees, the submission is held off until the delay since the disagreement
+// was first seen has passed.
+func (c *consensusCheck) run(block uint64, ours []submissionField, others map[common.Address][]submissionField, breakdown []string) bool {
+	if len(others) == 0 {
+		c.log.Printlnf("No other Oracle DAO members have submitted %s for block %d yet.", c.duty, block)
+		return true
+	}
+
+	agreeing, mismatches := compareSubmissions(ours, others)
+	if len(mismatches) == 0 {
+		c.log.Printlnf("All %d Oracle DAO members that have submitted %s for block %d agree with this node.", agreeing, c.duty, block)
+		c.shouldSubmit(block, agreeing, mismatches, time.Now())
+		return true
+	}
+
+	// Log the differences
+	c.log.Printlnf("WARNING: %d of the %d Oracle DAO members that have submitted %s for block %d disagree with this node:", len(mismatches), len(others), c.duty, block)
+	for _, mismatch := range mismatches {
+		c.log.Printlnf("    %s:", mismatch.member.Hex())
+		for _, diff := range mismatch.diffs {
+			c.log.Printlnf("        %s", diff.String())
+		}
+	}
+	if len(breakdown) > 0 {
+		c.log.Println("    This node's values were calculated from:")
+		for _, line := range breakdown {
+			c.log.Printlnf("        %s", line)
+		}
+	}
+
+	submit, holdUntil := c.shouldSubmit(block, agreeing, mismatches, time.Now())
+	if err := alerting.AlertODaoSubmissionMismatch(c.cfg, c.duty, block, agreeing, len(mismatches), holdUntil, mismatches[0].Summary()); err != nil {
+		c.log.Printlnf("WARNING: couldn't send the submission mismatch alert: %s", err.Error())
+	}
+	if agreeing > 0 {
+		c.log.Printlnf("%d members agree with this node, so it will submit anyway.", agreeing)
+		return true
+	}
+	if !submit {
+		c.log.Printlnf("Holding off on submitting %s for block %d until %s so the disagreement can be investigated.", c.duty, block, holdUntil.Format(time.RFC822))
+		return false
+	}
+	c.log.Printlnf("The disagreement has lasted longer than %s, submitting this node's %s anyway.", c.delay, c.duty)
+	return true
+}
+
+// Decide whether to submit for a block given how many other members agree and disagree with this node.
+// When the submission is held off, the time it's held off until is returned too.
+func (c *consensusCheck) shouldSubmit(block uint64, agreeing int, mismatches []submissionMismatch, now time.Time) (bool, time.Time) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	// Forget disagreements on older blocks
+	for mismatchBlock := range c.firstMismatch {
+		if mismatchBlock < block {
+			delete(c.firstMismatch, mismatchBlock)
+		}
+	}
+
+	if len(mismatches) == 0 || agreeing > 0 {
+		delete(c.firstMismatch, block)
+		return true, time.Time{}
+	}
+
+	firstMismatch, exists := c.firstMismatch[block]
+	if !exists {
+		firstMismatch = now
+		c.firstMismatch[block] = now
+	}
+	holdUntil := firstMismatch.Add(c.delay)
+	return !now.Before(holdUntil), holdUntil
+}
+
+// Compare this node's values with each other member's, returning how many agree and how the others differ
+func compareSubmissions(ours []submissionField, others map[common.Address][]submissionField) (int, []submissionMismatch) {
+	agreeing := 0
+	mismatches := []submissionMismatch{}
+	for member, theirs := range others {
+		diffs := []submissionFieldDiff{}
+		for i, field := range ours {
+			if i >= len(theirs) || theirs[i].value == nil || field.value == nil {
+				continue
+			}
+			if field.value.Cmp(theirs[i].value) != 0 {
+				diffs = append(diffs, submissionFieldDiff{
+					name:   field.name,
+					kind:   field.kind,
+					ours:   field.value,
+					theirs: theirs[i].value,
+				})
+			}
+		}
+		if len(diffs) == 0 {
+			agreeing++
+			continue
+		}
+		mismatches = append(mismatches, submissionMismatch{
+			member: member,
+			diffs:  diffs,
+		})
+	}
+
+	// Keep the output stable for logging
+	sort.Slice(mismatches, func(i, j int) bool {
+		return mismatches[i].member.Cmp(mismatches[j].member) < 0
+	})
+	return agreeing, mismatches
+}
+
+// Describe the difference, e.g. "Total ETH: this node 100.000000000000, member 100.500000000000 (+500000000000000000 wei)"
+func (d submissionFieldDiff) String() string {
+	delta := new(big.Int).Sub(d.theirs, d.ours)
+	switch d.kind {
+	case submissionFieldEth:
+		return fmt.Sprintf("%s: this node %.12f, member %.12f (%+d wei)", d.name, math.WeiToEth(d.ours), math.WeiToEth(d.theirs), delta)
+	default:
+		return fmt.Sprintf("%s: this node %s, member %s (%+d)", d.name, d.ours.String(), d.theirs.String(), delta)
+	}
+}
+
+// Summarize the differences on a single line
+func (m submissionMismatch) Summary() string {
+	diffs := make([]string, len(m.diffs))
+	for i, diff := range m.diffs {
+		diffs[i] = diff.String()
+	}
+	return fmt.Sprintf("%s submitted %s", m.member.Hex(), strings.Join(diffs, "; "))
+}
@@ -0,0 +1,118 @@
+package watchtower
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestCompareSubmissions(t *testing.T) {
+	memberA := common.HexToAddress("0xAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA")
+	memberB := common.HexToAddress("0xBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBB")
+	memberC := common.HexToAddress("0xCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCC")
+
+	ours := getBalancesSubmissionFields(big.NewInt(1700000000), ethToWei(1100), ethToWei(1000), ethToWei(1000))
+	others := map[common.Address][]submissionField{
+		memberA: getBalancesSubmissionFields(big.NewInt(1700000000), ethToWei(1100), ethToWei(1000), ethToWei(1000)),
+		memberC: getBalancesSubmissionFields(big.NewInt(1700000000), ethToWei(1100), ethToWei(999), ethToWei(1000)),
+		memberB: getBalancesSubmissionFields(big.NewInt(1700000012), ethToWei(1210), ethToWei(1000), ethToWei(1100)),
+	}
+
+	agreeing, mismatches := compareSubmissions(ours, others)
+	if agreeing != 1 {
+		t.Errorf("expected 1 agreeing member, got %d", agreeing)
+	}
+	if len(mismatches) != 2 {
+		t.Fatalf("expected 2 mismatches, got %d", len(mismatches))
+	}
+
+	// Mismatches are sorted by member
+	if mismatches[0].member != memberB || mismatches[1].member != memberC {
+		t.Errorf("expected mismatches from %s then %s, got %s then %s", memberB.Hex(), memberC.Hex(), mismatches[0].member.Hex(), mismatches[1].member.Hex())
+	}
+
+	// Member B has a different timestamp, total and supply, but the same rETH rate
+	names := []string{}
+	for _, diff := range mismatches[0].diffs {
+		names = append(names, diff.name)
+	}
+	expected := []string{"Slot timestamp", "Total ETH", "rETH supply"}
+	if len(names) != len(expected) {
+		t.Fatalf("expected member B to differ on %v, got %v", expected, names)
+	}
+	for i := range expected {
+		if names[i] != expected[i] {
+			t.Errorf("expected member B to differ on %v, got %v", expected, names)
+			break
+		}
+	}
+
+	// Member C only differs on staking ETH
+	if len(mismatches[1].diffs) != 1 || mismatches[1].diffs[0].name != "Staking ETH" {
+		t.Errorf("expected member C to only differ on staking ETH, got %+v", mismatches[1].diffs)
+	}
+	if got := mismatches[1].diffs[0].String(); got != "Staking ETH: this node 1000.000000000000, member 999.000000000000 (-1000000000000000000 wei)" {
+		t.Errorf("unexpected diff description: %s", got)
+	}
+	if got := mismatches[0].diffs[0].String(); got != "Slot timestamp: this node 1700000000, member 1700000012 (+12)" {
+		t.Errorf("unexpected diff description: %s", got)
+	}
+}
+
+func TestBalancesSubmissionRethRate(t *testing.T) {
+	fields := getBalancesSubmissionFields(big.NewInt(0), ethToWei(1150), ethToWei(1000), ethToWei(1000))
+	rate := fields[len(fields)-1]
+	if rate.name != "rETH rate" {
+		t.Fatalf("expected the last field to be the rETH rate, got %s", rate.name)
+	}
+	if expected := big.NewInt(1150000000000000000); rate.value.Cmp(expected) != 0 {
+		t.Errorf("expected an rETH rate of %s, got %s", expected, rate.value)
+	}
+
+	// An empty supply doesn't divide by zero
+	fields = getBalancesSubmissionFields(big.NewInt(0), ethToWei(1), ethToWei(1), big.NewInt(0))
+	if fields[len(fields)-1].value.Sign() != 0 {
+		t.Errorf("expected a zero rETH rate with no supply, got %s", fields[len(fields)-1].value)
+	}
+}
+
+func TestConsensusCheckHoldOff(t *testing.T) {
+	check := newConsensusCheck("network balances", nil, nil)
+	mismatches := []submissionMismatch{{member: common.HexToAddress("0x01")}}
+	start := time.Unix(1700000000, 0)
+
+	// Nothing to hold off for when nobody disagrees, or when someone agrees
+	if submit, _ := check.shouldSubmit(100, 2, nil, start); !submit {
+		t.Error("expected to submit when every member agrees")
+	}
+	if submit, _ := check.shouldSubmit(100, 1, mismatches, start); !submit {
+		t.Error("expected to submit when another member agrees")
+	}
+
+	// Held off from the first time the disagreement is seen
+	submit, holdUntil := check.shouldSubmit(100, 0, mismatches, start)
+	if submit {
+		t.Error("expected to hold off when every member disagrees")
+	}
+	if !holdUntil.Equal(start.Add(consensusMismatchDelay)) {
+		t.Errorf("expected to hold off until %s, got %s", start.Add(consensusMismatchDelay), holdUntil)
+	}
+	submit, holdUntil = check.shouldSubmit(100, 0, mismatches, start.Add(consensusMismatchDelay/2))
+	if submit || !holdUntil.Equal(start.Add(consensusMismatchDelay)) {
+		t.Errorf("expected the hold to keep its original deadline, got submit=%t until %s", submit, holdUntil)
+	}
+	if submit, _ := check.shouldSubmit(100, 0, mismatches, start.Add(consensusMismatchDelay)); !submit {
+		t.Error("expected to submit once the delay has passed")
+	}
+
+	// A new block starts a new hold and forgets the old one
+	submit, holdUntil = check.shouldSubmit(200, 0, mismatches, start.Add(2*consensusMismatchDelay))
+	if submit || !holdUntil.Equal(start.Add(3*consensusMismatchDelay)) {
+		t.Errorf("expected a new hold for a new block, got submit=%t until %s", submit, holdUntil)
+	}
+	if _, exists := check.firstMismatch[100]; exists {
+		t.Error("expected the hold on the older block to be forgotten")
+	}
+}
@@ -167,6 +167,12 @@ func (t *dissolveInvalidCredentials) dissolveMegapoolValidator(validator megapoo
 		return
 	}
 
+	// Check the proofs against our own Beacon Node before submitting them
+	if err := services.VerifyValidatorProof(t.bc, validatorProof, slotProof); err != nil {
+		t.log.Printlnf("error verifying validator proof: %v", err)
+		return
+	}
+
 	// Get the gas limit
 	gasLimits, err := megapool.EstimateDissolveWithProof(t.rp, validator.MegapoolAddress, validator.ValidatorId, slotTimestamp, validatorProof, slotProof, opts)
 	if err != nil {
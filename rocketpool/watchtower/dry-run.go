@@ -0,0 +1,130 @@
+package watchtower
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	log "github.com/rocket-pool/smartnode/shared/logger"
+	"github.com/rocket-pool/smartnode/shared/math"
+)
+
+// The gas limit given to dry-run transactions so the bindings don't estimate gas, which reverts for nodes that aren't on the Oracle DAO
+const dryRunGasLimit uint64 = 30000000
+
+// A transaction the watchtower would have submitted if it weren't in dry-run mode
+type dryRunTransaction struct {
+	Time        time.Time      `json:"time"`
+	Task        string         `json:"task"`
+	Description string         `json:"description"`
+	From        common.Address `json:"from"`
+	To          common.Address `json:"to"`
+	Value       *big.Int       `json:"value"`
+	Data        hexutil.Bytes  `json:"data"`
+}
+
+// Records the transactions the watchtower's Oracle DAO duties would submit, without signing or sending them
+type dryRunRecorder struct {
+	from     common.Address
+	path     string
+	recorded map[common.Hash]bool
+	lock     sync.Mutex
+}
+
+// Create a recorder for transactions from the given address, saving them to the file at path
+func newDryRunRecorder(from common.Address, path string) *dryRunRecorder {
+	return &dryRunRecorder{
+		from:     from,
+		path:     path,
+		recorded: map[common.Hash]bool{},
+	}
+}
+
+// Run submit with a transactor that builds its transactions without signing or sending them, then log and save each one.
+// Transactions that were already recorded by this daemon are only logged, since duties are retried until consensus is reached.
+func (r *dryRunRecorder) record(logger *log.ColorLogger, task string, description string, submit func(opts *bind.TransactOpts) error) error {
+	txs := []*types.Transaction{}
+	opts := &bind.TransactOpts{
+		From:      r.from,
+		Nonce:     big.NewInt(0),
+		GasFeeCap: big.NewInt(0),
+		GasTipCap: big.NewInt(0),
+		GasLimit:  dryRunGasLimit,
+		Context:   context.Background(),
+		NoSend:    true,
+		Signer: func(_ common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			txs = append(txs, tx)
+			return tx, nil
+		},
+	}
+	if err := submit(opts); err != nil {
+		return fmt.Errorf("error building dry-run transaction for %s: %w", description, err)
+	}
+
+	for _, tx := range txs {
+		recordedTx := dryRunTransaction{
+			Time:        time.Now().UTC(),
+			Task:        task,
+			Description: description,
+			From:        r.from,
+			Value:       tx.Value(),
+			Data:        tx.Data(),
+		}
+		if tx.To() != nil {
+			recordedTx.To = *tx.To()
+		}
+
+		logger.Printlnf("[Dry Run] Would submit %s to %s with %.6f ETH", description, recordedTx.To.Hex(), math.RoundDown(math.WeiToEth(recordedTx.Value), 6))
+		logger.Printlnf("[Dry Run] Calldata: %s", recordedTx.Data.String())
+
+		isNew, err := r.save(recordedTx)
+		if err != nil {
+			return err
+		}
+		if !isNew {
+			logger.Println("[Dry Run] This transaction was already recorded.")
+		}
+	}
+	return nil
+}
+
+// Append a transaction to the dry-run file if it hasn't been saved already, returning whether it was new
+func (r *dryRunRecorder) save(tx dryRunTransaction) (bool, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	id := crypto.Keccak256Hash([]byte(tx.Task), tx.To.Bytes(), tx.Value.Bytes(), tx.Data)
+	if r.recorded[id] {
+		return false, nil
+	}
+
+	line, err := json.Marshal(tx)
+	if err != nil {
+		return false, fmt.Errorf("error serializing dry-run transaction: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return false, fmt.Errorf("error creating dry-run folder: %w", err)
+	}
+	file, err := os.OpenFile(r.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return false, fmt.Errorf("error opening dry-run file %s: %w", r.path, err)
+	}
+	defer file.Close()
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return false, fmt.Errorf("error writing dry-run file %s: %w", r.path, err)
+	}
+
+	r.recorded[id] = true
+	return true, nil
+}
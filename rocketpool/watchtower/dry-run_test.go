@@ -0,0 +1,120 @@
+package watchtower
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	log "github.com/rocket-pool/smartnode/shared/logger"
+)
+
+// Builds a transaction through the transactor the same way the bindings do with NoSend set
+func buildDryRunTx(opts *bind.TransactOpts, to common.Address, data []byte) error {
+	tx := types.NewTx(&types.DynamicFeeTx{
+		Nonce:     opts.Nonce.Uint64(),
+		GasTipCap: opts.GasTipCap,
+		GasFeeCap: opts.GasFeeCap,
+		Gas:       opts.GasLimit,
+		To:        &to,
+		Value:     big.NewInt(0),
+		Data:      data,
+	})
+	_, err := opts.Signer(opts.From, tx)
+	return err
+}
+
+func readDryRunFile(t *testing.T, path string) []dryRunTransaction {
+	t.Helper()
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("error opening dry-run file: %v", err)
+	}
+	defer file.Close()
+
+	txs := []dryRunTransaction{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var tx dryRunTransaction
+		if err := json.Unmarshal(scanner.Bytes(), &tx); err != nil {
+			t.Fatalf("error decoding dry-run line %q: %v", scanner.Text(), err)
+		}
+		txs = append(txs, tx)
+	}
+	return txs
+}
+
+func TestDryRunRecorder(t *testing.T) {
+	from := common.HexToAddress("0xAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA")
+	to := common.HexToAddress("0xBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBB")
+	path := filepath.Join(t.TempDir(), "watchtower", "dry-run.jsonl")
+	recorder := newDryRunRecorder(from, path)
+	logger := log.NewColorLogger(SubmitRplPriceColor)
+
+	// The transactor must never send, and must not need a gas estimate
+	err := recorder.record(&logger, "submit-rpl-price", "RPL price for block 100", func(opts *bind.TransactOpts) error {
+		if !opts.NoSend {
+			t.Error("expected the dry-run transactor to not send transactions")
+		}
+		if opts.GasLimit == 0 {
+			t.Error("expected the dry-run transactor to have a gas limit so the bindings skip estimation")
+		}
+		if opts.From != from {
+			t.Errorf("expected the transactor to be from %s, got %s", from.Hex(), opts.From.Hex())
+		}
+		return buildDryRunTx(opts, to, []byte{0x01, 0x02})
+	})
+	if err != nil {
+		t.Fatalf("unexpected error recording transaction: %v", err)
+	}
+
+	// Retried duties with the same values are only saved once
+	err = recorder.record(&logger, "submit-rpl-price", "RPL price for block 100", func(opts *bind.TransactOpts) error {
+		return buildDryRunTx(opts, to, []byte{0x01, 0x02})
+	})
+	if err != nil {
+		t.Fatalf("unexpected error recording repeated transaction: %v", err)
+	}
+	err = recorder.record(&logger, "submit-rpl-price", "RPL price for block 200", func(opts *bind.TransactOpts) error {
+		return buildDryRunTx(opts, to, []byte{0x03})
+	})
+	if err != nil {
+		t.Fatalf("unexpected error recording second transaction: %v", err)
+	}
+
+	txs := readDryRunFile(t, path)
+	if len(txs) != 2 {
+		t.Fatalf("expected 2 recorded transactions, got %d", len(txs))
+	}
+	if txs[0].Task != "submit-rpl-price" || txs[0].Description != "RPL price for block 100" {
+		t.Errorf("unexpected task info on the first transaction: %+v", txs[0])
+	}
+	if txs[0].From != from || txs[0].To != to {
+		t.Errorf("expected the first transaction to go from %s to %s, got %s to %s", from.Hex(), to.Hex(), txs[0].From.Hex(), txs[0].To.Hex())
+	}
+	if txs[0].Data.String() != "0x0102" || txs[1].Data.String() != "0x03" {
+		t.Errorf("unexpected calldata: %s, %s", txs[0].Data, txs[1].Data)
+	}
+	if txs[0].Value == nil || txs[0].Value.Sign() != 0 {
+		t.Errorf("expected a zero value, got %v", txs[0].Value)
+	}
+
+	// Errors from building the transaction are passed through and nothing is saved
+	buildErr := errors.New("invalid arguments")
+	err = recorder.record(&logger, "respond-challenges", "challenge response", func(opts *bind.TransactOpts) error {
+		return buildErr
+	})
+	if !errors.Is(err, buildErr) {
+		t.Errorf("expected the build error to be returned, got %v", err)
+	}
+	if txs := readDryRunFile(t, path); len(txs) != 2 {
+		t.Errorf("expected a failed build to not be saved, got %d transactions", len(txs))
+	}
+}
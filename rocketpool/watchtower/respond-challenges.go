@@ -3,6 +3,7 @@ package watchtower
 import (
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/urfave/cli/v3"
 
 	"github.com/rocket-pool/smartnode/bindings/dao/trustednode"
@@ -20,16 +21,17 @@ import (
 
 // Respond to challenges task
 type respondChallenges struct {
-	c   *cli.Command
-	log log.ColorLogger
-	cfg *config.RocketPoolConfig
-	w   wallet.Wallet
-	rp  *rocketpool.RocketPool
-	m   *state.NetworkStateManager
+	c      *cli.Command
+	log    log.ColorLogger
+	cfg    *config.RocketPoolConfig
+	w      wallet.Wallet
+	rp     *rocketpool.RocketPool
+	m      *state.NetworkStateManager
+	dryRun *dryRunRecorder
 }
 
 // Create respond to challenges task
-func newRespondChallenges(c *cli.Command, logger log.ColorLogger, m *state.NetworkStateManager) (*respondChallenges, error) {
+func newRespondChallenges(c *cli.Command, logger log.ColorLogger, m *state.NetworkStateManager, dryRun *dryRunRecorder) (*respondChallenges, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -47,12 +49,13 @@ func newRespondChallenges(c *cli.Command, logger log.ColorLogger, m *state.Netwo
 
 	// Return task
 	return &respondChallenges{
-		c:   c,
-		log: logger,
-		cfg: cfg,
-		w:   w,
-		rp:  rp,
-		m:   m,
+		c:      c,
+		log:    logger,
+		cfg:    cfg,
+		w:      w,
+		rp:     rp,
+		m:      m,
+		dryRun: dryRun,
 	}, nil
 
 }
@@ -86,6 +89,14 @@ func (t *respondChallenges) run() error {
 	// Log
 	t.log.Printlnf("Node %s has an active challenge against it, responding...", nodeAccount.Address.Hex())
 
+	// Record the response instead of submitting it in dry-run mode
+	if t.dryRun != nil {
+		return t.dryRun.record(&t.log, "respond-challenges", fmt.Sprintf("challenge response for node %s", nodeAccount.Address.Hex()), func(opts *bind.TransactOpts) error {
+			_, err := trustednode.DecideChallenge(t.rp, nodeAccount.Address, opts)
+			return err
+		})
+	}
+
 	// Get transactor
 	opts, err := t.w.GetNodeAccountTransactor()
 	if err != nil {
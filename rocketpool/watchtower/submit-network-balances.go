@@ -70,6 +70,8 @@ type submitNetworkBalances struct {
 	storage   storageGetter
 	lock      *sync.Mutex
 	isRunning bool
+	dryRun    *dryRunRecorder
+	consensus *consensusCheck
 }
 
 // liveRewardSplitCalculator calls the on-chain megapool contract.
@@ -156,7 +158,7 @@ type megapoolBalanceDetail struct {
 }
 
 // Create submit network balances task
-func newSubmitNetworkBalances(c *cli.Command, logger log.ColorLogger, errorLogger log.ColorLogger) (*submitNetworkBalances, error) {
+func newSubmitNetworkBalances(c *cli.Command, logger log.ColorLogger, errorLogger log.ColorLogger, dryRun *dryRunRecorder) (*submitNetworkBalances, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -194,6 +196,8 @@ func newSubmitNetworkBalances(c *cli.Command, logger log.ColorLogger, errorLogge
 		storage:   rp.RocketStorage,
 		lock:      lock,
 		isRunning: false,
+		dryRun:    dryRun,
+		consensus: newConsensusCheck("network balances", cfg, &logger),
 	}, nil
 
 }
@@ -333,6 +337,19 @@ func (t *submitNetworkBalances) run(state *state.NetworkState) error {
 			t.log.Printlnf("Have previously submitted out-of-date balances for block %d, trying again...", targetBlockNumber)
 		}
 
+		// Cross-check the balances against the ones the other members already submitted for this block
+		submit, err := t.checkConsensus(nodeAccount.Address, balances)
+		if err != nil {
+			t.handleError(fmt.Errorf("%s couldn't cross-check balances with the other Oracle DAO members: %w", logPrefix, err))
+			return
+		}
+		if !submit {
+			t.lock.Lock()
+			t.isRunning = false
+			t.lock.Unlock()
+			return
+		}
+
 		// Submit balances
 		if err := t.submitBalances(balances); err != nil {
 			t.handleError(fmt.Errorf("%s could not submit network balances: %w", logPrefix, err))
@@ -427,6 +444,62 @@ func (t *submitNetworkBalances) hasSubmittedSpecificBlockBalances(nodeAddress co
 
 }
 
+// Compare the balances with the ones the other members already submitted for the same block, returning whether to submit them
+func (t *submitNetworkBalances) checkConsensus(nodeAddress common.Address, balances networkBalances) (bool, error) {
+	eventLogInterval, err := t.cfg.GetEventLogInterval()
+	if err != nil {
+		return false, err
+	}
+	reports, err := network.GetBalancesSubmittedEvents(t.rp, balances.Block, nil, big.NewInt(int64(eventLogInterval)), nil)
+	if err != nil {
+		return false, fmt.Errorf("error getting balances submissions for block %d: %w", balances.Block, err)
+	}
+
+	// Later submissions from a member replace its earlier ones
+	others := map[common.Address][]submissionField{}
+	for _, report := range reports {
+		if report.Block != balances.Block || report.Member == nodeAddress {
+			continue
+		}
+		others[report.Member] = getBalancesSubmissionFields(report.SlotTimestamp, report.TotalEth, report.StakingEth, report.RethSupply)
+	}
+	ours := getBalancesSubmissionFields(new(big.Int).SetUint64(balances.SlotTimestamp), balances.ClampedTotalBalanceWei, balances.TotalStaking, balances.RETHSupply)
+	return t.consensus.run(balances.Block, ours, others, getBalancesBreakdown(balances)), nil
+}
+
+// Describe the components this node added up for its total ETH, so a disagreement can be traced back to one of them
+func getBalancesBreakdown(balances networkBalances) []string {
+	return []string{
+		fmt.Sprintf("Deposit pool balance: %.12f", math.WeiToEth(balances.DepositPool)),
+		fmt.Sprintf("Node credit balance (subtracted): %.12f", math.WeiToEth(balances.NodeCreditBalance)),
+		fmt.Sprintf("Total minipool user balance: %.12f", math.WeiToEth(balances.MinipoolsTotal)),
+		fmt.Sprintf("Staking minipool user balance: %.12f", math.WeiToEth(balances.MinipoolsStaking)),
+		fmt.Sprintf("Fee distributor user balance: %.12f", math.WeiToEth(balances.DistributorShareTotal)),
+		fmt.Sprintf("Total megapool user balance: %.12f", math.WeiToEth(balances.MegapoolsUserShareTotal)),
+		fmt.Sprintf("Staking megapool user balance: %.12f", math.WeiToEth(balances.MegapoolStaking)),
+		fmt.Sprintf("Smoothing pool user balance: %.12f", math.WeiToEth(balances.SmoothingPoolShare)),
+		fmt.Sprintf("rETH contract balance: %.12f", math.WeiToEth(balances.RETHContract)),
+		fmt.Sprintf("rETH token supply: %.12f", math.WeiToEth(balances.RETHSupply)),
+		fmt.Sprintf("Total ETH before clamping to the max rETH delta: %.12f", math.WeiToEth(balances.OriginalTotalBalanceWei)),
+	}
+}
+
+// Get the values of a balances submission to compare between members, including the rETH rate they imply
+func getBalancesSubmissionFields(slotTimestamp *big.Int, totalEth *big.Int, stakingEth *big.Int, rethSupply *big.Int) []submissionField {
+	rethRate := big.NewInt(0)
+	if totalEth != nil && rethSupply != nil && rethSupply.Sign() > 0 {
+		rethRate.Mul(totalEth, big.NewInt(1e18))
+		rethRate.Div(rethRate, rethSupply)
+	}
+	return []submissionField{
+		{name: "Slot timestamp", kind: submissionFieldPlain, value: slotTimestamp},
+		{name: "Total ETH", kind: submissionFieldEth, value: totalEth},
+		{name: "Staking ETH", kind: submissionFieldEth, value: stakingEth},
+		{name: "rETH supply", kind: submissionFieldEth, value: rethSupply},
+		{name: "rETH rate", kind: submissionFieldEth, value: rethRate},
+	}
+}
+
 // Prints a message to the log
 func (t *submitNetworkBalances) printMessage(message string) {
 	t.log.Println(message)
@@ -769,6 +842,14 @@ func (t *submitNetworkBalances) submitBalances(balances networkBalances) error {
 	// Log
 	t.log.Printlnf("Submitting network balances for block %d...", balances.Block)
 
+	// Record the submission instead of sending it in dry-run mode
+	if t.dryRun != nil {
+		return t.dryRun.record(t.log, "submit-network-balances", fmt.Sprintf("network balances for block %d", balances.Block), func(opts *bind.TransactOpts) error {
+			_, err := network.SubmitBalances(t.rp, balances.Block, balances.SlotTimestamp, balances.ClampedTotalBalanceWei, balances.TotalStaking, balances.RETHSupply, opts)
+			return err
+		})
+	}
+
 	// Get transactor
 	opts, err := t.w.GetNodeAccountTransactor()
 	if err != nil {
@@ -507,6 +507,9 @@ func (s *stubBeaconClient) GetValidatorIndex(pubkey rptypes.ValidatorPubkey) (st
 func (s *stubBeaconClient) GetValidatorSyncDuties(indices []string, epoch uint64) (map[string]bool, error) {
 	return nil, nil
 }
+func (s *stubBeaconClient) GetValidatorSyncCommitteeIndices(indices []string, epoch uint64) (map[string][]uint64, error) {
+	return nil, nil
+}
 func (s *stubBeaconClient) GetValidatorProposerDuties(indices []string, epoch uint64) (map[string]uint64, error) {
 	return nil, nil
 }
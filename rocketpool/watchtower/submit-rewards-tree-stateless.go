@@ -48,10 +48,11 @@ type submitRewardsTree_Stateless struct {
 	isRunning        bool
 	generationPrefix string
 	m                *state.NetworkStateManager
+	dryRun           *dryRunRecorder
 }
 
 // Create submit rewards Merkle Tree task
-func newSubmitRewardsTree_Stateless(c *cli.Command, logger log.ColorLogger, errorLogger log.ColorLogger, m *state.NetworkStateManager) (*submitRewardsTree_Stateless, error) {
+func newSubmitRewardsTree_Stateless(c *cli.Command, logger log.ColorLogger, errorLogger log.ColorLogger, m *state.NetworkStateManager, dryRun *dryRunRecorder) (*submitRewardsTree_Stateless, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -89,6 +90,7 @@ func newSubmitRewardsTree_Stateless(c *cli.Command, logger log.ColorLogger, erro
 		isRunning:        false,
 		generationPrefix: "[Merkle Tree]",
 		m:                m,
+		dryRun:           dryRun,
 	}
 
 	return generator, nil
@@ -420,6 +422,15 @@ func (t *submitRewardsTree_Stateless) submitRewardsSnapshot(index *big.Int, cons
 		return false, nil
 	}
 
+	// Record the submission instead of sending it in dry-run mode
+	if t.dryRun != nil {
+		err = t.dryRun.record(t.log, "submit-rewards-tree", fmt.Sprintf("rewards snapshot for interval %s", index.String()), func(opts *bind.TransactOpts) error {
+			_, err := rewards.SubmitRewardSnapshot(t.rp, submission, opts)
+			return err
+		})
+		return false, err
+	}
+
 	// Get the gas limit
 	gasLimits, err = rewards.EstimateSubmitRewardSnapshotGas(t.rp, submission, opts)
 	if err != nil {
@@ -293,10 +293,12 @@ type submitRplPrice struct {
 	bc        beacon.Client
 	lock      *sync.Mutex
 	isRunning bool
+	dryRun    *dryRunRecorder
+	consensus *consensusCheck
 }
 
 // Create submit RPL price task
-func newSubmitRplPrice(c *cli.Command, logger log.ColorLogger, errorLogger log.ColorLogger) (*submitRplPrice, error) {
+func newSubmitRplPrice(c *cli.Command, logger log.ColorLogger, errorLogger log.ColorLogger, dryRun *dryRunRecorder) (*submitRplPrice, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -323,15 +325,17 @@ func newSubmitRplPrice(c *cli.Command, logger log.ColorLogger, errorLogger log.C
 	// Return task
 	lock := &sync.Mutex{}
 	return &submitRplPrice{
-		c:      c,
-		log:    &logger,
-		errLog: &errorLogger,
-		cfg:    cfg,
-		ec:     ec,
-		w:      w,
-		rp:     rp,
-		bc:     bc,
-		lock:   lock,
+		c:         c,
+		log:       &logger,
+		errLog:    &errorLogger,
+		cfg:       cfg,
+		ec:        ec,
+		w:         w,
+		rp:        rp,
+		bc:        bc,
+		lock:      lock,
+		dryRun:    dryRun,
+		consensus: newConsensusCheck("RPL price", cfg, &logger),
 	}, nil
 
 }
@@ -355,53 +359,9 @@ func (t *submitRplPrice) run(state *state.NetworkState) error {
 		return nil
 	}
 
-	// Check if Optimism rate is stale and submit
-	err = t.submitOptimismPrice()
-	if err != nil {
-		// Error is not fatal for this task so print and continue
-		t.log.Printlnf("Error submitting Optimism price: %s", err.Error())
-	}
-
-	// Check if Polygon rate is stale and submit
-	err = t.submitPolygonPrice()
-	if err != nil {
-		// Error is not fatal for this task so print and continue
-		t.log.Printlnf("Error submitting Polygon price: %s", err.Error())
-	}
-
-	// Check if Arbitrum rate is stale and submit. This messenger will be deprecated soon.
-	err = t.submitArbitrumPrice(t.cfg.Smartnode.GetArbitrumMessengerAddress())
-	if err != nil {
-		// Error is not fatal for this task so print and continue
-		t.log.Printlnf("Error submitting Arbitrum V1 price: %s", err.Error())
-	}
-
-	// Temporarily submit to both Arbitrum messengers until the first one sunsets
-	err = t.submitArbitrumPrice(t.cfg.Smartnode.GetArbitrumMessengerAddressV2())
-	if err != nil {
-		// Error is not fatal for this task so print and continue
-		t.log.Printlnf("Error submitting Arbitrum price to messenger v2: %s", err.Error())
-	}
-
-	// Check if zkSync rate is stale and submit
-	err = t.submitZkSyncEraPrice()
-	if err != nil {
-		// Error is not fatal for this task so print and continue
-		t.log.Printlnf("Error submitting zkSync Era price: %s", err.Error())
-	}
-
-	// Check if Base rate is stale and submit
-	err = t.submitBasePrice()
-	if err != nil {
-		// Error is not fatal for this task so print and continue
-		t.log.Printlnf("Error submitting Base price: %s", err.Error())
-	}
-
-	// Check if Scroll rate is stale and submit
-	err = t.submitScrollPrice()
-	if err != nil {
-		// Error is not fatal for this task so print and continue
-		t.log.Printlnf("Error submitting Scroll price: %s", err.Error())
+	// Relay the rates to the L2s; these aren't part of the price consensus, so they're skipped in dry-run mode
+	if t.dryRun == nil {
+		t.submitL2Prices()
 	}
 
 	// Log
@@ -529,6 +489,19 @@ func (t *submitRplPrice) run(state *state.NetworkState) error {
 			t.log.Printlnf("Have previously submitted out-of-date prices for block %d, trying again...", targetBlockNumber)
 		}
 
+		// Cross-check the price against the ones the other members already submitted for this block
+		submit, err := t.checkConsensus(nodeAccount.Address, targetBlockNumber, submissionTimestamp, rplPrice)
+		if err != nil {
+			t.handleError(fmt.Errorf("%s couldn't cross-check the RPL price with the other Oracle DAO members: %w", logPrefix, err))
+			return
+		}
+		if !submit {
+			t.lock.Lock()
+			t.isRunning = false
+			t.lock.Unlock()
+			return
+		}
+
 		// Log
 		t.log.Println("Submitting RPL price...")
 
@@ -550,6 +523,91 @@ func (t *submitRplPrice) run(state *state.NetworkState) error {
 
 }
 
+// Submit the RPL price to each L2 whose rate is stale; errors aren't fatal to the price report so they're only logged
+func (t *submitRplPrice) submitL2Prices() {
+
+	// Check if Optimism rate is stale and submit
+	err := t.submitOptimismPrice()
+	if err != nil {
+		// Error is not fatal for this task so print and continue
+		t.log.Printlnf("Error submitting Optimism price: %s", err.Error())
+	}
+
+	// Check if Polygon rate is stale and submit
+	err = t.submitPolygonPrice()
+	if err != nil {
+		// Error is not fatal for this task so print and continue
+		t.log.Printlnf("Error submitting Polygon price: %s", err.Error())
+	}
+
+	// Check if Arbitrum rate is stale and submit. This messenger will be deprecated soon.
+	err = t.submitArbitrumPrice(t.cfg.Smartnode.GetArbitrumMessengerAddress())
+	if err != nil {
+		// Error is not fatal for this task so print and continue
+		t.log.Printlnf("Error submitting Arbitrum V1 price: %s", err.Error())
+	}
+
+	// Temporarily submit to both Arbitrum messengers until the first one sunsets
+	err = t.submitArbitrumPrice(t.cfg.Smartnode.GetArbitrumMessengerAddressV2())
+	if err != nil {
+		// Error is not fatal for this task so print and continue
+		t.log.Printlnf("Error submitting Arbitrum price to messenger v2: %s", err.Error())
+	}
+
+	// Check if zkSync rate is stale and submit
+	err = t.submitZkSyncEraPrice()
+	if err != nil {
+		// Error is not fatal for this task so print and continue
+		t.log.Printlnf("Error submitting zkSync Era price: %s", err.Error())
+	}
+
+	// Check if Base rate is stale and submit
+	err = t.submitBasePrice()
+	if err != nil {
+		// Error is not fatal for this task so print and continue
+		t.log.Printlnf("Error submitting Base price: %s", err.Error())
+	}
+
+	// Check if Scroll rate is stale and submit
+	err = t.submitScrollPrice()
+	if err != nil {
+		// Error is not fatal for this task so print and continue
+		t.log.Printlnf("Error submitting Scroll price: %s", err.Error())
+	}
+
+}
+
+// Compare the RPL price with the ones the other members already submitted for the same block, returning whether to submit it
+func (t *submitRplPrice) checkConsensus(nodeAddress common.Address, blockNumber uint64, slotTimestamp uint64, rplPrice *big.Int) (bool, error) {
+	eventLogInterval, err := t.cfg.GetEventLogInterval()
+	if err != nil {
+		return false, err
+	}
+	reports, err := network.GetPricesSubmittedEvents(t.rp, blockNumber, nil, big.NewInt(int64(eventLogInterval)), nil)
+	if err != nil {
+		return false, fmt.Errorf("error getting price submissions for block %d: %w", blockNumber, err)
+	}
+
+	// Later submissions from a member replace its earlier ones
+	others := map[common.Address][]submissionField{}
+	for _, report := range reports {
+		if report.Block != blockNumber || report.Member == nodeAddress {
+			continue
+		}
+		others[report.Member] = getPricesSubmissionFields(report.SlotTimestamp, report.RplPrice)
+	}
+	ours := getPricesSubmissionFields(new(big.Int).SetUint64(slotTimestamp), rplPrice)
+	return t.consensus.run(blockNumber, ours, others, nil), nil
+}
+
+// Get the values of a prices submission to compare between members
+func getPricesSubmissionFields(slotTimestamp *big.Int, rplPrice *big.Int) []submissionField {
+	return []submissionField{
+		{name: "Slot timestamp", kind: submissionFieldPlain, value: slotTimestamp},
+		{name: "RPL price", kind: submissionFieldEth, value: rplPrice},
+	}
+}
+
 func (t *submitRplPrice) handleError(err error) {
 	t.errLog.Println(err)
 	t.errLog.Println("*** Price report failed. ***")
@@ -661,6 +719,14 @@ func (t *submitRplPrice) submitRplPrice(blockNumber uint64, slotTimestamp uint64
 	// Log
 	t.log.Printlnf("Submitting RPL price for block %d...", blockNumber)
 
+	// Record the submission instead of sending it in dry-run mode
+	if t.dryRun != nil {
+		return t.dryRun.record(t.log, "submit-rpl-price", fmt.Sprintf("RPL price for block %d", blockNumber), func(opts *bind.TransactOpts) error {
+			_, err := network.SubmitPrices(t.rp, blockNumber, slotTimestamp, rplPrice, opts)
+			return err
+		})
+	}
+
 	// Get transactor
 	opts, err := t.w.GetNodeAccountTransactor()
 	if err != nil {
@@ -54,6 +54,7 @@ type submitScrubMinipools struct {
 	coll      *collectors.ScrubCollector
 	lock      *sync.Mutex
 	isRunning bool
+	dryRun    *dryRunRecorder
 }
 
 type iterationData struct {
@@ -85,7 +86,7 @@ type minipoolDetails struct {
 }
 
 // Create submit scrub minipools task
-func newSubmitScrubMinipools(c *cli.Command, logger log.ColorLogger, errorLogger log.ColorLogger, coll *collectors.ScrubCollector) (*submitScrubMinipools, error) {
+func newSubmitScrubMinipools(c *cli.Command, logger log.ColorLogger, errorLogger log.ColorLogger, coll *collectors.ScrubCollector, dryRun *dryRunRecorder) (*submitScrubMinipools, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -123,6 +124,7 @@ func newSubmitScrubMinipools(c *cli.Command, logger log.ColorLogger, errorLogger
 		coll:      coll,
 		lock:      lock,
 		isRunning: false,
+		dryRun:    dryRun,
 	}, nil
 
 }
@@ -565,6 +567,14 @@ func (t *submitScrubMinipools) submitVoteScrubMinipool(mp minipool.Minipool) err
 	// Log
 	t.log.Printlnf("Voting to scrub minipool %s...", mp.GetAddress().Hex())
 
+	// Record the vote instead of submitting it in dry-run mode
+	if t.dryRun != nil {
+		return t.dryRun.record(&t.log, "submit-scrub-minipools", fmt.Sprintf("scrub vote for minipool %s", mp.GetAddress().Hex()), func(opts *bind.TransactOpts) error {
+			_, err := mp.VoteScrub(opts)
+			return err
+		})
+	}
+
 	// Get transactor
 	opts, err := t.w.GetNodeAccountTransactor()
 	if err != nil {
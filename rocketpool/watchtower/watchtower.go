@@ -50,6 +50,7 @@ const (
 	SubmitRewardsTreeColor          = color.FgHiCyan
 	WarningColor                    = color.FgYellow
 	ObserveWarningColor             = color.FgHiRed
+	DryRunWarningColor              = color.FgHiRed
 	ProcessPenaltiesColor           = color.FgHiMagenta
 	CancelBondsColor                = color.FgGreen
 	CheckSoloMigrationsColor        = color.FgCyan
@@ -161,6 +162,7 @@ func run(c *cli.Command) error {
 	errorLog := log.NewColorLogger(ErrorColor)
 	updateLog := log.NewColorLogger(UpdateColor)
 	observeLog := log.NewColorLogger(ObserveWarningColor)
+	dryRunLog := log.NewColorLogger(DryRunWarningColor)
 
 	// Create the state manager
 	m := state.NewNetworkStateManager(rp, cfg.Smartnode.GetStateManagerContracts(), bc, &updateLog)
@@ -171,16 +173,22 @@ func run(c *cli.Command) error {
 		return fmt.Errorf("error getting node account: %w", err)
 	}
 
+	// In dry-run mode, the Oracle DAO duties record their transactions instead of submitting them
+	var dryRun *dryRunRecorder
+	if cfg.Smartnode.WatchtowerDryRun.Value.(bool) {
+		dryRun = newDryRunRecorder(nodeAccount.Address, cfg.Smartnode.GetWatchtowerDryRunPath())
+	}
+
 	// Initialize tasks
-	respondChallenges, err := newRespondChallenges(c, log.NewColorLogger(RespondChallengesColor), m)
+	respondChallenges, err := newRespondChallenges(c, log.NewColorLogger(RespondChallengesColor), m, dryRun)
 	if err != nil {
 		return fmt.Errorf("error during respond-to-challenges check: %w", err)
 	}
-	submitRplPrice, err := newSubmitRplPrice(c, log.NewColorLogger(SubmitRplPriceColor), errorLog)
+	submitRplPrice, err := newSubmitRplPrice(c, log.NewColorLogger(SubmitRplPriceColor), errorLog, dryRun)
 	if err != nil {
 		return fmt.Errorf("error during rpl price check: %w", err)
 	}
-	submitNetworkBalances, err := newSubmitNetworkBalances(c, log.NewColorLogger(SubmitNetworkBalancesColor), errorLog)
+	submitNetworkBalances, err := newSubmitNetworkBalances(c, log.NewColorLogger(SubmitNetworkBalancesColor), errorLog, dryRun)
 	if err != nil {
 		return fmt.Errorf("error during network balances check: %w", err)
 	}
@@ -200,12 +208,12 @@ func run(c *cli.Command) error {
 	if err != nil {
 		return fmt.Errorf("error during invalid credentials check: %w", err)
 	}
-	submitScrubMinipools, err := newSubmitScrubMinipools(c, log.NewColorLogger(SubmitScrubMinipoolsColor), errorLog, scrubCollector)
+	submitScrubMinipools, err := newSubmitScrubMinipools(c, log.NewColorLogger(SubmitScrubMinipoolsColor), errorLog, scrubCollector, dryRun)
 	if err != nil {
 		return fmt.Errorf("error during scrub check: %w", err)
 	}
 	var submitRewardsTree_Stateless *submitRewardsTree_Stateless
-	submitRewardsTree_Stateless, err = newSubmitRewardsTree_Stateless(c, log.NewColorLogger(SubmitRewardsTreeColor), errorLog, m)
+	submitRewardsTree_Stateless, err = newSubmitRewardsTree_Stateless(c, log.NewColorLogger(SubmitRewardsTreeColor), errorLog, m, dryRun)
 	if err != nil {
 		return fmt.Errorf("error during stateless rewards tree check: %w", err)
 	}
@@ -292,13 +300,57 @@ func run(c *cli.Command) error {
 				}
 			}
 
+			// Keep the dry-run warning visible in the logs as well
+			if dryRun != nil {
+				dryRunLog.Println("Watchtower daemon is in dry-run mode; only the Oracle DAO duties will run.")
+				dryRunLog.Println("Their transactions will be recorded to " + dryRun.path + " instead of being submitted.")
+				dryRunLog.Println("Disable Watchtower Dry Run in the Smart Node settings and restart the watchtower daemon when you have finished testing.")
+			}
+
 			// Run the manual rewards tree generation
 			if err := generateRewardsTree.run(); err != nil {
 				errorLog.Println(err)
 			}
 			time.Sleep(taskCooldown)
 
-			if isOnOdao {
+			if dryRun != nil {
+				// Only run the duties that record their transactions, whether or not the node is on the Oracle DAO
+				if err := respondChallenges.run(); err != nil {
+					errorLog.Println(err)
+				}
+				time.Sleep(taskCooldown)
+
+				// Update the network state
+				state, err := updateNetworkState(m, &updateLog, latestBlock)
+				if err != nil {
+					errorLog.Println(err)
+					time.Sleep(taskCooldown)
+					continue
+				}
+
+				// Run the network balance submission check
+				if err := submitNetworkBalances.run(state); err != nil {
+					errorLog.Println(err)
+				}
+				time.Sleep(taskCooldown)
+
+				// Run the rewards tree submission check
+				if err := submitRewardsTree_Stateless.Run(true, state, latestBlock.Slot); err != nil {
+					errorLog.Println(err)
+				}
+				time.Sleep(taskCooldown)
+
+				// Run the price submission check
+				if err := submitRplPrice.run(state); err != nil {
+					errorLog.Println(err)
+				}
+				time.Sleep(taskCooldown)
+
+				// Run the minipool scrub check
+				if err := submitScrubMinipools.run(state); err != nil {
+					errorLog.Println(err)
+				}
+			} else if isOnOdao {
 				// Run the challenge check
 				if err := respondChallenges.run(); err != nil {
 					errorLog.Println(err)
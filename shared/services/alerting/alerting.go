@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"maps"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -281,6 +282,194 @@ func AlertObserveModeActive(cfg *config.RocketPoolConfig, observedAddress common
 	return sendAlert(alert, cfg)
 }
 
+// Sends an alert when a node on the watch list has slashed validators.
+// If alerting/metrics are disabled, this function does nothing.
+func AlertWatchedNodeValidatorsSlashed(cfg *config.RocketPoolConfig, nodeAddress common.Address, slashedCount int) error {
+	if !isAlertingEnabled(cfg) {
+		logMessage("alerting is disabled, not sending AlertWatchedNodeValidatorsSlashed.")
+		return nil
+	}
+
+	if cfg.Alertmanager.AlertEnabled_WatchedNodeIssues.Value != true {
+		logMessage("alert for WatchedNodeIssues is disabled, not sending.")
+		return nil
+	}
+
+	alert := createAlert(
+		fmt.Sprintf("WatchedNodeValidatorsSlashed-%s", nodeAddress.Hex()),
+		"Watched node has slashed validators",
+		fmt.Sprintf("Watched node %s has %d slashed validator(s).", nodeAddress.Hex(), slashedCount),
+		SeverityCritical,
+		strfmt.DateTime(time.Now().Add(DefaultEndsAtDurationForSeverityCritical)),
+		map[string]string{"node": nodeAddress.Hex()},
+	)
+	return sendAlert(alert, cfg)
+}
+
+// Sends an alert when the megapool of a node on the watch list has debt.
+// If alerting/metrics are disabled, this function does nothing.
+func AlertWatchedNodeMegapoolDebt(cfg *config.RocketPoolConfig, nodeAddress common.Address, debtEth float64) error {
+	if !isAlertingEnabled(cfg) {
+		logMessage("alerting is disabled, not sending AlertWatchedNodeMegapoolDebt.")
+		return nil
+	}
+
+	if cfg.Alertmanager.AlertEnabled_WatchedNodeIssues.Value != true {
+		logMessage("alert for WatchedNodeIssues is disabled, not sending.")
+		return nil
+	}
+
+	alert := createAlert(
+		fmt.Sprintf("WatchedNodeMegapoolDebt-%s", nodeAddress.Hex()),
+		"Watched node's megapool has debt",
+		fmt.Sprintf("The megapool of watched node %s has %.6f ETH of debt.", nodeAddress.Hex(), debtEth),
+		SeverityWarning,
+		strfmt.DateTime(time.Now().Add(DefaultEndsAtDurationForSeverityInfo)),
+		map[string]string{"node": nodeAddress.Hex()},
+	)
+	return sendAlert(alert, cfg)
+}
+
+type DutyKind string
+
+const (
+	DutyKindAttestation   DutyKind = "Attestation"
+	DutyKindProposal      DutyKind = "Proposal"
+	DutyKindSyncCommittee DutyKind = "SyncCommittee"
+)
+
+// Sends an alert when one of the node's validators missed more duties in the recently finalized epochs than the configured thresholds allow.
+// If alerting/metrics are disabled, this function does nothing.
+func AlertValidatorMissedDuties(cfg *config.RocketPoolConfig, validator string, duty DutyKind, missed uint64, epochs uint64) error {
+	if !isAlertingEnabled(cfg) {
+		logMessage("alerting is disabled, not sending AlertValidatorMissedDuties.")
+		return nil
+	}
+
+	if cfg.Alertmanager.AlertEnabled_MissedDuties.Value != true {
+		logMessage("alert for MissedDuties is disabled, not sending.")
+		return nil
+	}
+
+	dutyName := "attestations"
+	severity := SeverityWarning
+	switch duty {
+	case DutyKindProposal:
+		dutyName = "block proposals"
+		severity = SeverityCritical
+	case DutyKindSyncCommittee:
+		dutyName = "sync committee signatures"
+	}
+
+	alert := createAlert(
+		fmt.Sprintf("ValidatorMissed%s-%s", duty, validator),
+		fmt.Sprintf("Validator %s is missing %s", validator, dutyName),
+		fmt.Sprintf("Validator %s missed %d %s in the last %d finalized epochs. Check that your validator client is online and synced.", validator, missed, dutyName, epochs),
+		severity,
+		strfmt.DateTime(time.Now().Add(DefaultEndsAtDurationForSeverityCritical)),
+		map[string]string{
+			"validator": validator,
+			"duty":      string(duty),
+		},
+	)
+	return sendAlert(alert, cfg)
+}
+
+// Sends an alert when voting on a governance proposal the node hasn't voted on closes soon.
+// If alerting/metrics are disabled, this function does nothing.
+func AlertGovernanceVoteClosing(cfg *config.RocketPoolConfig, dao string, proposalID string, title string, deadline time.Time) error {
+	if !isAlertingEnabled(cfg) {
+		logMessage("alerting is disabled, not sending AlertGovernanceVoteClosing.")
+		return nil
+	}
+
+	if cfg.Alertmanager.AlertEnabled_GovernanceDeadlines.Value != true {
+		logMessage("alert for GovernanceDeadlines is disabled, not sending.")
+		return nil
+	}
+
+	alert := createAlert(
+		fmt.Sprintf("GovernanceVoteClosing-%s-%s", dao, proposalID),
+		fmt.Sprintf("Voting on %s proposal %s closes soon", dao, proposalID),
+		fmt.Sprintf("The node hasn't voted on %s proposal %s (%s) yet, and the current voting phase closes at %s. Run `rocketpool governance upcoming` for details.", dao, proposalID, title, deadline.Format(time.RFC822)),
+		SeverityWarning,
+		strfmt.DateTime(deadline),
+		map[string]string{
+			"dao":      dao,
+			"proposal": proposalID,
+		},
+	)
+	return sendAlert(alert, cfg)
+}
+
+// Sends an alert when a challenge against one of the node's pDAO proposals hasn't been answered and the window to respond closes soon.
+// If alerting/metrics are disabled, this function does nothing.
+func AlertProposalChallengeUnanswered(cfg *config.RocketPoolConfig, proposalID uint64, challengedIndex uint64, deadline time.Time) error {
+	if !isAlertingEnabled(cfg) {
+		logMessage("alerting is disabled, not sending AlertProposalChallengeUnanswered.")
+		return nil
+	}
+
+	if cfg.Alertmanager.AlertEnabled_GovernanceDeadlines.Value != true {
+		logMessage("alert for GovernanceDeadlines is disabled, not sending.")
+		return nil
+	}
+
+	alert := createAlert(
+		fmt.Sprintf("ProposalChallengeUnanswered-%d-%d", proposalID, challengedIndex),
+		fmt.Sprintf("Challenge against pDAO proposal %d is unanswered", proposalID),
+		fmt.Sprintf("The node hasn't responded to the challenge against index %d of its pDAO proposal %d, and the response window closes at %s. If it isn't answered in time, the proposal is defeated and the node loses its proposal bond. Check the node logs for the errors that prevented the response.", challengedIndex, proposalID, deadline.Format(time.RFC822)),
+		SeverityCritical,
+		strfmt.DateTime(deadline),
+		map[string]string{
+			"proposal": fmt.Sprint(proposalID),
+		},
+	)
+	return sendAlert(alert, cfg)
+}
+
+// Sends an alert when the watchtower holds off an Oracle DAO submission because every other member that submitted for the block disagrees with it.
+// If alerting/metrics are disabled, this function does nothing.
+func AlertODaoSubmissionMismatch(cfg *config.RocketPoolConfig, duty string, block uint64, agreeing int, mismatched int, holdUntil time.Time, difference string) error {
+	if !isAlertingEnabled(cfg) {
+		logMessage("alerting is disabled, not sending AlertODaoSubmissionMismatch.")
+		return nil
+	}
+
+	if cfg.Alertmanager.AlertEnabled_ODaoSubmissionMismatch.Value != true {
+		logMessage("alert for ODaoSubmissionMismatch is disabled, not sending.")
+		return nil
+	}
+
+	// Members that agree mean this node still submits, so it's only a warning
+	var description string
+	severity := SeverityCritical
+	endsAt := holdUntil
+	if agreeing > 0 {
+		description = fmt.Sprintf("%d of the %d Oracle DAO members that submitted %s for block %d disagree with this node, but the others agree so the watchtower submitted anyway. For example, %s. Check the watchtower logs for the full comparison.", mismatched, agreeing+mismatched, duty, block, difference)
+		severity = SeverityWarning
+		endsAt = time.Now().Add(time.Hour)
+	} else if holdUntil.After(time.Now()) {
+		description = fmt.Sprintf("All %d Oracle DAO members that submitted %s for block %d disagree with this node, so the watchtower is holding off on its submission until %s. For example, %s. Check the watchtower logs for the full comparison.", mismatched, duty, block, holdUntil.Format(time.RFC822), difference)
+	} else {
+		description = fmt.Sprintf("All %d Oracle DAO members that submitted %s for block %d disagree with this node, and the watchtower submitted anyway after holding off until %s. For example, %s. Check the watchtower logs for the full comparison.", mismatched, duty, block, holdUntil.Format(time.RFC822), difference)
+		endsAt = time.Now().Add(time.Hour)
+	}
+
+	alert := createAlert(
+		fmt.Sprintf("ODaoSubmissionMismatch-%s-%d", strings.ReplaceAll(duty, " ", "-"), block),
+		fmt.Sprintf("Oracle DAO disagrees with the watchtower's %s for block %d", duty, block),
+		description,
+		severity,
+		strfmt.DateTime(endsAt),
+		map[string]string{
+			"duty":  duty,
+			"block": fmt.Sprint(block),
+		},
+	)
+	return sendAlert(alert, cfg)
+}
+
 func alertClientSyncComplete(cfg *config.RocketPoolConfig, client ClientKind) error {
 	alertName := fmt.Sprintf("%sClientSyncComplete", client)
 	if !isAlertingEnabled(cfg) {
@@ -288,6 +288,17 @@ func (m *BeaconClientManager) GetValidatorSyncDuties(indices []string, epoch uin
 	return result.(map[string]bool), nil
 }
 
+// Get the positions the validators hold in the sync committee
+func (m *BeaconClientManager) GetValidatorSyncCommitteeIndices(indices []string, epoch uint64) (map[string][]uint64, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetValidatorSyncCommitteeIndices(indices, epoch)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(map[string][]uint64), nil
+}
+
 // Get a validator's proposer duties
 func (m *BeaconClientManager) GetValidatorProposerDuties(indices []string, epoch uint64) (map[string]uint64, error) {
 	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
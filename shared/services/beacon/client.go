@@ -65,10 +65,14 @@ type BeaconBlock struct {
 	ExecutionBlockNumber uint64
 	ExecutionBlockHash   common.Hash
 	Withdrawals          []WithdrawalInfo
+	// The sync committee members that signed the previous slot's block; nil before Altair
+	SyncCommitteeBits bitfield.Bitvector512
 }
 type BeaconBlockHeader struct {
 	Slot          uint64
 	ProposerIndex string
+	Root          common.Hash
+	StateRoot     common.Hash
 }
 
 // Committees is an interface as an optimization- since committees responses
@@ -188,6 +192,7 @@ type Client interface {
 	GetValidatorStatuses(pubkeys []types.ValidatorPubkey, opts *ValidatorStatusOptions) (map[types.ValidatorPubkey]ValidatorStatus, error)
 	GetValidatorIndex(pubkey types.ValidatorPubkey) (string, error)
 	GetValidatorSyncDuties(indices []string, epoch uint64) (map[string]bool, error)
+	GetValidatorSyncCommitteeIndices(indices []string, epoch uint64) (map[string][]uint64, error)
 	GetValidatorProposerDuties(indices []string, epoch uint64) (map[string]uint64, error)
 	GetValidatorBalances(indices []string, opts *ValidatorStatusOptions) (map[string]*big.Int, error)
 	GetValidatorBalancesSafe(indices []string, opts *ValidatorStatusOptions) (map[string]*big.Int, error)
@@ -488,6 +488,40 @@ func (c *StandardHttpClient) GetValidatorSyncDuties(indices []string, epoch uint
 	return validatorMap, nil
 }
 
+// Get the positions each validator holds in the sync committee for the given epoch; validators that aren't on it are left out
+func (c *StandardHttpClient) GetValidatorSyncCommitteeIndices(indices []string, epoch uint64) (map[string][]uint64, error) {
+	// Return if there are no validators to check
+	if len(indices) == 0 {
+		return nil, nil
+	}
+
+	// Perform the post request
+	responseBody, status, err := c.postRequest(fmt.Sprintf(RequestValidatorSyncDuties, strconv.FormatUint(epoch, 10)), indices)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get validator sync duties: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("Could not get validator sync duties: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+
+	var response SyncDutiesResponse
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return nil, fmt.Errorf("Could not decode validator sync duties data: %w", err)
+	}
+
+	// Map the results
+	validatorMap := make(map[string][]uint64, len(response.Data))
+	for _, duty := range response.Data {
+		positions := make([]uint64, len(duty.SyncCommitteeIndices))
+		for i, position := range duty.SyncCommitteeIndices {
+			positions[i] = uint64(position)
+		}
+		validatorMap[duty.ValidatorIndex] = positions
+	}
+
+	return validatorMap, nil
+}
+
 // Sums proposer duties per validators for a given epoch
 func (c *StandardHttpClient) GetValidatorProposerDuties(indices []string, epoch uint64) (map[string]uint64, error) {
 
@@ -705,6 +739,11 @@ func (c *StandardHttpClient) GetBeaconBlock(blockId string) (beacon.BeaconBlock,
 		beaconBlock.Attestations = append(beaconBlock.Attestations, info)
 	}
 
+	// Add sync committee participation
+	if block.Data.Message.Body.SyncAggregate != nil {
+		beaconBlock.SyncCommitteeBits = bitfield.Bitvector512(block.Data.Message.Body.SyncAggregate.SyncCommitteeBits)
+	}
+
 	// Withdrawals only exist on the embedded execution payload (pre-Gloas).
 	// Gloas withdrawals live on the EL block / beacon state expected_withdrawals.
 	if executionPayload != nil {
@@ -741,6 +780,8 @@ func (c *StandardHttpClient) GetBeaconBlockHeader(blockId string) (beacon.Beacon
 	beaconBlock := beacon.BeaconBlockHeader{
 		Slot:          uint64(block.Data.Header.Message.Slot),
 		ProposerIndex: block.Data.Header.Message.ProposerIndex,
+		Root:          common.HexToHash(block.Data.Root),
+		StateRoot:     common.HexToHash(block.Data.Header.Message.StateRoot),
 	}
 	return beaconBlock, true, nil
 }
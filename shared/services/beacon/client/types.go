@@ -96,7 +96,10 @@ type BeaconBlockResponse struct {
 					DepositCount uinteger  `json:"deposit_count"`
 					BlockHash    byteArray `json:"block_hash"`
 				} `json:"eth1_data"`
-				Attestations     []Attestation `json:"attestations"`
+				Attestations  []Attestation `json:"attestations"`
+				SyncAggregate *struct {
+					SyncCommitteeBits byteArray `json:"sync_committee_bits"`
+				} `json:"sync_aggregate"`
 				ExecutionPayload *struct {
 					FeeRecipient byteArray    `json:"fee_recipient"`
 					BlockNumber  uinteger     `json:"block_number"`
@@ -123,6 +126,7 @@ type BeaconBlockHeaderResponse struct {
 			Message struct {
 				Slot          uinteger `json:"slot"`
 				ProposerIndex string   `json:"proposer_index"`
+				StateRoot     string   `json:"state_root"`
 			} `json:"message"`
 		} `json:"header"`
 	} `json:"data"`
@@ -0,0 +1,247 @@
+package beacon
+
+import (
+	"fmt"
+	"strconv"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// The most Beacon API requests to run at once when getting an epoch's duty performance
+	dutyPerformanceThreadLimit int = 6
+)
+
+// The duty performance of a validator over one or more epochs
+type DutyPerformance struct {
+	AttestationDuties  uint64
+	MissedAttestations uint64
+	ProposalDuties     uint64
+	Proposals          uint64
+	SyncDuties         uint64
+	MissedSyncDuties   uint64
+}
+
+// Add the performance from another set of epochs to this one
+func (p *DutyPerformance) Add(other DutyPerformance) {
+	p.AttestationDuties += other.AttestationDuties
+	p.MissedAttestations += other.MissedAttestations
+	p.ProposalDuties += other.ProposalDuties
+	p.Proposals += other.Proposals
+	p.SyncDuties += other.SyncDuties
+	p.MissedSyncDuties += other.MissedSyncDuties
+}
+
+// Get the number of proposal duties that didn't end up with a block
+func (p DutyPerformance) MissedProposals() uint64 {
+	if p.Proposals >= p.ProposalDuties {
+		return 0
+	}
+	return p.ProposalDuties - p.Proposals
+}
+
+// The attestation duties of a set of validators in an epoch, keyed by slot
+type AttestationDuties map[uint64]*SlotAttestationDuties
+
+// The attestation duties of a set of validators in a single slot
+type SlotAttestationDuties struct {
+	// The size of every committee in the slot, for calculating offsets in post-electra aggregation bits
+	CommitteeSizes map[uint64]int
+
+	// The validators in each committee, keyed by committee index then position
+	Positions map[uint64]map[int]string
+}
+
+// Maps out the attestation duties of the given validators from an epoch's committees
+func GetAttestationDuties(committees Committees, indices map[string]bool) AttestationDuties {
+	duties := AttestationDuties{}
+
+	// Crawl the committees
+	for idx := 0; idx < committees.Count(); idx++ {
+		slotIndex := committees.Slot(idx)
+		committeeIndex := committees.Index(idx)
+
+		// Every committee size is needed to find positions in the aggregation bits, even ones without any of the validators
+		slot, exists := duties[slotIndex]
+		if !exists {
+			slot = &SlotAttestationDuties{
+				CommitteeSizes: map[uint64]int{},
+				Positions:      map[uint64]map[int]string{},
+			}
+			duties[slotIndex] = slot
+		}
+		slot.CommitteeSizes[committeeIndex] = committees.ValidatorCount(idx)
+
+		for position, validator := range committees.Validators(idx) {
+			if !indices[validator] {
+				continue
+			}
+			positions, exists := slot.Positions[committeeIndex]
+			if !exists {
+				positions = map[int]string{}
+				slot.Positions[committeeIndex] = positions
+			}
+			positions[position] = validator
+		}
+	}
+
+	return duties
+}
+
+// Removes every duty that was fulfilled by one of the attestations, so whatever is left afterwards was missed
+func (d AttestationDuties) CheckAttestations(attestations []AttestationInfo) {
+	for _, attestation := range attestations {
+		slot, exists := d[attestation.SlotIndex]
+		if !exists {
+			continue
+		}
+
+		for _, committeeIndex := range attestation.CommitteeIndices() {
+			positions, exists := slot.Positions[uint64(committeeIndex)]
+			if !exists {
+				continue
+			}
+			for position := range positions {
+				if attestation.ValidatorAttested(committeeIndex, position, slot.CommitteeSizes) {
+					delete(positions, position)
+				}
+			}
+			if len(positions) == 0 {
+				delete(slot.Positions, uint64(committeeIndex))
+			}
+		}
+	}
+}
+
+// Get the number of remaining duties for each validator
+func (d AttestationDuties) CountByValidator() map[string]uint64 {
+	counts := map[string]uint64{}
+	for _, slot := range d {
+		for _, positions := range slot.Positions {
+			for _, index := range positions {
+				counts[index]++
+			}
+		}
+	}
+	return counts
+}
+
+// Check which of the sync committee positions signed a block; a validator misses the duty if any of its positions didn't
+func checkSyncCommitteeParticipation(block BeaconBlock, syncCommitteeIndices map[string][]uint64, performance map[string]*DutyPerformance) {
+	if block.SyncCommitteeBits == nil {
+		return
+	}
+	for index, positions := range syncCommitteeIndices {
+		validatorPerformance, exists := performance[index]
+		if !exists || len(positions) == 0 {
+			continue
+		}
+		validatorPerformance.SyncDuties++
+		for _, position := range positions {
+			if !block.SyncCommitteeBits.BitAt(position) {
+				validatorPerformance.MissedSyncDuties++
+				break
+			}
+		}
+	}
+}
+
+// Get the attestation, proposal and sync committee performance of the given validators in an epoch.
+// Attestations can be included up to the end of the following epoch, so the epoch should be at least one behind the finalized epoch.
+func GetEpochDutyPerformance(bc Client, epoch uint64, slotsPerEpoch uint64, indices []string) (map[string]*DutyPerformance, error) {
+	performance := make(map[string]*DutyPerformance, len(indices))
+	indexLookup := make(map[string]bool, len(indices))
+	for _, index := range indices {
+		performance[index] = &DutyPerformance{}
+		indexLookup[index] = true
+	}
+	if len(indices) == 0 {
+		return performance, nil
+	}
+
+	// Get the duties for the epoch and the blocks that could include its attestations
+	var wg errgroup.Group
+	wg.SetLimit(dutyPerformanceThreadLimit)
+	var attestationDuties AttestationDuties
+	wg.Go(func() error {
+		committees, err := bc.GetCommitteesForEpoch(&epoch)
+		if err != nil {
+			return fmt.Errorf("error getting committees: %w", err)
+		}
+		defer committees.Release()
+		attestationDuties = GetAttestationDuties(committees, indexLookup)
+		return nil
+	})
+
+	var proposalDuties map[string]uint64
+	wg.Go(func() error {
+		var err error
+		proposalDuties, err = bc.GetValidatorProposerDuties(indices, epoch)
+		if err != nil {
+			return fmt.Errorf("error getting proposer duties: %w", err)
+		}
+		return nil
+	})
+
+	var syncCommitteeIndices map[string][]uint64
+	wg.Go(func() error {
+		var err error
+		syncCommitteeIndices, err = bc.GetValidatorSyncCommitteeIndices(indices, epoch)
+		if err != nil {
+			return fmt.Errorf("error getting sync committee duties: %w", err)
+		}
+		return nil
+	})
+
+	firstSlot := epoch * slotsPerEpoch
+	blocks := make([]*BeaconBlock, 2*slotsPerEpoch)
+	for i := range blocks {
+		slot := firstSlot + uint64(i)
+		wg.Go(func() error {
+			block, exists, err := bc.GetBeaconBlock(strconv.FormatUint(slot, 10))
+			if err != nil {
+				return fmt.Errorf("error getting block for slot %d: %w", slot, err)
+			}
+			if exists {
+				blocks[i] = &block
+			}
+			return nil
+		})
+	}
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Tally the duties before the fulfilled ones are removed
+	for index, count := range attestationDuties.CountByValidator() {
+		performance[index].AttestationDuties = count
+	}
+	for index, count := range proposalDuties {
+		if _, exists := performance[index]; exists {
+			performance[index].ProposalDuties = count
+		}
+	}
+
+	for i, block := range blocks {
+		if block == nil {
+			continue
+		}
+		attestationDuties.CheckAttestations(block.Attestations)
+
+		// Proposals and sync committee signatures from the following epoch are checked along with that epoch.
+		// A block's sync aggregate signs the slot before it, so the epoch's sync duties are in the blocks from its second slot onwards.
+		if uint64(i) < slotsPerEpoch && indexLookup[block.ProposerIndex] {
+			performance[block.ProposerIndex].Proposals++
+		}
+		if uint64(i) > 0 && uint64(i) <= slotsPerEpoch {
+			checkSyncCommitteeParticipation(*block, syncCommitteeIndices, performance)
+		}
+	}
+
+	// Whatever is left was missed
+	for index, count := range attestationDuties.CountByValidator() {
+		performance[index].MissedAttestations = count
+	}
+
+	return performance, nil
+}
@@ -0,0 +1,125 @@
+package beacon
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+type testCommittee struct {
+	slot       uint64
+	index      uint64
+	validators []string
+}
+
+type testCommittees []testCommittee
+
+func (c testCommittees) Index(idx int) uint64        { return c[idx].index }
+func (c testCommittees) Slot(idx int) uint64         { return c[idx].slot }
+func (c testCommittees) Validators(idx int) []string { return c[idx].validators }
+func (c testCommittees) ValidatorCount(idx int) int  { return len(c[idx].validators) }
+func (c testCommittees) Count() int                  { return len(c) }
+func (c testCommittees) Release()                    {}
+
+// Build an electra-style aggregate attestation for a slot, with the given positions set across the given committees
+func newTestAttestation(slot uint64, committeeSizes []int, committeeIndices []uint64, attested []int) AttestationInfo {
+	size := 0
+	for _, committeeSize := range committeeSizes {
+		size += committeeSize
+	}
+	attestation := AttestationInfo{
+		SlotIndex:       slot,
+		AggregationBits: bitfield.NewBitlist(uint64(size)),
+		Committees:      bitfield.NewBitvector64(),
+	}
+	for _, index := range committeeIndices {
+		attestation.Committees.SetBitAt(index, true)
+	}
+	for _, offset := range attested {
+		attestation.AggregationBits.SetBitAt(uint64(offset), true)
+	}
+	return attestation
+}
+
+func TestAttestationDuties(t *testing.T) {
+	committees := testCommittees{
+		{slot: 100, index: 0, validators: []string{"1", "10", "2"}},
+		{slot: 100, index: 1, validators: []string{"3", "11", "4", "12"}},
+		{slot: 101, index: 0, validators: []string{"5", "6", "13"}},
+	}
+	validators := map[string]bool{"10": true, "11": true, "12": true, "13": true}
+
+	duties := GetAttestationDuties(committees, validators)
+	if len(duties) != 2 {
+		t.Fatalf("expected duties in 2 slots, got %d", len(duties))
+	}
+	if size := duties[100].CommitteeSizes[1]; size != 4 {
+		t.Errorf("expected committee 1 of slot 100 to have 4 validators, got %d", size)
+	}
+	if index := duties[100].Positions[1][3]; index != "12" {
+		t.Errorf("expected validator 12 at position 3 of committee 1, got %s", index)
+	}
+	if counts := duties.CountByValidator(); len(counts) != 4 || counts["13"] != 1 {
+		t.Errorf("expected one duty for each validator, got %v", counts)
+	}
+
+	// Validator 10 and 12 attest in one aggregate spanning both committees of slot 100; validator 11's bit is left unset.
+	// Offsets in the aggregate are relative to the start of the first committee, so validator 12 is at 3 + 3.
+	duties.CheckAttestations([]AttestationInfo{
+		newTestAttestation(100, []int{3, 4}, []uint64{0, 1}, []int{0, 1, 2, 6}),
+
+		// An attestation for a slot without any of the duties is ignored
+		newTestAttestation(99, []int{3}, []uint64{0}, []int{0, 1, 2}),
+	})
+
+	missed := duties.CountByValidator()
+	if len(missed) != 2 || missed["11"] != 1 || missed["13"] != 1 {
+		t.Errorf("expected validators 11 and 13 to miss their attestations, got %v", missed)
+	}
+	if _, exists := duties[100].Positions[0]; exists {
+		t.Error("expected committee 0 of slot 100 to be removed once all of its duties were fulfilled")
+	}
+}
+
+func TestSyncCommitteeParticipation(t *testing.T) {
+	performance := map[string]*DutyPerformance{
+		"10": {},
+		"11": {},
+		"12": {},
+	}
+	syncCommitteeIndices := map[string][]uint64{
+		"10": {4},
+		"11": {7, 300},
+	}
+
+	// Validator 11 only signed with one of its two positions, which counts as a miss
+	block := BeaconBlock{SyncCommitteeBits: bitfield.NewBitvector512()}
+	block.SyncCommitteeBits.SetBitAt(4, true)
+	block.SyncCommitteeBits.SetBitAt(7, true)
+	checkSyncCommitteeParticipation(block, syncCommitteeIndices, performance)
+
+	// Blocks from before Altair don't have a sync aggregate
+	checkSyncCommitteeParticipation(BeaconBlock{}, syncCommitteeIndices, performance)
+
+	if p := performance["10"]; p.SyncDuties != 1 || p.MissedSyncDuties != 0 {
+		t.Errorf("unexpected sync performance for validator 10: %+v", p)
+	}
+	if p := performance["11"]; p.SyncDuties != 1 || p.MissedSyncDuties != 1 {
+		t.Errorf("unexpected sync performance for validator 11: %+v", p)
+	}
+	if p := performance["12"]; p.SyncDuties != 0 {
+		t.Errorf("expected validator 12 to have no sync duties, got %+v", p)
+	}
+}
+
+func TestMissedProposals(t *testing.T) {
+	total := DutyPerformance{}
+	total.Add(DutyPerformance{ProposalDuties: 1, Proposals: 1, AttestationDuties: 1})
+	total.Add(DutyPerformance{ProposalDuties: 1, AttestationDuties: 1, MissedAttestations: 1})
+	if total.AttestationDuties != 2 || total.MissedAttestations != 1 {
+		t.Errorf("unexpected attestation totals: %+v", total)
+	}
+	if missed := total.MissedProposals(); missed != 1 {
+		t.Errorf("expected 1 missed proposal, got %d", missed)
+	}
+}
@@ -27,6 +27,10 @@ const defaultAlertmanagerPort uint16 = 9093
 const defaultAlertmanagerHost string = "localhost"
 const defaultAlertmanagerOpenPort config.RPCMode = config.RPC_Closed
 const defaultLowETHBalanceThreshold float64 = 0.01
+const defaultMissedDutiesWindow uint64 = 8
+const defaultMissedAttestationsThreshold uint64 = 3
+const defaultMissedSyncDutiesThreshold uint64 = 32
+const defaultGovernanceDeadlineHours uint64 = 24
 
 // Configuration for Alertmanager
 type AlertmanagerConfig struct {
@@ -94,6 +98,18 @@ type AlertmanagerConfig struct {
 	AlertEnabled_PortConnectivityCheck config.Parameter `yaml:"alertEnabled_PortConnectivityCheck,omitempty"`
 	// Whether to alert while the node/watchtower daemon is running in observe (masquerade) mode
 	AlertEnabled_ObserveModeActive config.Parameter `yaml:"alertEnabled_ObserveModeActive,omitempty"`
+	// Whether to alert when one of the nodes on the watch list needs attention
+	AlertEnabled_WatchedNodeIssues config.Parameter `yaml:"alertEnabled_WatchedNodeIssues,omitempty"`
+	// Whether to alert when the node's validators miss duties, and how many misses in which window trigger it
+	AlertEnabled_MissedDuties   config.Parameter `yaml:"alertEnabled_MissedDuties,omitempty"`
+	MissedDutiesWindow          config.Parameter `yaml:"missedDutiesWindow,omitempty"`
+	MissedAttestationsThreshold config.Parameter `yaml:"missedAttestationsThreshold,omitempty"`
+	MissedSyncDutiesThreshold   config.Parameter `yaml:"missedSyncDutiesThreshold,omitempty"`
+	// Whether to alert before governance deadlines that need the node's attention, and how far ahead
+	AlertEnabled_GovernanceDeadlines config.Parameter `yaml:"alertEnabled_GovernanceDeadlines,omitempty"`
+	GovernanceDeadlineHours          config.Parameter `yaml:"governanceDeadlineHours,omitempty"`
+	// Whether to alert when the watchtower's Oracle DAO submissions disagree with the other members'
+	AlertEnabled_ODaoSubmissionMismatch config.Parameter `yaml:"alertEnabled_ODaoSubmissionMismatch,omitempty"`
 }
 
 func NewAlertmanagerConfig(cfg *RocketPoolConfig) *AlertmanagerConfig {
@@ -321,6 +337,36 @@ func NewAlertmanagerConfig(cfg *RocketPoolConfig) *AlertmanagerConfig {
 			"ObserveModeActive",
 			"the node/watchtower daemon is running in observe mode"),
 
+		AlertEnabled_WatchedNodeIssues: createParameterForAlertEnablement(
+			"WatchedNodeIssues",
+			"a watched node has slashed validators or megapool debt"),
+
+		AlertEnabled_ODaoSubmissionMismatch: createParameterForAlertEnablement(
+			"ODaoSubmissionMismatch",
+			"the watchtower holds off an Oracle DAO submission that disagrees with the other members"),
+
+		AlertEnabled_MissedDuties: config.Parameter{
+			ID:                 "alertEnabled_MissedDuties",
+			Name:               "Alert for Missed Validator Duties",
+			Description:        "Check your validators' attestations, block proposals and sync committee signatures in recently finalized epochs, and send an alert when they miss more duties than the thresholds below allow.",
+			Type:               config.ParameterType_Bool,
+			Default:            map[config.Network]interface{}{config.Network_All: true},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		AlertEnabled_GovernanceDeadlines: config.Parameter{
+			ID:                 "alertEnabled_GovernanceDeadlines",
+			Name:               "Alert for Governance Deadlines",
+			Description:        "Send an alert before voting closes on a pDAO, oDAO, Security Council or Snapshot proposal the node hasn't voted on yet, and before the window to respond to a challenge against one of the node's pDAO proposals expires.",
+			Type:               config.ParameterType_Bool,
+			Default:            map[config.Network]interface{}{config.Network_All: true},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
 		LowETHBalanceThreshold: config.Parameter{
 			ID:                 "lowETHBalanceThreshold",
 			Name:               "Low ETH Balance Threshold",
@@ -331,6 +377,50 @@ func NewAlertmanagerConfig(cfg *RocketPoolConfig) *AlertmanagerConfig {
 			CanBeBlank:         false,
 			OverwriteOnUpgrade: false,
 		},
+
+		MissedDutiesWindow: config.Parameter{
+			ID:                 "missedDutiesWindow",
+			Name:               "Missed Duties Window",
+			Description:        "The number of most recently finalized epochs to count missed duties over for the missed validator duties alert.",
+			Type:               config.ParameterType_Uint,
+			Default:            map[config.Network]interface{}{config.Network_All: defaultMissedDutiesWindow},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		MissedAttestationsThreshold: config.Parameter{
+			ID:                 "missedAttestationsThreshold",
+			Name:               "Missed Attestations Threshold",
+			Description:        "The number of attestations a validator has to miss within the missed duties window before an alert is sent. Set this to 0 to disable the attestation alert.",
+			Type:               config.ParameterType_Uint,
+			Default:            map[config.Network]interface{}{config.Network_All: defaultMissedAttestationsThreshold},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		MissedSyncDutiesThreshold: config.Parameter{
+			ID:                 "missedSyncDutiesThreshold",
+			Name:               "Missed Sync Committee Duties Threshold",
+			Description:        "The number of sync committee signatures a validator has to miss within the missed duties window before an alert is sent. Set this to 0 to disable the sync committee alert. A missed block proposal always sends an alert.",
+			Type:               config.ParameterType_Uint,
+			Default:            map[config.Network]interface{}{config.Network_All: defaultMissedSyncDutiesThreshold},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		GovernanceDeadlineHours: config.Parameter{
+			ID:                 "governanceDeadlineHours",
+			Name:               "Governance Deadline Warning Hours",
+			Description:        "How many hours before a governance deadline the governance deadline alert is sent.",
+			Type:               config.ParameterType_Uint,
+			Default:            map[config.Network]interface{}{config.Network_All: defaultGovernanceDeadlineHours},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
 	}
 }
 
@@ -382,7 +472,15 @@ func (cfg *AlertmanagerConfig) GetParameters() []*config.Parameter {
 		&cfg.AlertEnabled_PortConnectivityCheck,
 		&cfg.AlertEnabled_LowETHBalance,
 		&cfg.AlertEnabled_ObserveModeActive,
+		&cfg.AlertEnabled_WatchedNodeIssues,
+		&cfg.AlertEnabled_MissedDuties,
 		&cfg.LowETHBalanceThreshold,
+		&cfg.MissedDutiesWindow,
+		&cfg.MissedAttestationsThreshold,
+		&cfg.MissedSyncDutiesThreshold,
+		&cfg.AlertEnabled_GovernanceDeadlines,
+		&cfg.GovernanceDeadlineHours,
+		&cfg.AlertEnabled_ODaoSubmissionMismatch,
 	}
 }
 
@@ -1366,7 +1366,14 @@ func (cfg *RocketPoolConfig) GetECStopSignal() (string, error) {
 
 func (cfg *RocketPoolConfig) GetNodeOpenPorts() string {
 	port := cfg.Smartnode.APIPort.Value.(uint16)
-	return fmt.Sprintf("\"127.0.0.1:%d:%d/tcp\"", port, port)
+	ports := fmt.Sprintf("\"127.0.0.1:%d:%d/tcp\"", port, port)
+
+	// The proof server is meant to be reached by other machines, so it isn't limited to localhost
+	if cfg.Smartnode.EnableProofServer.Value.(bool) {
+		proofServerPort := cfg.Smartnode.ProofServerPort.Value.(uint16)
+		ports += fmt.Sprintf(", \"%d:%d/tcp\"", proofServerPort, proofServerPort)
+	}
+	return ports
 }
 
 // Gets the stop signal of the ec container
@@ -37,6 +37,7 @@ const (
 	DaemonDataPath                     string = "/.rocketpool/data"
 	WatchtowerFolder                   string = "watchtower"
 	WatchtowerStateFile                string = "state.yml"
+	WatchtowerDryRunFile               string = "dry-run-transactions.jsonl"
 	RegenerateRewardsTreeRequestSuffix string = ".request"
 	RegenerateRewardsTreeRequestFormat string = "%d" + RegenerateRewardsTreeRequestSuffix
 	PrimaryRewardsFileUrl              string = "https://%s.ipfs.dweb.link/%s"
@@ -102,6 +103,12 @@ type SmartnodeConfig struct {
 	// The amount of ETH in a minipool's balance before auto-distribute kicks in
 	DistributeThreshold config.Parameter `yaml:"distributeThreshold,omitempty"`
 
+	// The node wallet balance to keep when automatically repaying megapool debt
+	AutoRepayDebtThreshold config.Parameter `yaml:"autoRepayDebtThreshold,omitempty"`
+
+	// The minimum megapool refund, after gas costs, before it's claimed automatically
+	AutoClaimRefundThreshold config.Parameter `yaml:"autoClaimRefundThreshold,omitempty"`
+
 	// Mode for acquiring Merkle rewards trees
 	RewardsTreeMode config.Parameter `yaml:"rewardsTreeMode,omitempty"`
 
@@ -120,6 +127,9 @@ type SmartnodeConfig struct {
 	// Manual override for the watchtower's priority fee
 	WatchtowerPrioFeeOverride config.Parameter `yaml:"watchtowerPrioFeeOverride,omitempty"`
 
+	// Toggle for recording the watchtower's Oracle DAO transactions instead of submitting them
+	WatchtowerDryRun config.Parameter `yaml:"watchtowerDryRun,omitempty"`
+
 	// The toggle for enabling pDAO proposal verification duties
 	VerifyProposals config.Parameter `yaml:"verifyProposals,omitempty"`
 
@@ -129,6 +139,15 @@ type SmartnodeConfig struct {
 	// Port for the node's HTTP API webserver
 	APIPort config.Parameter `yaml:"apiPort,omitempty"`
 
+	// The toggle for serving megapool proofs to other nodes
+	EnableProofServer config.Parameter `yaml:"enableProofServer,omitempty"`
+
+	// Port for the megapool proof server
+	ProofServerPort config.Parameter `yaml:"proofServerPort,omitempty"`
+
+	// URL of a megapool proof server to get proofs from
+	ProofServerUrl config.Parameter `yaml:"proofServerUrl,omitempty"`
+
 	///////////////////////////
 	// Non-editable settings //
 	///////////////////////////
@@ -334,6 +353,28 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade: false,
 		},
 
+		AutoRepayDebtThreshold: config.Parameter{
+			ID:                 "autoRepayDebtThreshold",
+			Name:               "Auto-Repay Debt Threshold",
+			Description:        "The Smart Node will regularly check if your megapool has any debt (for example, from penalties or a validator exiting with less than its bond).\nIf it does and your node wallet holds more than this amount of ETH, the Smart Node will automatically use the ETH above this amount to repay the debt. Keep enough ETH in your wallet above this threshold to cover gas for your other transactions.\n\nSet this to 0 to disable automatic debt repayment.",
+			Type:               config.ParameterType_Float,
+			Default:            map[config.Network]interface{}{config.Network_All: float64(0)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		AutoClaimRefundThreshold: config.Parameter{
+			ID:                 "autoClaimRefundThreshold",
+			Name:               "Auto-Claim Refund Threshold",
+			Description:        "The Smart Node will regularly check if your megapool has a refund available (for example, from a dissolved validator or a bond reduction).\nIf the refund is larger than this amount of ETH plus the gas cost of claiming it, the Smart Node will automatically claim it. This will send the refund to your withdrawal address.\n\nSet this to 0 to disable automatic refund claiming.",
+			Type:               config.ParameterType_Float,
+			Default:            map[config.Network]interface{}{config.Network_All: float64(0)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
 		VerifyProposals: config.Parameter{
 			ID:                 "verifyProposals",
 			Name:               "Enable PDAO Proposal Checker",
@@ -431,6 +472,17 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade: true,
 		},
 
+		WatchtowerDryRun: config.Parameter{
+			ID:                 "watchtowerDryRun",
+			Name:               "Watchtower Dry Run",
+			Description:        fmt.Sprintf("[orange]**For testing Oracle DAO duties only.**\n\n[white]Enable this to run the watchtower's Oracle DAO duties (prices, balances, rewards trees, challenge responses, and minipool scrubs) even if your node isn't an Oracle DAO member. Instead of being signed and submitted, the transactions the watchtower would send are logged and saved to `%s` in the watchtower folder.\n\nThis works with observe mode, so you can follow the duties of an existing member.", WatchtowerDryRunFile),
+			Type:               config.ParameterType_Bool,
+			Default:            map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Watchtower},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
 		APIPort: config.Parameter{
 			ID:                 "apiPort",
 			Name:               "API Port",
@@ -442,6 +494,39 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade: false,
 		},
 
+		EnableProofServer: config.Parameter{
+			ID:                 "enableProofServer",
+			Name:               "Enable Megapool Proof Server",
+			Description:        "Check this box to have your node serve the beacon chain proofs used by megapool staking, exit and final balance transactions. Your node will keep its most recent finalized beacon states in memory and precompute the proofs for its own megapool validators, so other nodes you run can get their proofs from it instead of downloading a full beacon state or relying on Rocket Pool's proof API. Staking and exit proofs are served for any validator, but final balance proofs are only served for this node's own validators.\n\n[orange]NOTE: Each cached beacon state can take several hundred megabytes of memory.",
+			Type:               config.ParameterType_Bool,
+			Default:            map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		ProofServerPort: config.Parameter{
+			ID:                 "proofServerPort",
+			Name:               "Megapool Proof Server Port",
+			Description:        "The port the megapool proof server should listen on. Unlike the node's API port, this port is open to other machines so your other nodes can reach it; it only serves proofs from the beacon states your node has already cached, and never downloads new states for requests that come in on it.",
+			Type:               config.ParameterType_Uint16,
+			Default:            map[config.Network]interface{}{config.Network_All: uint16(8281)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		ProofServerUrl: config.Parameter{
+			ID:                 "proofServerUrl",
+			Name:               "Megapool Proof Server URL",
+			Description:        "The URL of a megapool proof server run by another one of your nodes, e.g. `http://192.168.1.10:8281`. If set, the Smart Node will get its megapool proofs from that server instead of building them from a full beacon state or fetching them from Rocket Pool's proof API. Proofs from the server are always checked against your own Beacon Node, and the Smart Node falls back to building them itself if the server can't provide them. The server only has final balance proofs for its own validators, so this node builds its final balance proofs itself.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
 		txWatchUrl: map[config.Network]string{
 			config.Network_Mainnet: "https://etherscan.io/tx",
 			config.Network_Devnet:  "",
@@ -666,6 +751,8 @@ func (cfg *SmartnodeConfig) GetParameters() []*config.Parameter {
 		&cfg.PriorityFee,
 		&cfg.AutoTxGasThreshold,
 		&cfg.DistributeThreshold,
+		&cfg.AutoRepayDebtThreshold,
+		&cfg.AutoClaimRefundThreshold,
 		&cfg.VerifyProposals,
 		&cfg.AutoAssignmentDelay,
 		&cfg.RewardsTreeMode,
@@ -674,7 +761,11 @@ func (cfg *SmartnodeConfig) GetParameters() []*config.Parameter {
 		&cfg.ArchiveECUrl,
 		&cfg.WatchtowerMaxFeeOverride,
 		&cfg.WatchtowerPrioFeeOverride,
+		&cfg.WatchtowerDryRun,
 		&cfg.APIPort,
+		&cfg.EnableProofServer,
+		&cfg.ProofServerPort,
+		&cfg.ProofServerUrl,
 	}
 }
 
@@ -716,6 +807,30 @@ func (cfg *SmartnodeConfig) GetNodeAddressPath() string {
 	return filepath.Join(DaemonDataPath, "address")
 }
 
+func (cfg *SmartnodeConfig) GetWatchedNodesPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), "watched-nodes")
+	}
+
+	return filepath.Join(DaemonDataPath, "watched-nodes")
+}
+
+func (cfg *SmartnodeConfig) GetMinipoolMigrationPlanPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), "minipool-migration")
+	}
+
+	return filepath.Join(DaemonDataPath, "minipool-migration")
+}
+
+func (cfg *SmartnodeConfig) GetVotePolicyPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), "pdao-vote-policy.yml")
+	}
+
+	return filepath.Join(DaemonDataPath, "pdao-vote-policy.yml")
+}
+
 func (cfg *SmartnodeConfig) GetValidatorKeychainPath() string {
 	if cfg.parent.IsNativeMode {
 		return filepath.Join(cfg.DataPath.Value.(string), "validators")
@@ -760,6 +875,14 @@ func (cfg *SmartnodeConfig) GetWatchtowerStatePath() string {
 	return filepath.Join(DaemonDataPath, WatchtowerFolder, "state.yml")
 }
 
+func (cfg *SmartnodeConfig) GetWatchtowerDryRunPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), WatchtowerFolder, WatchtowerDryRunFile)
+	}
+
+	return filepath.Join(DaemonDataPath, WatchtowerFolder, WatchtowerDryRunFile)
+}
+
 func (cfg *SmartnodeConfig) GetCustomKeyPath() string {
 	if cfg.parent.IsNativeMode {
 		return filepath.Join(cfg.DataPath.Value.(string), "custom-keys")
@@ -146,6 +146,21 @@ func (p *ExecutionClientManager) CallContract(ctx context.Context, call ethereum
 	return result.([]byte), err
 }
 
+// SimulateCalls executes a sequence of Ethereum contract calls where each call sees the state
+// changes made by the ones before it, without committing anything to the chain.
+func (p *ExecutionClientManager) SimulateCalls(ctx context.Context, calls []ethereum.CallMsg, blockNumber *big.Int) ([]SimulatedCall, error) {
+	if p.static != nil {
+		return nil, fmt.Errorf("SimulateCalls is not supported by the static execution client")
+	}
+	result, err := p.runFunction(func(client *EthClient) (interface{}, error) {
+		return client.SimulateCalls(ctx, calls, blockNumber)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]SimulatedCall), err
+}
+
 /// ============================
 /// ContractTransactor Functions
 /// ============================
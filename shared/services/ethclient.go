@@ -2,8 +2,13 @@ package services
 
 import (
 	"context"
+	"fmt"
+	"math/big"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 )
 
@@ -27,3 +32,88 @@ func (c *EthClient) LatestBlockTime(ctx context.Context) (time.Time, error) {
 
 	return time.Unix(int64(header.Time), 0), nil
 }
+
+// The result of one of the calls in a simulated sequence
+type SimulatedCall struct {
+	// The data the call returned, if it succeeded
+	ReturnData []byte
+
+	// Whether the call reverted, and the data it reverted with
+	Reverted   bool
+	RevertData []byte
+
+	// The reason the call failed, if it reverted or hit any other execution error
+	Error string
+}
+
+// The result of a call in an eth_simulateV1 response
+type simulateCallResult struct {
+	ReturnData hexutil.Bytes  `json:"returnData"`
+	Status     hexutil.Uint64 `json:"status"`
+	Error      *struct {
+		Code    int            `json:"code"`
+		Message string         `json:"message"`
+		Data    *hexutil.Bytes `json:"data"`
+	} `json:"error"`
+}
+
+// Executes a sequence of contract calls against the given block (or the latest one if nil) using eth_simulateV1, where each call
+// sees the state changes made by the calls before it. Nothing is committed to the chain.
+// Calls that fail don't return an error; their result records whether they reverted and why.
+func (c *EthClient) SimulateCalls(ctx context.Context, calls []ethereum.CallMsg, blockNumber *big.Int) ([]SimulatedCall, error) {
+	args := make([]map[string]interface{}, len(calls))
+	for i, call := range calls {
+		arg := map[string]interface{}{
+			"from": call.From,
+			"to":   call.To,
+		}
+		if len(call.Data) > 0 {
+			arg["input"] = hexutil.Bytes(call.Data)
+		}
+		if call.Value != nil {
+			arg["value"] = (*hexutil.Big)(call.Value)
+		}
+		if call.Gas != 0 {
+			arg["gas"] = hexutil.Uint64(call.Gas)
+		}
+		args[i] = arg
+	}
+	opts := map[string]interface{}{
+		"blockStateCalls": []map[string]interface{}{
+			{"calls": args},
+		},
+	}
+	block := "latest"
+	if blockNumber != nil {
+		block = hexutil.EncodeBig(blockNumber)
+	}
+
+	var blocks []struct {
+		Calls []simulateCallResult `json:"calls"`
+	}
+	if err := c.Client.Client().CallContext(ctx, &blocks, "eth_simulateV1", opts, block); err != nil {
+		return nil, fmt.Errorf("error simulating calls (the Execution client must support eth_simulateV1): %w", err)
+	}
+	if len(blocks) != 1 || len(blocks[0].Calls) != len(calls) {
+		return nil, fmt.Errorf("expected 1 simulated block with %d calls", len(calls))
+	}
+
+	results := make([]SimulatedCall, len(calls))
+	for i, call := range blocks[0].Calls {
+		if call.Status == hexutil.Uint64(types.ReceiptStatusSuccessful) {
+			results[i].ReturnData = call.ReturnData
+			continue
+		}
+		if call.Error == nil {
+			results[i].Error = "call failed"
+			continue
+		}
+		results[i].Error = call.Error.Message
+		if call.Error.Data != nil {
+			// Only calls that reverted have revert data; other execution errors (e.g. running out of gas) don't
+			results[i].Reverted = true
+			results[i].RevertData = *call.Error.Data
+		}
+	}
+	return results, nil
+}
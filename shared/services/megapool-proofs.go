@@ -0,0 +1,222 @@
+package services
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/bindings/megapool"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/types/eth2/fork/fulu"
+	"github.com/rocket-pool/smartnode/shared/types/eth2/fork/gloas"
+	"github.com/rocket-pool/smartnode/shared/types/eth2/generic"
+)
+
+// The number of witnesses that prove a state root against its block root
+var blockHeaderProofDepth = bits.Len64(generic.BeaconBlockHeaderStateRootGeneralizedIndex) - 1
+
+// VerifyValidatorProof checks a validator proof and its slot proof against the block and state roots
+// our own Beacon Node reports for the proven slot, so a bad proof is caught before a transaction is
+// submitted with it.
+func VerifyValidatorProof(bc beacon.Client, validatorProof megapool.ValidatorProof, slotProof megapool.SlotProof) error {
+	anchor, eth2Config, err := getProofAnchor(bc, slotProof.Slot)
+	if err != nil {
+		return err
+	}
+	return verifyValidatorProof(eth2Config, anchor, validatorProof, slotProof)
+}
+
+// VerifyFinalBalanceProof checks every proof passed to NotifyFinalBalance against the block and state
+// roots our own Beacon Node reports for the proven slot. It doesn't matter whether the withdrawal
+// proof was built locally or fetched from the Rocket Pool API.
+func VerifyFinalBalanceProof(bc beacon.Client, withdrawalProof megapool.WithdrawalProof, validatorProof megapool.ValidatorProof, slotProof megapool.SlotProof) error {
+	anchor, eth2Config, err := getProofAnchor(bc, slotProof.Slot)
+	if err != nil {
+		return err
+	}
+	if err := verifyValidatorProof(eth2Config, anchor, validatorProof, slotProof); err != nil {
+		return err
+	}
+	return verifyWithdrawalProof(eth2Config, anchor, withdrawalProof)
+}
+
+// Get the header of the block the proofs are rooted in from the Beacon Node
+func getProofAnchor(bc beacon.Client, slot uint64) (beacon.BeaconBlockHeader, beacon.Eth2Config, error) {
+	eth2Config, err := bc.GetEth2Config()
+	if err != nil {
+		return beacon.BeaconBlockHeader{}, beacon.Eth2Config{}, err
+	}
+	header, exists, err := bc.GetBeaconBlockHeader(strconv.FormatUint(slot, 10))
+	if err != nil {
+		return beacon.BeaconBlockHeader{}, beacon.Eth2Config{}, fmt.Errorf("error getting the beacon block header for slot %d: %w", slot, err)
+	}
+	if !exists {
+		return beacon.BeaconBlockHeader{}, beacon.Eth2Config{}, fmt.Errorf("the proofs are for slot %d, but the Beacon Node doesn't have a block for that slot", slot)
+	}
+	if header.Root == (common.Hash{}) || header.StateRoot == (common.Hash{}) {
+		return beacon.BeaconBlockHeader{}, beacon.Eth2Config{}, fmt.Errorf("the Beacon Node did not report the block and state roots for slot %d", slot)
+	}
+	return header, eth2Config, nil
+}
+
+// Check the validator and slot proofs against the anchor block
+func verifyValidatorProof(eth2Config beacon.Eth2Config, anchor beacon.BeaconBlockHeader, validatorProof megapool.ValidatorProof, slotProof megapool.SlotProof) error {
+	if slotProof.Slot != anchor.Slot {
+		return fmt.Errorf("the slot proof is for slot %d but the anchor block is for slot %d", slotProof.Slot, anchor.Slot)
+	}
+	if validatorProof.ValidatorIndex == nil || !validatorProof.ValidatorIndex.IsUint64() {
+		return fmt.Errorf("the validator proof has an invalid validator index")
+	}
+	validatorIndex := validatorProof.ValidatorIndex.Uint64()
+	isGloas := anchor.Slot >= eth2Config.GloasActivationSlot()
+
+	// Check the validator proof
+	if len(validatorProof.Validator.Pubkey) != 48 {
+		return fmt.Errorf("the validator proof for validator %d has a %d byte pubkey", validatorIndex, len(validatorProof.Validator.Pubkey))
+	}
+	validator := generic.Validator{
+		Pubkey:                     validatorProof.Validator.Pubkey,
+		WithdrawalCredentials:      validatorProof.Validator.WithdrawalCredentials[:],
+		EffectiveBalance:           validatorProof.Validator.EffectiveBalance,
+		Slashed:                    validatorProof.Validator.Slashed,
+		ActivationEligibilityEpoch: validatorProof.Validator.ActivationEligibilityEpoch,
+		ActivationEpoch:            validatorProof.Validator.ActivationEpoch,
+		ExitEpoch:                  validatorProof.Validator.ExitEpoch,
+		WithdrawableEpoch:          validatorProof.Validator.WithdrawableEpoch,
+	}
+	validatorLeaf, err := generic.SSZ.HashTreeRoot(&validator)
+	if err != nil {
+		return fmt.Errorf("error getting the hash tree root of validator %d: %w", validatorIndex, err)
+	}
+	var validatorGindex uint64
+	if isGloas {
+		validatorGindex = gloas.GetGeneralizedIndexForValidator(validatorIndex)
+	} else {
+		validatorGindex = generic.GetGeneralizedIndexForValidator(validatorIndex, fulu.GetGeneralizedIndexForValidators())
+	}
+	if err := verifyStateProof(anchor, validatorLeaf, validatorProof.Witnesses, validatorGindex); err != nil {
+		return fmt.Errorf("the validator proof for validator %d is invalid: %w", validatorIndex, err)
+	}
+
+	// Check the slot proof
+	var slotLeaf [32]byte
+	binary.LittleEndian.PutUint64(slotLeaf[:8], slotProof.Slot)
+	var slotGindex uint64
+	if isGloas {
+		slotGindex = gloas.GetGeneralizedIndexForSlot()
+	} else {
+		slotGindex = fulu.GetGeneralizedIndexForSlot()
+	}
+	if err := verifyStateProof(anchor, slotLeaf, slotProof.Witnesses, slotGindex); err != nil {
+		return fmt.Errorf("the slot proof for slot %d is invalid: %w", slotProof.Slot, err)
+	}
+
+	return nil
+}
+
+// Check the withdrawal proof against the anchor block
+func verifyWithdrawalProof(eth2Config beacon.Eth2Config, anchor beacon.BeaconBlockHeader, withdrawalProof megapool.WithdrawalProof) error {
+	withdrawal := generic.Withdrawal{
+		Index:          withdrawalProof.Withdrawal.Index,
+		ValidatorIndex: withdrawalProof.Withdrawal.ValidatorIndex,
+		Address:        withdrawalProof.Withdrawal.WithdrawalCredentials,
+		Amount:         withdrawalProof.Withdrawal.AmountInGwei,
+	}
+	withdrawalLeaf, err := generic.SSZ.HashTreeRoot(&withdrawal)
+	if err != nil {
+		return fmt.Errorf("error getting the hash tree root of withdrawal %d: %w", withdrawal.Index, err)
+	}
+	withdrawalGindex, err := getWithdrawalProofGindex(eth2Config, anchor.Slot, withdrawalProof.WithdrawalSlot, uint64(withdrawalProof.WithdrawalNum))
+	if err != nil {
+		return err
+	}
+	if err := verifyStateProof(anchor, withdrawalLeaf, withdrawalProof.Witnesses, withdrawalGindex); err != nil {
+		return fmt.Errorf("the withdrawal proof for withdrawal %d of validator %d at slot %d is invalid: %w", withdrawal.Index, withdrawal.ValidatorIndex, withdrawalProof.WithdrawalSlot, err)
+	}
+	return nil
+}
+
+// Get the gindex of a withdrawal relative to the state root of the anchor block. This mirrors the
+// layouts built by GetWithdrawalProofForSlot and getGloasWithdrawalProofForSlot:
+//
+//	pre-Gloas withdrawals are proven to their block root, which sits in block_roots (recent slots) or
+//	in the block_summary_root of a historical summary (historical slots)
+//
+//	Gloas withdrawals are proven to the state root of their slot via payload_expected_withdrawals,
+//	which sits in state_roots (recent slots) or in the state_summary_root of a historical summary
+//	(historical slots)
+func getWithdrawalProofGindex(eth2Config beacon.Eth2Config, anchorSlot uint64, withdrawalSlot uint64, indexInWithdrawalsArray uint64) (uint64, error) {
+	if withdrawalSlot > anchorSlot {
+		return 0, fmt.Errorf("the withdrawal slot %d is after the proven slot %d", withdrawalSlot, anchorSlot)
+	}
+	if indexInWithdrawalsArray >= generic.BeaconBlockWithdrawalsArrayMax {
+		return 0, fmt.Errorf("the withdrawal number %d is out of range", indexInWithdrawalsArray)
+	}
+	gloasSlot := eth2Config.GloasActivationSlot()
+	anchorIsGloas := anchorSlot >= gloasSlot
+	withdrawalIsGloas := withdrawalSlot >= gloasSlot
+
+	// Withdrawal -> block or state root of the withdrawal slot
+	var withdrawalGindex uint64
+	var rootsGindex uint64
+	var summaryRootGindex uint64
+	if withdrawalIsGloas {
+		withdrawalGindex = gloas.GetGeneralizedIndexForExpectedWithdrawal(indexInWithdrawalsArray)
+		rootsGindex = gloas.GetGeneralizedIndexForStateRoots()
+		summaryRootGindex = 3 // state_summary_root
+	} else {
+		withdrawalGindex = fulu.GetGeneralizedIndexForWithdrawal(indexInWithdrawalsArray)
+		if anchorIsGloas {
+			rootsGindex = gloas.GetGeneralizedIndexForBlockRoots()
+		} else {
+			rootsGindex = fulu.GetGeneralizedIndexForBlockRoots()
+		}
+		summaryRootGindex = 2 // block_summary_root
+	}
+
+	// Recent slot: block or state root of the withdrawal slot -> anchor state root
+	if withdrawalSlot+generic.SlotsPerHistoricalRoot > anchorSlot {
+		rootGindex := generic.GetGeneralizedIndexForVectorElement(rootsGindex, generic.SlotsPerHistoricalRoot, withdrawalSlot%generic.SlotsPerHistoricalRoot)
+		return generic.ConcatGindices(rootGindex, withdrawalGindex), nil
+	}
+
+	// Historical slot: block or state root of the withdrawal slot -> historical summary -> anchor state root
+	var summariesGindex uint64
+	if anchorIsGloas {
+		summariesGindex = gloas.GetGeneralizedIndexForHistoricalSummaries()
+	} else {
+		summariesGindex = fulu.GetGeneralizedIndexForHistoricalSummaries()
+	}
+	summaryGindex := generic.GetGeneralizedIndexForListElement(summariesGindex, generic.BeaconStateHistoricalSummariesMaxLength, withdrawalSlot/generic.SlotsPerHistoricalRoot)
+	rootGindex := generic.GetGeneralizedIndexForVectorElement(summaryRootGindex, generic.SlotsPerHistoricalRoot, withdrawalSlot%generic.SlotsPerHistoricalRoot)
+	return generic.ConcatGindices(summaryGindex, rootGindex, withdrawalGindex), nil
+}
+
+// Check a proof made of a state proof followed by a block header proof against the anchor block's
+// state root and block root
+func verifyStateProof(anchor beacon.BeaconBlockHeader, leaf [32]byte, witnesses [][32]byte, stateGindex uint64) error {
+	if len(witnesses) < blockHeaderProofDepth {
+		return fmt.Errorf("it only has %d witnesses", len(witnesses))
+	}
+	split := len(witnesses) - blockHeaderProofDepth
+
+	stateRoot, err := generic.GetRootFromMerkleBranch(leaf, witnesses[:split], stateGindex)
+	if err != nil {
+		return err
+	}
+	if stateRoot != anchor.StateRoot {
+		return fmt.Errorf("it resolves to state root %s but the Beacon Node reports %s for slot %d", common.Hash(stateRoot).Hex(), anchor.StateRoot.Hex(), anchor.Slot)
+	}
+
+	blockRoot, err := generic.GetRootFromMerkleBranch(stateRoot, witnesses[split:], generic.BeaconBlockHeaderStateRootGeneralizedIndex)
+	if err != nil {
+		return err
+	}
+	if blockRoot != anchor.Root {
+		return fmt.Errorf("it resolves to block root %s but the Beacon Node reports %s for slot %d", common.Hash(blockRoot).Hex(), anchor.Root.Hex(), anchor.Slot)
+	}
+	return nil
+}
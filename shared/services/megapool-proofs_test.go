@@ -0,0 +1,459 @@
+package services
+
+import (
+	"bytes"
+	"math/big"
+	"math/bits"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/bindings/megapool"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/types/eth2/fork/fulu"
+	"github.com/rocket-pool/smartnode/shared/types/eth2/fork/gloas"
+	"github.com/rocket-pool/smartnode/shared/types/eth2/generic"
+)
+
+func minimalGloasState(slot uint64) *gloas.BeaconState {
+	state := &gloas.BeaconState{
+		GenesisValidatorsRoot: make([]byte, 32),
+		Slot:                  slot,
+		Fork: &generic.Fork{
+			PreviousVersion: make([]byte, 4),
+			CurrentVersion:  make([]byte, 4),
+		},
+		LatestBlockHeader: &generic.BeaconBlockHeader{
+			Slot:       slot,
+			ParentRoot: make([]byte, 32),
+			StateRoot:  make([]byte, 32),
+			BodyRoot:   make([]byte, 32),
+		},
+		Eth1Data: &generic.Eth1Data{
+			DepositRoot: make([]byte, 32),
+			BlockHash:   make([]byte, 32),
+		},
+		RandaoMixes:                  make([][]byte, 65536),
+		Slashings:                    make([]uint64, 8192),
+		PreviousJustifiedCheckpoint:  &generic.Checkpoint{Root: make([]byte, 32)},
+		CurrentJustifiedCheckpoint:   &generic.Checkpoint{Root: make([]byte, 32)},
+		FinalizedCheckpoint:          &generic.Checkpoint{Root: make([]byte, 32)},
+		CurrentSyncCommittee:         &generic.SyncCommittee{PubKeys: make([][]byte, 512)},
+		NextSyncCommittee:            &generic.SyncCommittee{PubKeys: make([][]byte, 512)},
+		ProposerLookahead:            make([]uint64, 64),
+		ExecutionPayloadAvailability: make([]byte, 1024),
+		LatestExecutionPayloadBid:    &gloas.ExecutionPayloadBid{},
+	}
+	for i := range state.RandaoMixes {
+		state.RandaoMixes[i] = make([]byte, 32)
+	}
+	for i := range state.CurrentSyncCommittee.PubKeys {
+		state.CurrentSyncCommittee.PubKeys[i] = make([]byte, 48)
+		state.NextSyncCommittee.PubKeys[i] = make([]byte, 48)
+	}
+	for i := range state.BuilderPendingPayments {
+		state.BuilderPendingPayments[i] = &gloas.BuilderPendingPayment{Withdrawal: &gloas.BuilderPendingWithdrawal{}}
+	}
+	return state
+}
+
+func minimalFuluState(slot uint64) *fulu.BeaconState {
+	state := &fulu.BeaconState{
+		GenesisValidatorsRoot: make([]byte, 32),
+		Slot:                  slot,
+		Fork: &generic.Fork{
+			PreviousVersion: make([]byte, 4),
+			CurrentVersion:  make([]byte, 4),
+		},
+		LatestBlockHeader: &generic.BeaconBlockHeader{
+			Slot:       slot,
+			ParentRoot: make([]byte, 32),
+			StateRoot:  make([]byte, 32),
+			BodyRoot:   make([]byte, 32),
+		},
+		Eth1Data: &generic.Eth1Data{
+			DepositRoot: make([]byte, 32),
+			BlockHash:   make([]byte, 32),
+		},
+		RandaoMixes:                  make([][]byte, 65536),
+		Slashings:                    make([]uint64, 8192),
+		PreviousJustifiedCheckpoint:  &generic.Checkpoint{Root: make([]byte, 32)},
+		CurrentJustifiedCheckpoint:   &generic.Checkpoint{Root: make([]byte, 32)},
+		FinalizedCheckpoint:          &generic.Checkpoint{Root: make([]byte, 32)},
+		CurrentSyncCommittee:         &generic.SyncCommittee{PubKeys: make([][]byte, 512)},
+		NextSyncCommittee:            &generic.SyncCommittee{PubKeys: make([][]byte, 512)},
+		LatestExecutionPayloadHeader: &generic.ExecutionPayloadHeader{},
+		ProposerLookahead:            make([]uint64, 64),
+	}
+	for i := range state.RandaoMixes {
+		state.RandaoMixes[i] = make([]byte, 32)
+	}
+	for i := range state.CurrentSyncCommittee.PubKeys {
+		state.CurrentSyncCommittee.PubKeys[i] = make([]byte, 48)
+		state.NextSyncCommittee.PubKeys[i] = make([]byte, 48)
+	}
+	return state
+}
+
+func TestVerifyFinalBalanceProofFulu(t *testing.T) {
+	eth2Config := beacon.Eth2Config{SlotsPerEpoch: 32, GloasForkEpoch: beacon.FarFutureEpoch}
+
+	// A real block with withdrawals, in the Fulu block layout
+	blockBytes, err := os.ReadFile("../types/eth2/testdata/block_11900001.ssz")
+	if err != nil {
+		t.Fatalf("reading block fixture: %v", err)
+	}
+	block := &fulu.SignedBeaconBlock{}
+	if err := generic.SSZ.UnmarshalSSZ(block, blockBytes); err != nil {
+		t.Fatalf("decoding block fixture: %v", err)
+	}
+	withdrawalSlot := block.Block.Slot
+	withdrawalNum := uint64(len(block.Withdrawals()) - 1)
+	withdrawal := block.Withdrawals()[withdrawalNum]
+	blockRoot, err := generic.SSZ.HashTreeRoot(block.Block)
+	if err != nil {
+		t.Fatalf("block root: %v", err)
+	}
+
+	// The state the proofs are anchored in, which has the block in its recent block roots
+	anchorSlot := withdrawalSlot + 10
+	state := minimalFuluState(anchorSlot)
+	state.BlockRoots[withdrawalSlot%generic.SlotsPerHistoricalRoot] = blockRoot
+	address := common.Address(withdrawal.Address)
+	state.Validators = []*generic.Validator{
+		{Pubkey: bytes.Repeat([]byte{0x01}, 48), WithdrawalCredentials: make([]byte, 32)},
+		{Pubkey: bytes.Repeat([]byte{0x02}, 48), WithdrawalCredentials: CalculateMegapoolWithdrawalCredentials(address).Bytes(), ExitEpoch: 2, WithdrawableEpoch: 2},
+	}
+	state.Balances = []uint64{32_000_000_000, 0}
+
+	// Build the proofs the same way GetWithdrawalProofForSlot does for recent pre-Gloas slots
+	validatorWitnesses, slotWitnesses, err := state.ValidatorAndSlotProof(1)
+	if err != nil {
+		t.Fatalf("validator proof: %v", err)
+	}
+	blockWithdrawalProof, err := block.ProveWithdrawal(withdrawalNum)
+	if err != nil {
+		t.Fatalf("block withdrawal proof: %v", err)
+	}
+	blockRootProof, err := state.BlockRootProof(withdrawalSlot)
+	if err != nil {
+		t.Fatalf("block root proof: %v", err)
+	}
+	blockHeaderProof, err := state.BlockHeaderProof()
+	if err != nil {
+		t.Fatalf("block header proof: %v", err)
+	}
+	withdrawalWitnesses := append(append(blockWithdrawalProof, blockRootProof...), blockHeaderProof...)
+
+	validator := state.Validators[1]
+	validatorProof := megapool.ValidatorProof{
+		ValidatorIndex: big.NewInt(1),
+		Validator: megapool.ProvedValidator{
+			Pubkey:                validator.Pubkey,
+			WithdrawalCredentials: [32]byte(validator.WithdrawalCredentials),
+			ExitEpoch:             validator.ExitEpoch,
+			WithdrawableEpoch:     validator.WithdrawableEpoch,
+		},
+		Witnesses: ConvertToFixedSize(validatorWitnesses),
+	}
+	slotProof := megapool.SlotProof{
+		Slot:      anchorSlot,
+		Witnesses: ConvertToFixedSize(slotWitnesses),
+	}
+	withdrawalProof := megapool.WithdrawalProof{
+		WithdrawalSlot: withdrawalSlot,
+		WithdrawalNum:  uint16(withdrawalNum),
+		Withdrawal: megapool.Withdrawal{
+			Index:                 withdrawal.Index,
+			ValidatorIndex:        withdrawal.ValidatorIndex,
+			WithdrawalCredentials: address,
+			AmountInGwei:          withdrawal.Amount,
+		},
+		Witnesses: ConvertToFixedSize(withdrawalWitnesses),
+	}
+
+	// The anchor block as the Beacon Node would report it
+	stateRoot, err := generic.SSZ.HashTreeRoot(state)
+	if err != nil {
+		t.Fatalf("state root: %v", err)
+	}
+	header := *state.LatestBlockHeader
+	header.StateRoot = stateRoot[:]
+	anchorRoot, err := generic.SSZ.HashTreeRoot(&header)
+	if err != nil {
+		t.Fatalf("anchor block root: %v", err)
+	}
+	anchor := beacon.BeaconBlockHeader{Slot: anchorSlot, Root: anchorRoot, StateRoot: stateRoot}
+
+	if err := verifyValidatorProof(eth2Config, anchor, validatorProof, slotProof); err != nil {
+		t.Fatalf("expected the validator proof to be valid, got %v", err)
+	}
+	if err := verifyWithdrawalProof(eth2Config, anchor, withdrawalProof); err != nil {
+		t.Fatalf("expected the withdrawal proof to be valid, got %v", err)
+	}
+
+	// A withdrawal the block didn't include isn't covered by the state root
+	withdrawalProof.Withdrawal.AmountInGwei++
+	if err := verifyWithdrawalProof(eth2Config, anchor, withdrawalProof); err == nil {
+		t.Error("expected a changed withdrawal amount to be rejected")
+	}
+	withdrawalProof.Withdrawal.AmountInGwei--
+
+	// Neither is a proof anchored in a different state
+	anchor.StateRoot[0] ^= 0xff
+	if err := verifyWithdrawalProof(eth2Config, anchor, withdrawalProof); err == nil {
+		t.Error("expected a different state root to be rejected")
+	}
+	if err := verifyValidatorProof(eth2Config, anchor, validatorProof, slotProof); err == nil {
+		t.Error("expected a different state root to be rejected")
+	}
+}
+
+func TestVerifyFinalBalanceProof(t *testing.T) {
+	eth2Config := beacon.Eth2Config{SlotsPerEpoch: 32, GloasForkEpoch: 0}
+	address := common.HexToAddress("0x1234")
+
+	// The state that committed to the withdrawal
+	withdrawalState := minimalGloasState(90)
+	withdrawalState.PayloadExpectedWithdrawals = []*generic.Withdrawal{
+		{Index: 10, ValidatorIndex: 0, Address: [20]byte{0xaa}, Amount: 1_000_000_000},
+		{Index: 11, ValidatorIndex: 1, Address: address, Amount: 32_000_000_000},
+	}
+	withdrawalStateRoot, err := generic.SSZ.HashTreeRoot(withdrawalState)
+	if err != nil {
+		t.Fatalf("withdrawal state root: %v", err)
+	}
+
+	// The state the proofs are anchored in
+	state := minimalGloasState(100)
+	state.StateRoots[90] = withdrawalStateRoot
+	state.Validators = []*generic.Validator{
+		{Pubkey: bytes.Repeat([]byte{0x01}, 48), WithdrawalCredentials: make([]byte, 32)},
+		{Pubkey: bytes.Repeat([]byte{0x02}, 48), WithdrawalCredentials: CalculateMegapoolWithdrawalCredentials(address).Bytes(), ExitEpoch: 2, WithdrawableEpoch: 2},
+	}
+	state.Balances = []uint64{32_000_000_000, 0}
+
+	// Build the proofs the same way the proof generators do
+	validatorWitnesses, slotWitnesses, err := state.ValidatorAndSlotProof(1)
+	if err != nil {
+		t.Fatalf("validator proof: %v", err)
+	}
+	expectedWithdrawalProof, err := withdrawalState.ProveExpectedWithdrawal(1)
+	if err != nil {
+		t.Fatalf("expected withdrawal proof: %v", err)
+	}
+	stateRootProof, err := state.StateRootProof(90)
+	if err != nil {
+		t.Fatalf("state root proof: %v", err)
+	}
+	blockHeaderProof, err := state.BlockHeaderProof()
+	if err != nil {
+		t.Fatalf("block header proof: %v", err)
+	}
+	withdrawalWitnesses := append(append(expectedWithdrawalProof, stateRootProof...), blockHeaderProof...)
+
+	validator := state.Validators[1]
+	validatorProof := megapool.ValidatorProof{
+		ValidatorIndex: big.NewInt(1),
+		Validator: megapool.ProvedValidator{
+			Pubkey:                validator.Pubkey,
+			WithdrawalCredentials: [32]byte(validator.WithdrawalCredentials),
+			ExitEpoch:             validator.ExitEpoch,
+			WithdrawableEpoch:     validator.WithdrawableEpoch,
+		},
+		Witnesses: ConvertToFixedSize(validatorWitnesses),
+	}
+	slotProof := megapool.SlotProof{
+		Slot:      100,
+		Witnesses: ConvertToFixedSize(slotWitnesses),
+	}
+	withdrawalProof := megapool.WithdrawalProof{
+		WithdrawalSlot: 90,
+		WithdrawalNum:  1,
+		Withdrawal: megapool.Withdrawal{
+			Index:                 11,
+			ValidatorIndex:        1,
+			WithdrawalCredentials: address,
+			AmountInGwei:          32_000_000_000,
+		},
+		Witnesses: ConvertToFixedSize(withdrawalWitnesses),
+	}
+
+	// The anchor block as the Beacon Node would report it
+	stateRoot, err := generic.SSZ.HashTreeRoot(state)
+	if err != nil {
+		t.Fatalf("state root: %v", err)
+	}
+	header := *state.LatestBlockHeader
+	header.StateRoot = stateRoot[:]
+	blockRoot, err := generic.SSZ.HashTreeRoot(&header)
+	if err != nil {
+		t.Fatalf("block root: %v", err)
+	}
+	anchor := beacon.BeaconBlockHeader{Slot: 100, Root: blockRoot, StateRoot: stateRoot}
+
+	verify := func() error {
+		if err := verifyValidatorProof(eth2Config, anchor, validatorProof, slotProof); err != nil {
+			return err
+		}
+		return verifyWithdrawalProof(eth2Config, anchor, withdrawalProof)
+	}
+	if err := verify(); err != nil {
+		t.Fatalf("expected the proofs to be valid, got %v", err)
+	}
+
+	// A different withdrawal amount doesn't match what the state committed to
+	withdrawalProof.Withdrawal.AmountInGwei++
+	if err := verify(); err == nil {
+		t.Error("expected a changed withdrawal amount to be rejected")
+	}
+	withdrawalProof.Withdrawal.AmountInGwei--
+
+	// Neither does a different validator record
+	validatorProof.Validator.Slashed = true
+	if err := verify(); err == nil {
+		t.Error("expected a changed validator to be rejected")
+	}
+	validatorProof.Validator.Slashed = false
+
+	// A proof anchored in another block is rejected even if it's internally consistent
+	anchor.Root[0] ^= 0xff
+	if err := verify(); err == nil {
+		t.Error("expected a different block root to be rejected")
+	}
+	anchor.Root[0] ^= 0xff
+
+	// So is a proof for a different slot than the anchor block
+	slotProof.Slot = 101
+	if err := verify(); err == nil {
+		t.Error("expected a slot mismatch to be rejected")
+	}
+	slotProof.Slot = 100
+
+	if err := verify(); err != nil {
+		t.Fatalf("expected the restored proofs to be valid, got %v", err)
+	}
+}
+
+func TestGetWithdrawalProofGindex(t *testing.T) {
+	// Pre-Gloas historical layout, matching the 65 witnesses in the example API response:
+	// [withdrawal, summary_block_root, historical_summary, block_header]
+	preGloas := beacon.Eth2Config{SlotsPerEpoch: 32, GloasForkEpoch: beacon.FarFutureEpoch}
+	gindex, err := getWithdrawalProofGindex(preGloas, 2277023, 2244697, 5)
+	if err != nil {
+		t.Fatalf("historical gindex: %v", err)
+	}
+	if depth := bits.Len64(gindex) - 1 + blockHeaderProofDepth; depth != 65 {
+		t.Errorf("historical withdrawal proof: got depth %d want 65", depth)
+	}
+
+	// Recent layout: [withdrawal, block_roots, block_header]
+	gindex, err = getWithdrawalProofGindex(preGloas, 2277023, 2277000, 5)
+	if err != nil {
+		t.Fatalf("recent gindex: %v", err)
+	}
+	if depth := bits.Len64(gindex) - 1 + blockHeaderProofDepth; depth != 39 {
+		t.Errorf("recent withdrawal proof: got depth %d want 39", depth)
+	}
+
+	// Withdrawals can't be proven against an earlier slot
+	if _, err := getWithdrawalProofGindex(preGloas, 100, 101, 0); err == nil {
+		t.Error("expected an error for a withdrawal after the proven slot")
+	}
+	if _, err := getWithdrawalProofGindex(preGloas, 100, 90, generic.BeaconBlockWithdrawalsArrayMax); err == nil {
+		t.Error("expected an error for an out of range withdrawal number")
+	}
+}
+
+// Reports the anchor block of the proofs the way the Beacon Node would
+type proofAnchorBeaconClient struct {
+	beacon.Client
+	eth2Config beacon.Eth2Config
+	headers    map[string]beacon.BeaconBlockHeader
+}
+
+func (bc *proofAnchorBeaconClient) GetEth2Config() (beacon.Eth2Config, error) {
+	return bc.eth2Config, nil
+}
+
+func (bc *proofAnchorBeaconClient) GetBeaconBlockHeader(blockId string) (beacon.BeaconBlockHeader, bool, error) {
+	header, exists := bc.headers[blockId]
+	return header, exists, nil
+}
+
+func TestVerifyValidatorProof(t *testing.T) {
+	address := common.HexToAddress("0x1234")
+	credentials := CalculateMegapoolWithdrawalCredentials(address).Bytes()
+
+	// The validators proven by stake, notify-validator-exit, dissolve and the challenge defense
+	state := minimalGloasState(100)
+	state.Validators = []*generic.Validator{
+		{Pubkey: bytes.Repeat([]byte{0x01}, 48), WithdrawalCredentials: credentials, EffectiveBalance: 1_000_000_000, ActivationEligibilityEpoch: 3, ActivationEpoch: beacon.FarFutureEpoch, ExitEpoch: beacon.FarFutureEpoch, WithdrawableEpoch: beacon.FarFutureEpoch},
+		{Pubkey: bytes.Repeat([]byte{0x02}, 48), WithdrawalCredentials: credentials, EffectiveBalance: 32_000_000_000, ExitEpoch: 2, WithdrawableEpoch: 258},
+		{Pubkey: bytes.Repeat([]byte{0x03}, 48), WithdrawalCredentials: make([]byte, 32), EffectiveBalance: 1_000_000_000, ActivationEpoch: beacon.FarFutureEpoch, ExitEpoch: beacon.FarFutureEpoch, WithdrawableEpoch: beacon.FarFutureEpoch},
+		{Pubkey: bytes.Repeat([]byte{0x04}, 48), WithdrawalCredentials: credentials, EffectiveBalance: 32_000_000_000, ExitEpoch: beacon.FarFutureEpoch, WithdrawableEpoch: beacon.FarFutureEpoch},
+	}
+	state.Balances = []uint64{1_000_000_000, 32_000_000_000, 1_000_000_000, 32_000_000_000}
+
+	// The anchor block as the Beacon Node would report it
+	stateRoot, err := generic.SSZ.HashTreeRoot(state)
+	if err != nil {
+		t.Fatalf("state root: %v", err)
+	}
+	header := *state.LatestBlockHeader
+	header.StateRoot = stateRoot[:]
+	blockRoot, err := generic.SSZ.HashTreeRoot(&header)
+	if err != nil {
+		t.Fatalf("block root: %v", err)
+	}
+	bc := &proofAnchorBeaconClient{
+		eth2Config: beacon.Eth2Config{SlotsPerEpoch: 32, GloasForkEpoch: 0},
+		headers: map[string]beacon.BeaconBlockHeader{
+			"100": {Slot: 100, Root: blockRoot, StateRoot: stateRoot},
+		},
+	}
+
+	for name, validatorIndex := range map[string]uint64{
+		"stake":                 0,
+		"notify validator exit": 1,
+		"dissolve":              2,
+		"challenge defense":     3,
+	} {
+		t.Run(name, func(t *testing.T) {
+			// Build the proofs the same way the transaction paths do
+			validatorProof, slotProof, err := getValidatorProofFromState(state, validatorIndex)
+			if err != nil {
+				t.Fatalf("validator proof: %v", err)
+			}
+			if err := VerifyValidatorProof(bc, validatorProof, slotProof); err != nil {
+				t.Fatalf("expected the proofs to be valid, got %v", err)
+			}
+
+			// A bad witness is caught before a transaction is built with it
+			validatorProof.Witnesses[0][0] ^= 0xff
+			if err := VerifyValidatorProof(bc, validatorProof, slotProof); err == nil {
+				t.Error("expected a bad validator witness to be rejected")
+			}
+			validatorProof.Witnesses[0][0] ^= 0xff
+			slotProof.Witnesses[0][0] ^= 0xff
+			if err := VerifyValidatorProof(bc, validatorProof, slotProof); err == nil {
+				t.Error("expected a bad slot witness to be rejected")
+			}
+			slotProof.Witnesses[0][0] ^= 0xff
+
+			// So is a validator record that doesn't match the state
+			validatorProof.Validator.EffectiveBalance++
+			if err := VerifyValidatorProof(bc, validatorProof, slotProof); err == nil {
+				t.Error("expected a changed validator to be rejected")
+			}
+			validatorProof.Validator.EffectiveBalance--
+
+			// And a proof for a slot the Beacon Node doesn't have a block for
+			slotProof.Slot = 101
+			if err := VerifyValidatorProof(bc, validatorProof, slotProof); err == nil {
+				t.Error("expected a proof for an unknown slot to be rejected")
+			}
+		})
+	}
+}
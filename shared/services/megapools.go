@@ -2,13 +2,11 @@ package services
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
+	"log"
 	"math"
 	"math/big"
-	"net/http"
 	"strconv"
 	"strings"
 	"sync"
@@ -81,40 +79,76 @@ func GetValidatorProof(c *cli.Command, slot uint64, wallet wallet.Wallet, eth2Co
 		return megapool.ValidatorProof{}, 0, megapool.SlotProof{}, err
 	}
 	if beaconState == nil {
-		beaconState, err = GetBeaconState(bc)
+		// Get the proofs from the proof server if the node uses one, falling back to building them locally
+		serverUrl, err := getProofServerUrl(c)
+		if err != nil {
+			return megapool.ValidatorProof{}, 0, megapool.SlotProof{}, err
+		}
+		if serverUrl != "" {
+			proof, slotTimestamp, slotProof, err := getValidatorProofFromServer(bc, serverUrl, slot, validatorIndex64, validatorPubkey)
+			if err == nil {
+				return proof, slotTimestamp, slotProof, nil
+			}
+			log.Printf("Couldn't get the proof for validator %d from the proof server, building it locally instead: %s\n", validatorIndex64, err.Error())
+		}
+
+		// The proof has to be anchored in the requested slot, e.g. the one a withdrawal proof from the proof server is anchored in
+		if slot == 0 {
+			beaconState, err = GetBeaconState(bc)
+		} else {
+			beaconState, err = GetBeaconStateForSlot(bc, slot)
+		}
 		if err != nil {
 			return megapool.ValidatorProof{}, 0, megapool.SlotProof{}, err
 		}
 	}
 
-	// Build the validator and slot proofs from a single state proof tree
-	proofBytes, slotProofBytes, err := beaconState.ValidatorAndSlotProof(validatorIndex64)
+	proof, slotProof, err := getValidatorProofFromState(beaconState, validatorIndex64)
 	if err != nil {
 		return megapool.ValidatorProof{}, 0, megapool.SlotProof{}, err
 	}
 
+	slotTimestamp, err := GetChildBlockTimestampForSlot(c, beaconState.GetSlot())
+	if err != nil {
+		return megapool.ValidatorProof{}, 0, megapool.SlotProof{}, fmt.Errorf("Error getting the slotTimestamp: %w", err)
+	}
+
+	return proof, slotTimestamp, slotProof, err
+}
+
+// Build the validator and slot proofs for a validator from a beacon state
+func getValidatorProofFromState(beaconState eth2.BeaconState, validatorIndex uint64) (megapool.ValidatorProof, megapool.SlotProof, error) {
 	validators := beaconState.GetValidators()
+	if validatorIndex >= uint64(len(validators)) {
+		return megapool.ValidatorProof{}, megapool.SlotProof{}, fmt.Errorf("validator %d does not exist at slot %d", validatorIndex, beaconState.GetSlot())
+	}
+
+	// Build the validator and slot proofs from a single state proof tree
+	proofBytes, slotProofBytes, err := beaconState.ValidatorAndSlotProof(validatorIndex)
+	if err != nil {
+		return megapool.ValidatorProof{}, megapool.SlotProof{}, err
+	}
 
 	// Convert [][]byte to [][32]byte
 	proofWithFixedSize := ConvertToFixedSize(proofBytes)
 
-	withdrawalCredentials := validators[validatorIndex64].WithdrawalCredentials
+	withdrawalCredentials := validators[validatorIndex].WithdrawalCredentials
 	// Convert the WithdrawalCredentials to the fixed size [32]byte
 	var withdrawalCredentialsFixed [32]byte
 	copy(withdrawalCredentialsFixed[:], withdrawalCredentials[:])
 
 	val := megapool.ProvedValidator{
-		Pubkey:                     validatorPubkey[:],
+		Pubkey:                     validators[validatorIndex].Pubkey,
 		WithdrawalCredentials:      withdrawalCredentialsFixed,
-		EffectiveBalance:           validators[validatorIndex64].EffectiveBalance,
-		Slashed:                    validators[validatorIndex64].Slashed,
-		ActivationEligibilityEpoch: validators[validatorIndex64].ActivationEligibilityEpoch,
-		ActivationEpoch:            validators[validatorIndex64].ActivationEpoch,
-		ExitEpoch:                  validators[validatorIndex64].ExitEpoch,
-		WithdrawableEpoch:          validators[validatorIndex64].WithdrawableEpoch,
+		EffectiveBalance:           validators[validatorIndex].EffectiveBalance,
+		Slashed:                    validators[validatorIndex].Slashed,
+		ActivationEligibilityEpoch: validators[validatorIndex].ActivationEligibilityEpoch,
+		ActivationEpoch:            validators[validatorIndex].ActivationEpoch,
+		ExitEpoch:                  validators[validatorIndex].ExitEpoch,
+		WithdrawableEpoch:          validators[validatorIndex].WithdrawableEpoch,
 	}
 	proof := megapool.ValidatorProof{
-		ValidatorIndex: big.NewInt(int64(validatorIndex64)),
+		ValidatorIndex: big.NewInt(int64(validatorIndex)),
 		Validator:      val,
 		Witnesses:      proofWithFixedSize,
 	}
@@ -124,12 +158,7 @@ func GetValidatorProof(c *cli.Command, slot uint64, wallet wallet.Wallet, eth2Co
 		Witnesses: ConvertToFixedSize(slotProofBytes),
 	}
 
-	slotTimestamp, err := GetChildBlockTimestampForSlot(c, beaconState.GetSlot())
-	if err != nil {
-		return megapool.ValidatorProof{}, 0, megapool.SlotProof{}, fmt.Errorf("Error getting the slotTimestamp: %w", err)
-	}
-
-	return proof, slotTimestamp, slotProof, err
+	return proof, slotProof, nil
 }
 
 func GetWithdrawableEpochProof(c *cli.Command, wallet *wallet.Wallet, eth2Config beacon.Eth2Config, megapoolAddress common.Address, validatorPubkey types.ValidatorPubkey) (api.ValidatorWithdrawableEpochProof, error) {
@@ -663,31 +692,15 @@ func GetWithdrawalProofForSlotFromAPI(c *cli.Command, finalizedSlot uint64, with
 	*/
 
 	url := fmt.Sprintf(apiURL, network, finalizedSlot, withdrawalSlot, validatorIndex)
-	response, err := http.Get(url)
-	if err != nil {
-		return megapool.FinalBalanceProof{}, 0, err
-	}
-	defer func() {
-		_ = response.Body.Close()
-	}()
-	body, err := io.ReadAll(response.Body)
-	if err != nil {
-		return megapool.FinalBalanceProof{}, 0, err
-	}
-	// unmarshal the response into the WithdrawalProofResponse type
 	var withdrawalProofResponse WithdrawalProofResponse
-	err = json.Unmarshal([]byte(body), &withdrawalProofResponse)
-	if err != nil {
+	if err := getProofFromServer(url, &withdrawalProofResponse); err != nil {
 		return megapool.FinalBalanceProof{}, 0, err
 	}
+	return withdrawalProofFromResponse(withdrawalProofResponse, validatorIndex), withdrawalProofResponse.Slot, nil
+}
 
-	// Convert []common.Hash to [][32]byte
-	witnesses := make([][32]byte, len(withdrawalProofResponse.Witnesses))
-	for i, w := range withdrawalProofResponse.Witnesses {
-		witnesses[i] = [32]byte(w)
-	}
-
-	// Convert to fixed size
+// Convert a withdrawal proof served by the Rocket Pool API or a proof server
+func withdrawalProofFromResponse(withdrawalProofResponse WithdrawalProofResponse, validatorIndex uint64) megapool.FinalBalanceProof {
 	return megapool.FinalBalanceProof{
 		IndexInWithdrawalsArray: uint(withdrawalProofResponse.WithdrawalNum),
 		WithdrawalIndex:         withdrawalProofResponse.Withdrawal.Index,
@@ -695,11 +708,28 @@ func GetWithdrawalProofForSlotFromAPI(c *cli.Command, finalizedSlot uint64, with
 		WithdrawalSlot:          withdrawalProofResponse.WithdrawalSlot,
 		ValidatorIndex:          validatorIndex,
 		Amount:                  big.NewInt(0).SetUint64(withdrawalProofResponse.Withdrawal.AmountInGwei),
-		Witnesses:               witnesses,
-	}, withdrawalProofResponse.Slot, nil
+		Witnesses:               hashesToFixedSize(withdrawalProofResponse.Witnesses),
+	}
 }
 
 func GetWithdrawalProofForSlot(c *cli.Command, slot uint64, validatorIndex uint64) (megapool.FinalBalanceProof, uint64, eth2.BeaconState, error) {
+	// Get the proof from the proof server if the node uses one, falling back to building it locally
+	serverUrl, err := getProofServerUrl(c)
+	if err != nil {
+		return megapool.FinalBalanceProof{}, 0, nil, err
+	}
+	if serverUrl != "" {
+		proof, proofSlot, err := getWithdrawalProofFromServer(serverUrl, slot, validatorIndex)
+		if err == nil {
+			return proof, proofSlot, nil, nil
+		}
+		log.Printf("Couldn't get the withdrawal proof for validator %d from the proof server, building it locally instead: %s\n", validatorIndex, err.Error())
+	}
+	return getWithdrawalProofForSlot(c, slot, validatorIndex)
+}
+
+// Build a withdrawal proof from the latest finalized beacon state
+func getWithdrawalProofForSlot(c *cli.Command, slot uint64, validatorIndex uint64) (megapool.FinalBalanceProof, uint64, eth2.BeaconState, error) {
 	// Create a new response
 	response := megapool.FinalBalanceProof{}
 	response.ValidatorIndex = validatorIndex
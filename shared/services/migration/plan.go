@@ -0,0 +1,227 @@
+package migration
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/goccy/go-json"
+
+	"github.com/rocket-pool/smartnode/bindings/types"
+)
+
+const planFileMode fs.FileMode = 0644
+
+// The API and the node daemon's task run in the same process, so every read and write of the plan file goes through this
+var planLock sync.Mutex
+
+// Returned when the plan a minipool belongs to was cancelled, or replaced by a new one, while it was being worked on
+var ErrPlanCancelled = errors.New("the migration plan was cancelled")
+
+// Returned when creating a plan while another one is still in progress
+var ErrPlanInProgress = errors.New("a migration plan is already in progress; check it with `rocketpool minipool migrate status` or cancel it first")
+
+// The stage a minipool is at in its migration to the megapool
+type Stage string
+
+const (
+	// The minipool's validator still has to be exited
+	StageExitPending Stage = "exit-pending"
+
+	// The exit was submitted; waiting for the validator's balance to be withdrawn so the minipool can be closed
+	StageExiting Stage = "exiting"
+
+	// The minipool was closed; waiting for enough ETH to queue the replacement megapool deposit
+	StageClosed Stage = "closed"
+
+	// The replacement megapool validator was deposited
+	StageDeposited Stage = "deposited"
+)
+
+// The migration of a single minipool
+type MinipoolMigration struct {
+	MinipoolAddress common.Address        `json:"minipoolAddress"`
+	Stage           Stage                 `json:"stage"`
+	UpdatedTime     time.Time             `json:"updatedTime"`
+	CloseTxHash     common.Hash           `json:"closeTxHash"`
+	DepositTxHash   common.Hash           `json:"depositTxHash"`
+	DepositTxNonce  uint64                `json:"depositTxNonce"`
+	DepositTime     time.Time             `json:"depositTime"`
+	ValidatorPubkey types.ValidatorPubkey `json:"validatorPubkey"`
+	LastError       string                `json:"lastError"`
+
+	// Earlier deposits that weren't included in time and were resubmitted with the same nonce
+	ResubmittedDeposits []DepositAttempt `json:"resubmittedDeposits,omitempty"`
+}
+
+// A megapool deposit transaction submitted for a minipool
+type DepositAttempt struct {
+	TxHash          common.Hash           `json:"txHash"`
+	ValidatorPubkey types.ValidatorPubkey `json:"validatorPubkey"`
+}
+
+// A plan to move a set of minipools to the node's megapool
+type Plan struct {
+	CreatedTime       time.Time           `json:"createdTime"`
+	UseExpressTickets bool                `json:"useExpressTickets"`
+	Minipools         []MinipoolMigration `json:"minipools"`
+}
+
+// Create a new plan with every minipool waiting to be exited
+func NewPlan(minipoolAddresses []common.Address, useExpressTickets bool) *Plan {
+	now := time.Now()
+	plan := &Plan{
+		CreatedTime:       now,
+		UseExpressTickets: useExpressTickets,
+		Minipools:         make([]MinipoolMigration, len(minipoolAddresses)),
+	}
+	for i, address := range minipoolAddresses {
+		plan.Minipools[i] = MinipoolMigration{
+			MinipoolAddress: address,
+			Stage:           StageExitPending,
+			UpdatedTime:     now,
+		}
+	}
+	return plan
+}
+
+// Move a minipool to the next stage, clearing any error from the previous one
+func (m *MinipoolMigration) Advance(stage Stage) {
+	m.Stage = stage
+	m.UpdatedTime = time.Now()
+	m.LastError = ""
+}
+
+// Record why a minipool couldn't move to the next stage
+func (m *MinipoolMigration) Fail(err error) {
+	m.LastError = err.Error()
+	m.UpdatedTime = time.Now()
+}
+
+// Check if every minipool in the plan has been migrated
+func (p *Plan) IsComplete() bool {
+	for _, minipool := range p.Minipools {
+		if minipool.Stage != StageDeposited {
+			return false
+		}
+	}
+	return true
+}
+
+// Get the number of minipools at each stage
+func (p *Plan) StageCounts() map[Stage]int {
+	counts := map[Stage]int{}
+	for _, minipool := range p.Minipools {
+		counts[minipool.Stage]++
+	}
+	return counts
+}
+
+// Simple class to wrap the file holding the node's minipool migration plan
+type PlanManager struct {
+	path string
+}
+
+// Creates a new migration plan manager
+func NewPlanManager(path string) *PlanManager {
+	return &PlanManager{
+		path: path,
+	}
+}
+
+// Gets the plan saved on disk. Returns nil if there isn't one.
+func (m *PlanManager) Load() (*Plan, error) {
+	planLock.Lock()
+	defer planLock.Unlock()
+	return m.load()
+}
+
+// Saves a new plan to disk, then runs start on it and saves the result. The plan file stays locked until start returns,
+// so the node daemon can't work on the plan while it's being started.
+func (m *PlanManager) Create(plan *Plan, start func(plan *Plan)) error {
+	planLock.Lock()
+	defer planLock.Unlock()
+
+	// Only one plan can run at a time
+	existingPlan, err := m.load()
+	if err != nil {
+		return err
+	}
+	if existingPlan != nil && !existingPlan.IsComplete() {
+		return ErrPlanInProgress
+	}
+
+	// Save the plan before starting it so the daemon can pick up where this left off
+	if err := m.save(plan); err != nil {
+		return err
+	}
+	start(plan)
+	return m.save(plan)
+}
+
+// Saves the progress of a single minipool in the plan that was created at createdTime.
+// The plan is reloaded first so progress on other minipools isn't overwritten, and ErrPlanCancelled is returned
+// instead of recreating it if it was deleted or replaced.
+func (m *PlanManager) UpdateMinipool(createdTime time.Time, minipool MinipoolMigration) error {
+	planLock.Lock()
+	defer planLock.Unlock()
+
+	plan, err := m.load()
+	if err != nil {
+		return err
+	}
+	if plan == nil || !plan.CreatedTime.Equal(createdTime) {
+		return ErrPlanCancelled
+	}
+	for i := range plan.Minipools {
+		if plan.Minipools[i].MinipoolAddress == minipool.MinipoolAddress {
+			plan.Minipools[i] = minipool
+			return m.save(plan)
+		}
+	}
+	return fmt.Errorf("minipool %s is not part of the migration plan", minipool.MinipoolAddress.Hex())
+}
+
+// Deletes the plan from disk
+func (m *PlanManager) Delete() error {
+	planLock.Lock()
+	defer planLock.Unlock()
+
+	err := os.Remove(m.path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("error deleting migration plan file [%s]: %w", m.path, err)
+	}
+	return nil
+}
+
+// Reads the plan from disk; the caller must hold planLock
+func (m *PlanManager) load() (*Plan, error) {
+	bytes, err := os.ReadFile(m.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error loading migration plan file [%s]: %w", m.path, err)
+	}
+
+	var plan Plan
+	if err := json.Unmarshal(bytes, &plan); err != nil {
+		return nil, fmt.Errorf("error decoding migration plan file [%s]: %w", m.path, err)
+	}
+	return &plan, nil
+}
+
+// Writes the plan to disk; the caller must hold planLock
+func (m *PlanManager) save(plan *Plan) error {
+	bytes, err := json.Marshal(plan)
+	if err != nil {
+		return fmt.Errorf("error encoding migration plan file: %w", err)
+	}
+	if err := os.WriteFile(m.path, bytes, planFileMode); err != nil {
+		return fmt.Errorf("error writing migration plan file [%s] to disk: %w", m.path, err)
+	}
+	return nil
+}
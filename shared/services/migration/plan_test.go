@@ -0,0 +1,123 @@
+package migration
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestPlanManager(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "minipool-migration")
+	minipoolA := common.HexToAddress("0xAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA")
+	minipoolB := common.HexToAddress("0xBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBB")
+
+	// A missing file means there's no plan
+	m := NewPlanManager(path)
+	plan, err := m.Load()
+	if err != nil {
+		t.Fatalf("unexpected error loading a missing plan: %v", err)
+	}
+	if plan != nil {
+		t.Fatalf("expected no plan, got %v", plan)
+	}
+
+	// A new plan starts with every minipool waiting to exit
+	plan = NewPlan([]common.Address{minipoolA, minipoolB}, true)
+	if counts := plan.StageCounts(); counts[StageExitPending] != 2 {
+		t.Errorf("expected 2 minipools waiting to exit, got %v", counts)
+	}
+	err = m.Create(plan, func(plan *Plan) {
+		plan.Minipools[0].Fail(errors.New("beacon node offline"))
+		plan.Minipools[1].Advance(StageExiting)
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating the plan: %v", err)
+	}
+
+	// Only one plan can be in progress
+	if err := m.Create(NewPlan([]common.Address{minipoolA}, false), func(*Plan) {}); !errors.Is(err, ErrPlanInProgress) {
+		t.Errorf("expected a second plan to be rejected, got %v", err)
+	}
+
+	// The plan survives a reload
+	loaded, err := m.Load()
+	if err != nil {
+		t.Fatalf("unexpected error loading the plan: %v", err)
+	}
+	if !loaded.UseExpressTickets || len(loaded.Minipools) != 2 {
+		t.Fatalf("unexpected plan after reload: %+v", loaded)
+	}
+	if loaded.Minipools[0].Stage != StageExitPending || loaded.Minipools[0].LastError != "beacon node offline" {
+		t.Errorf("unexpected state for %s: %+v", minipoolA.Hex(), loaded.Minipools[0])
+	}
+	if loaded.Minipools[1].Stage != StageExiting || loaded.Minipools[1].LastError != "" {
+		t.Errorf("unexpected state for %s: %+v", minipoolB.Hex(), loaded.Minipools[1])
+	}
+
+	// Advancing clears the last error, and the plan is complete once everything is deposited
+	loaded.Minipools[0].Advance(StageDeposited)
+	if loaded.Minipools[0].LastError != "" {
+		t.Errorf("expected the error to be cleared, got %s", loaded.Minipools[0].LastError)
+	}
+	if loaded.IsComplete() {
+		t.Error("expected the plan to be incomplete")
+	}
+	loaded.Minipools[1].Advance(StageDeposited)
+	if !loaded.IsComplete() {
+		t.Error("expected the plan to be complete")
+	}
+
+	// Updating one minipool keeps the progress saved for the others
+	exiting := loaded.Minipools[1]
+	closed := loaded.Minipools[0]
+	closed.Advance(StageClosed)
+	if err := m.UpdateMinipool(loaded.CreatedTime, closed); err != nil {
+		t.Fatalf("unexpected error updating the plan: %v", err)
+	}
+	exiting.LastError = "stale copy"
+	if err := m.UpdateMinipool(loaded.CreatedTime, exiting); err != nil {
+		t.Fatalf("unexpected error updating the plan: %v", err)
+	}
+	reloaded, err := m.Load()
+	if err != nil {
+		t.Fatalf("unexpected error loading the plan: %v", err)
+	}
+	if reloaded.Minipools[0].Stage != StageClosed || reloaded.Minipools[1].LastError != "stale copy" {
+		t.Errorf("unexpected plan after updating each minipool: %+v", reloaded.Minipools)
+	}
+
+	// Deleting the plan is idempotent
+	if err := m.Delete(); err != nil {
+		t.Fatalf("unexpected error deleting the plan: %v", err)
+	}
+	if err := m.Delete(); err != nil {
+		t.Fatalf("unexpected error deleting a missing plan: %v", err)
+	}
+	if plan, err := m.Load(); err != nil || plan != nil {
+		t.Errorf("expected no plan after deleting it, got %v (%v)", plan, err)
+	}
+
+	// A cancelled plan is never recreated by updates to it
+	if err := m.UpdateMinipool(loaded.CreatedTime, closed); !errors.Is(err, ErrPlanCancelled) {
+		t.Errorf("expected updating a cancelled plan to fail, got %v", err)
+	}
+	if plan, err := m.Load(); err != nil || plan != nil {
+		t.Errorf("expected the cancelled plan to stay deleted, got %v (%v)", plan, err)
+	}
+
+	// Nor are updates applied to a new plan that replaced it
+	replacement := NewPlan([]common.Address{minipoolA}, false)
+	replacement.CreatedTime = loaded.CreatedTime.Add(time.Second)
+	if err := m.Create(replacement, func(*Plan) {}); err != nil {
+		t.Fatalf("unexpected error creating the replacement plan: %v", err)
+	}
+	if err := m.UpdateMinipool(loaded.CreatedTime, closed); !errors.Is(err, ErrPlanCancelled) {
+		t.Errorf("expected updating a replaced plan to fail, got %v", err)
+	}
+	if plan, err := m.Load(); err != nil || plan.Minipools[0].Stage != StageExitPending {
+		t.Errorf("expected the replacement plan to be untouched, got %+v (%v)", plan, err)
+	}
+}
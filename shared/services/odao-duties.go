@@ -0,0 +1,327 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/bindings/dao/trustednode"
+	"github.com/rocket-pool/smartnode/bindings/minipool"
+	"github.com/rocket-pool/smartnode/bindings/network"
+	"github.com/rocket-pool/smartnode/bindings/rewards"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+const (
+	// The default number of days of submissions the oDAO duty report covers; long enough to include a rewards interval
+	DefaultODaoDutiesLookbackDays uint64 = 30
+
+	// How many days before the window submissions are read from, so rounds that reach consensus early in the window
+	// aren't reported as missed when the member submitted just before it started
+	oDaoDutiesSubmissionMarginDays uint64 = 1
+)
+
+// A single report for a round of a duty, from either a member or the consensus, reduced to what's needed to compare them
+type dutyReport struct {
+	Round      string
+	Member     common.Address
+	Value      string
+	EventBlock uint64
+}
+
+// Get how reliably an oDAO member performed each of its duties over the last lookbackDays, compared to the
+// consensus the oDAO reached for each round
+func GetODaoDuties(rp *rocketpool.RocketPool, bc beacon.Client, cfg *config.RocketPoolConfig, member common.Address, lookbackDays uint64) (api.TNDAODuties, error) {
+	if lookbackDays == 0 {
+		lookbackDays = DefaultODaoDutiesLookbackDays
+	}
+	duties := api.TNDAODuties{
+		Member:       member,
+		LookbackDays: lookbackDays,
+	}
+
+	// Get the block range
+	eth2Config, err := bc.GetEth2Config()
+	if err != nil {
+		return duties, err
+	}
+	eventLogInterval, err := cfg.GetEventLogInterval()
+	if err != nil {
+		return duties, err
+	}
+	intervalSize := big.NewInt(int64(eventLogInterval))
+	secondsPerSlot := eth2Config.SecondsPerSlot
+	if secondsPerSlot == 0 {
+		secondsPerSlot = 12
+	}
+	blocksPerDay := uint64(86400) / secondsPerSlot
+	latestHeader, err := rp.Client.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return duties, fmt.Errorf("error getting the latest block: %w", err)
+	}
+	latestBlock := latestHeader.Number.Uint64()
+	if latestBlock > lookbackDays*blocksPerDay {
+		duties.FromBlock = latestBlock - lookbackDays*blocksPerDay + 1
+	}
+	duties.ToBlock = latestBlock
+
+	// Rounds from before the member joined weren't its duty
+	joinedTime, err := trustednode.GetMemberJoinedTime(rp, member, nil)
+	if err != nil {
+		return duties, fmt.Errorf("error getting the member's joined time: %w", err)
+	}
+	if joinedTime > 0 && joinedTime < latestHeader.Time {
+		blocksSinceJoined := (latestHeader.Time - joinedTime) / secondsPerSlot
+		if blocksSinceJoined < latestBlock && latestBlock-blocksSinceJoined > duties.FromBlock {
+			duties.FromBlock = latestBlock - blocksSinceJoined
+		}
+	}
+	submissionsFromBlock := uint64(0)
+	if duties.FromBlock > oDaoDutiesSubmissionMarginDays*blocksPerDay {
+		submissionsFromBlock = duties.FromBlock - oDaoDutiesSubmissionMarginDays*blocksPerDay
+	}
+	toBlock := new(big.Int).SetUint64(latestBlock)
+
+	// Get the submissions and consensus reports for each duty
+	var wg errgroup.Group
+	var balancesSubmissions, balancesConsensus []dutyReport
+	var pricesSubmissions, pricesConsensus []dutyReport
+	var rewardsSubmissions, rewardsConsensus []dutyReport
+	var scrubSubmissions, scrubConsensus []dutyReport
+
+	wg.Go(func() error {
+		submitted, err := network.GetBalancesSubmittedEvents(rp, submissionsFromBlock, toBlock, intervalSize, nil)
+		if err != nil {
+			return fmt.Errorf("error getting balances submissions: %w", err)
+		}
+		updated, err := network.GetBalancesUpdatedEvents(rp, duties.FromBlock, toBlock, intervalSize, nil)
+		if err != nil {
+			return fmt.Errorf("error getting balances updates: %w", err)
+		}
+		balancesSubmissions = make([]dutyReport, len(submitted))
+		for i, report := range submitted {
+			balancesSubmissions[i] = getBalancesDutyReport(report)
+		}
+		balancesConsensus = make([]dutyReport, len(updated))
+		for i, report := range updated {
+			balancesConsensus[i] = getBalancesDutyReport(report)
+		}
+		return nil
+	})
+	wg.Go(func() error {
+		submitted, err := network.GetPricesSubmittedEvents(rp, submissionsFromBlock, toBlock, intervalSize, nil)
+		if err != nil {
+			return fmt.Errorf("error getting prices submissions: %w", err)
+		}
+		updated, err := network.GetPricesUpdatedEvents(rp, duties.FromBlock, toBlock, intervalSize, nil)
+		if err != nil {
+			return fmt.Errorf("error getting prices updates: %w", err)
+		}
+		pricesSubmissions = make([]dutyReport, len(submitted))
+		for i, report := range submitted {
+			pricesSubmissions[i] = getPricesDutyReport(report)
+		}
+		pricesConsensus = make([]dutyReport, len(updated))
+		for i, report := range updated {
+			pricesConsensus[i] = getPricesDutyReport(report)
+		}
+		return nil
+	})
+	wg.Go(func() error {
+		submitted, err := rewards.GetRewardSnapshotSubmittedEvents(rp, submissionsFromBlock, toBlock, intervalSize, nil)
+		if err != nil {
+			return fmt.Errorf("error getting rewards tree submissions: %w", err)
+		}
+		snapshots, err := rewards.GetRewardSnapshotEvents(rp, duties.FromBlock, toBlock, intervalSize, nil)
+		if err != nil {
+			return fmt.Errorf("error getting rewards snapshots: %w", err)
+		}
+		rewardsSubmissions = make([]dutyReport, len(submitted))
+		for i, report := range submitted {
+			rewardsSubmissions[i] = getRewardsDutyReport(report)
+		}
+		rewardsConsensus = make([]dutyReport, len(snapshots))
+		for i, report := range snapshots {
+			rewardsConsensus[i] = getRewardsDutyReport(report)
+		}
+		return nil
+	})
+	wg.Go(func() error {
+		votes, err := minipool.GetScrubVotedEvents(rp, &member, submissionsFromBlock, toBlock, intervalSize)
+		if err != nil {
+			return fmt.Errorf("error getting scrub votes: %w", err)
+		}
+		scrubbed, err := minipool.GetMinipoolScrubbedEvents(rp, duties.FromBlock, toBlock, intervalSize)
+		if err != nil {
+			return fmt.Errorf("error getting scrubbed minipools: %w", err)
+		}
+		scrubSubmissions = make([]dutyReport, len(votes))
+		for i, vote := range votes {
+			scrubSubmissions[i] = dutyReport{
+				Round:      vote.Minipool.Hex(),
+				Member:     vote.Member,
+				EventBlock: vote.EventBlock,
+			}
+		}
+
+		// The events are matched by signature, so make sure they came from real minipools
+		scrubConsensus = []dutyReport{}
+		for _, scrub := range scrubbed {
+			exists, err := minipool.GetMinipoolExists(rp, scrub.Minipool, nil)
+			if err != nil {
+				return fmt.Errorf("error checking if %s is a minipool: %w", scrub.Minipool.Hex(), err)
+			}
+			if exists {
+				scrubConsensus = append(scrubConsensus, dutyReport{
+					Round:      scrub.Minipool.Hex(),
+					EventBlock: scrub.EventBlock,
+				})
+			}
+		}
+		return nil
+	})
+	if err := wg.Wait(); err != nil {
+		return duties, err
+	}
+
+	// Compare the member's submissions to the consensus
+	duties.Rounds = []api.TNDAODutyRound{}
+	duties.Summaries = []api.TNDAODutySummary{}
+	for _, duty := range []struct {
+		duty        api.TNDAODuty
+		submissions []dutyReport
+		consensus   []dutyReport
+	}{
+		{api.TNDAODuty_Balances, balancesSubmissions, balancesConsensus},
+		{api.TNDAODuty_Prices, pricesSubmissions, pricesConsensus},
+		{api.TNDAODuty_Rewards, rewardsSubmissions, rewardsConsensus},
+		{api.TNDAODuty_Scrub, scrubSubmissions, scrubConsensus},
+	} {
+		rounds := getDutyRounds(duty.duty, member, duty.submissions, duty.consensus, duties.FromBlock)
+		duties.Rounds = append(duties.Rounds, rounds...)
+		duties.Summaries = append(duties.Summaries, summarizeDutyRounds(duty.duty, rounds))
+	}
+	return duties, nil
+}
+
+// Work out the member's status for every round of a duty. Every round that reached consensus is included, along with
+// any of the member's submissions since fromBlock for rounds that didn't.
+func getDutyRounds(duty api.TNDAODuty, member common.Address, submissions []dutyReport, consensus []dutyReport, fromBlock uint64) []api.TNDAODutyRound {
+	memberSubmissions := map[string]dutyReport{}
+	for _, submission := range submissions {
+		if submission.Member != member {
+			continue
+		}
+		if _, exists := memberSubmissions[submission.Round]; !exists {
+			memberSubmissions[submission.Round] = submission
+		}
+	}
+
+	rounds := []api.TNDAODutyRound{}
+	finalized := map[string]bool{}
+	for _, report := range consensus {
+		if finalized[report.Round] {
+			continue
+		}
+		finalized[report.Round] = true
+
+		round := api.TNDAODutyRound{
+			Duty:           duty,
+			Round:          report.Round,
+			Status:         api.TNDAODutyStatus_Missed,
+			ConsensusBlock: report.EventBlock,
+		}
+		if submission, exists := memberSubmissions[report.Round]; exists {
+			round.SubmissionBlock = submission.EventBlock
+			round.MatchedConsensus = submission.Value == report.Value
+			if submission.EventBlock <= report.EventBlock {
+				round.Status = api.TNDAODutyStatus_OnTime
+			} else {
+				round.Status = api.TNDAODutyStatus_Late
+			}
+		}
+		rounds = append(rounds, round)
+	}
+
+	for _, submission := range memberSubmissions {
+		if finalized[submission.Round] || submission.EventBlock < fromBlock {
+			continue
+		}
+		rounds = append(rounds, api.TNDAODutyRound{
+			Duty:            duty,
+			Round:           submission.Round,
+			Status:          api.TNDAODutyStatus_Unfinalized,
+			SubmissionBlock: submission.EventBlock,
+		})
+	}
+
+	// Order the rounds by when they happened
+	roundBlock := func(round api.TNDAODutyRound) uint64 {
+		if round.ConsensusBlock != 0 {
+			return round.ConsensusBlock
+		}
+		return round.SubmissionBlock
+	}
+	sort.SliceStable(rounds, func(i, j int) bool {
+		return roundBlock(rounds[i]) < roundBlock(rounds[j])
+	})
+	return rounds
+}
+
+// Count the member's status across the rounds of a duty
+func summarizeDutyRounds(duty api.TNDAODuty, rounds []api.TNDAODutyRound) api.TNDAODutySummary {
+	summary := api.TNDAODutySummary{
+		Duty:   duty,
+		Rounds: len(rounds),
+	}
+	for _, round := range rounds {
+		switch round.Status {
+		case api.TNDAODutyStatus_OnTime:
+			summary.OnTime++
+		case api.TNDAODutyStatus_Late:
+			summary.Late++
+		case api.TNDAODutyStatus_Missed:
+			summary.Missed++
+		case api.TNDAODutyStatus_Unfinalized:
+			summary.Unfinalized++
+		}
+		if (round.Status == api.TNDAODutyStatus_OnTime || round.Status == api.TNDAODutyStatus_Late) && !round.MatchedConsensus {
+			summary.Mismatched++
+		}
+	}
+	return summary
+}
+
+func getBalancesDutyReport(report network.BalancesReport) dutyReport {
+	return dutyReport{
+		Round:      fmt.Sprint(report.Block),
+		Member:     report.Member,
+		Value:      fmt.Sprintf("%s/%s/%s", report.TotalEth, report.StakingEth, report.RethSupply),
+		EventBlock: report.EventBlock,
+	}
+}
+
+func getPricesDutyReport(report network.PricesReport) dutyReport {
+	return dutyReport{
+		Round:      fmt.Sprint(report.Block),
+		Member:     report.Member,
+		Value:      report.RplPrice.String(),
+		EventBlock: report.EventBlock,
+	}
+}
+
+func getRewardsDutyReport(report rewards.RewardSnapshotReport) dutyReport {
+	return dutyReport{
+		Round:      fmt.Sprint(report.Index),
+		Member:     report.Member,
+		Value:      report.MerkleRoot.Hex(),
+		EventBlock: report.EventBlock,
+	}
+}
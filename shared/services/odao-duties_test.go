@@ -0,0 +1,62 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func TestGetDutyRounds(t *testing.T) {
+	member := common.HexToAddress("0x01")
+	other := common.HexToAddress("0x02")
+
+	submissions := []dutyReport{
+		// On time and matching
+		{Round: "100", Member: member, Value: "a", EventBlock: 110},
+		// Late, after the others reached consensus
+		{Round: "200", Member: member, Value: "b", EventBlock: 215},
+		// On time but with a different value to the consensus
+		{Round: "300", Member: member, Value: "x", EventBlock: 305},
+		// Never reached consensus
+		{Round: "500", Member: member, Value: "e", EventBlock: 505},
+		// Submitted before the window for a round that finalized before it too
+		{Round: "50", Member: member, Value: "z", EventBlock: 55},
+		// Other members' submissions don't count
+		{Round: "400", Member: other, Value: "d", EventBlock: 402},
+	}
+	consensus := []dutyReport{
+		{Round: "100", Value: "a", EventBlock: 112},
+		{Round: "200", Value: "b", EventBlock: 210},
+		{Round: "300", Value: "c", EventBlock: 310},
+		{Round: "400", Value: "d", EventBlock: 402},
+	}
+
+	rounds := getDutyRounds(api.TNDAODuty_Balances, member, submissions, consensus, 100)
+	expected := []struct {
+		round   string
+		status  api.TNDAODutyStatus
+		matched bool
+	}{
+		{"100", api.TNDAODutyStatus_OnTime, true},
+		{"200", api.TNDAODutyStatus_Late, true},
+		{"300", api.TNDAODutyStatus_OnTime, false},
+		{"400", api.TNDAODutyStatus_Missed, false},
+		{"500", api.TNDAODutyStatus_Unfinalized, false},
+	}
+	if len(rounds) != len(expected) {
+		t.Fatalf("expected %d rounds, got %d: %v", len(expected), len(rounds), rounds)
+	}
+	for i, want := range expected {
+		got := rounds[i]
+		if got.Round != want.round || got.Status != want.status || got.MatchedConsensus != want.matched {
+			t.Errorf("round %d: expected %s %s (matched %t), got %s %s (matched %t)", i, want.round, want.status, want.matched, got.Round, got.Status, got.MatchedConsensus)
+		}
+	}
+
+	summary := summarizeDutyRounds(api.TNDAODuty_Balances, rounds)
+	if summary.Rounds != 5 || summary.OnTime != 2 || summary.Late != 1 || summary.Missed != 1 || summary.Unfinalized != 1 || summary.Mismatched != 1 {
+		t.Errorf("unexpected summary: %+v", summary)
+	}
+}
@@ -0,0 +1,418 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v3"
+
+	"github.com/rocket-pool/smartnode/bindings/megapool"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/types/eth2"
+)
+
+// The number of finalized states the proof server keeps in memory. Each one can take several hundred
+// MB on mainnet, so only the most recent ones are kept.
+const proofServerCachedStates = 2
+
+// The proof server only caches a newer finalized state once finality has moved this many epochs past
+// the newest state it has, so it isn't downloading a full state every time the task runs
+const proofServerStateRefreshEpochs = 8
+
+// Withdrawal proofs anchored more than this many slots before the newest cached state are dropped.
+// This is half of the EIP-4788 beacon roots window, so served proofs can still be used on-chain.
+const proofServerMaxProofAge = 4096
+
+// Routes served by proof servers (base URL + slot + validator index)
+const (
+	proofServerValidatorRoute  = "%s/api/megapool/proofs/validators/%d/%d"
+	proofServerWithdrawalRoute = "%s/api/megapool/proofs/withdrawals/%d/%d/%d"
+)
+
+// Timeout for requests to a proof server; building a proof from a cached state can take a while
+const proofServerTimeout = 5 * time.Minute
+
+// Returned when a proof is requested for a slot or withdrawal the proof server hasn't cached.
+// Other nodes can only get proofs from the states this node already downloaded, so they can't make it download new ones.
+var ErrProofNotCached = errors.New("the proof server doesn't have a cached state for this proof")
+
+// Caches recent finalized beacon states and the megapool proofs built from them, so they can be
+// served to other nodes without each of them downloading a full beacon state.
+// Validator proofs can be built from the cached states for any validator, but withdrawal proofs are
+// only built for the node's own validators, so other nodes build their final balance proofs themselves.
+// All access is serialized so concurrent cache misses don't download the same state more than once.
+type ProofServer struct {
+	states           []*proofServerState
+	withdrawalProofs map[proofServerWithdrawalKey]api.MegapoolWithdrawalProofResponse
+	lock             sync.Mutex
+}
+
+// A cached beacon state and the validator proofs built from it so far
+type proofServerState struct {
+	state           eth2.BeaconState
+	slotTimestamp   uint64
+	validatorProofs map[uint64]api.MegapoolValidatorProofResponse
+}
+
+// Withdrawal proofs are cached by the slot the withdrawal was searched from and the validator index
+type proofServerWithdrawalKey struct {
+	withdrawalSlot uint64
+	validatorIndex uint64
+}
+
+var (
+	proofServer     *ProofServer
+	initProofServer sync.Once
+)
+
+// Get the node's megapool proof server
+func GetProofServer() *ProofServer {
+	initProofServer.Do(func() {
+		proofServer = &ProofServer{
+			withdrawalProofs: map[proofServerWithdrawalKey]api.MegapoolWithdrawalProofResponse{},
+		}
+	})
+	return proofServer
+}
+
+// Cache the latest finalized state if finality has moved far enough past the newest cached state.
+// Returns the slot of the newest cached state.
+func (p *ProofServer) Refresh(c *cli.Command) (uint64, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	bc, err := GetBeaconClient(c)
+	if err != nil {
+		return 0, err
+	}
+	eth2Config, err := bc.GetEth2Config()
+	if err != nil {
+		return 0, err
+	}
+	head, err := bc.GetBeaconHead()
+	if err != nil {
+		return 0, err
+	}
+	if len(p.states) > 0 {
+		newestSlot := p.states[len(p.states)-1].state.GetSlot()
+		if newestSlot+proofServerStateRefreshEpochs*eth2Config.SlotsPerEpoch > head.FinalizedEpoch*eth2Config.SlotsPerEpoch {
+			return newestSlot, nil
+		}
+	}
+
+	beaconState, err := GetBeaconState(bc)
+	if err != nil {
+		return 0, fmt.Errorf("error getting the finalized beacon state: %w", err)
+	}
+	cached, err := p.addState(c, beaconState)
+	if err != nil {
+		return 0, err
+	}
+	return cached.state.GetSlot(), nil
+}
+
+// Get the proofs for a validator at the given slot, or at the newest cached state if the slot is 0
+func (p *ProofServer) GetValidatorProof(c *cli.Command, slot uint64, validatorIndex uint64) (api.MegapoolValidatorProofResponse, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	var cached *proofServerState
+	if slot == 0 {
+		if len(p.states) > 0 {
+			cached = p.states[len(p.states)-1]
+		}
+	} else {
+		cached = p.getState(slot)
+	}
+
+	// Load the state if it isn't cached yet
+	if cached == nil {
+		bc, err := GetBeaconClient(c)
+		if err != nil {
+			return api.MegapoolValidatorProofResponse{}, err
+		}
+		var beaconState eth2.BeaconState
+		if slot == 0 {
+			beaconState, err = GetBeaconState(bc)
+			if err != nil {
+				return api.MegapoolValidatorProofResponse{}, fmt.Errorf("error getting the finalized beacon state: %w", err)
+			}
+		} else {
+			beaconState, err = GetBeaconStateForSlot(bc, slot)
+			if err != nil {
+				return api.MegapoolValidatorProofResponse{}, err
+			}
+		}
+		cached, err = p.addState(c, beaconState)
+		if err != nil {
+			return api.MegapoolValidatorProofResponse{}, err
+		}
+	}
+
+	return cached.getValidatorProof(validatorIndex)
+}
+
+// Get the proofs for a validator at the given slot, or at the newest cached state if the slot is 0.
+// Unlike GetValidatorProof, this never downloads a state; ErrProofNotCached is returned if the slot isn't cached.
+func (p *ProofServer) GetCachedValidatorProof(slot uint64, validatorIndex uint64) (api.MegapoolValidatorProofResponse, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	var cached *proofServerState
+	if slot == 0 {
+		if len(p.states) > 0 {
+			cached = p.states[len(p.states)-1]
+		}
+	} else {
+		cached = p.getState(slot)
+	}
+	if cached == nil {
+		return api.MegapoolValidatorProofResponse{}, ErrProofNotCached
+	}
+	return cached.getValidatorProof(validatorIndex)
+}
+
+// Get the withdrawal proof for a validator's withdrawal if it has already been built.
+// Unlike GetWithdrawalProof, this never searches for the withdrawal; ErrProofNotCached is returned if it wasn't built yet.
+func (p *ProofServer) GetCachedWithdrawalProof(withdrawalSlot uint64, validatorIndex uint64) (api.MegapoolWithdrawalProofResponse, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	key := proofServerWithdrawalKey{
+		withdrawalSlot: withdrawalSlot,
+		validatorIndex: validatorIndex,
+	}
+	proof, exists := p.withdrawalProofs[key]
+	if !exists {
+		return api.MegapoolWithdrawalProofResponse{}, ErrProofNotCached
+	}
+	return proof, nil
+}
+
+// Get the withdrawal proof for a validator's withdrawal, searching from the given slot
+func (p *ProofServer) GetWithdrawalProof(c *cli.Command, withdrawalSlot uint64, validatorIndex uint64) (api.MegapoolWithdrawalProofResponse, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	key := proofServerWithdrawalKey{
+		withdrawalSlot: withdrawalSlot,
+		validatorIndex: validatorIndex,
+	}
+	if proof, exists := p.withdrawalProofs[key]; exists {
+		return proof, nil
+	}
+
+	proof, proofSlot, beaconState, err := getWithdrawalProofForSlot(c, withdrawalSlot, validatorIndex)
+	if err != nil {
+		return api.MegapoolWithdrawalProofResponse{}, err
+	}
+
+	// Keep the state the proof is anchored in, since the validator proof for the same slot is needed with it
+	if beaconState != nil {
+		if _, err := p.addState(c, beaconState); err != nil {
+			return api.MegapoolWithdrawalProofResponse{}, err
+		}
+	}
+
+	response := api.MegapoolWithdrawalProofResponse{
+		Slot:           proofSlot,
+		WithdrawalSlot: proof.WithdrawalSlot,
+		WithdrawalNum:  uint16(proof.IndexInWithdrawalsArray),
+		Withdrawal: api.ProvenWithdrawal{
+			Index:                 proof.WithdrawalIndex,
+			ValidatorIndex:        validatorIndex,
+			WithdrawalCredentials: proof.WithdrawalAddress,
+			AmountInGwei:          proof.Amount.Uint64(),
+		},
+		Witnesses: fixedSizeToHashes(proof.Witnesses),
+	}
+	p.withdrawalProofs[key] = response
+	return response, nil
+}
+
+// Get the cached state for a slot, if there is one
+func (p *ProofServer) getState(slot uint64) *proofServerState {
+	for _, cached := range p.states {
+		if cached.state.GetSlot() == slot {
+			return cached
+		}
+	}
+	return nil
+}
+
+// Add a state to the cache, evicting the oldest states and any withdrawal proofs that have become too old
+func (p *ProofServer) addState(c *cli.Command, beaconState eth2.BeaconState) (*proofServerState, error) {
+	slot := beaconState.GetSlot()
+	if cached := p.getState(slot); cached != nil {
+		return cached, nil
+	}
+	slotTimestamp, err := GetChildBlockTimestampForSlot(c, slot)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the slot timestamp for slot %d: %w", slot, err)
+	}
+
+	cached := &proofServerState{
+		state:           beaconState,
+		slotTimestamp:   slotTimestamp,
+		validatorProofs: map[uint64]api.MegapoolValidatorProofResponse{},
+	}
+	p.states = append(p.states, cached)
+	sort.Slice(p.states, func(i, j int) bool {
+		return p.states[i].state.GetSlot() < p.states[j].state.GetSlot()
+	})
+	if len(p.states) > proofServerCachedStates {
+		p.states = p.states[len(p.states)-proofServerCachedStates:]
+	}
+
+	newestSlot := p.states[len(p.states)-1].state.GetSlot()
+	for key, proof := range p.withdrawalProofs {
+		if proof.Slot+proofServerMaxProofAge < newestSlot {
+			delete(p.withdrawalProofs, key)
+		}
+	}
+
+	// The state is still returned if it was older than everything in the cache, so the caller can use it once
+	return cached, nil
+}
+
+// Get the proofs for a validator from the cached state, building them if they haven't been yet
+func (s *proofServerState) getValidatorProof(validatorIndex uint64) (api.MegapoolValidatorProofResponse, error) {
+	if proof, exists := s.validatorProofs[validatorIndex]; exists {
+		return proof, nil
+	}
+
+	validatorProof, slotProof, err := getValidatorProofFromState(s.state, validatorIndex)
+	if err != nil {
+		return api.MegapoolValidatorProofResponse{}, err
+	}
+	response := api.MegapoolValidatorProofResponse{
+		Slot:           slotProof.Slot,
+		SlotTimestamp:  s.slotTimestamp,
+		ValidatorIndex: validatorIndex,
+		Validator: api.ProvenValidator{
+			Pubkey:                     types.BytesToValidatorPubkey(validatorProof.Validator.Pubkey),
+			WithdrawalCredentials:      common.Hash(validatorProof.Validator.WithdrawalCredentials),
+			EffectiveBalance:           validatorProof.Validator.EffectiveBalance,
+			Slashed:                    validatorProof.Validator.Slashed,
+			ActivationEligibilityEpoch: validatorProof.Validator.ActivationEligibilityEpoch,
+			ActivationEpoch:            validatorProof.Validator.ActivationEpoch,
+			ExitEpoch:                  validatorProof.Validator.ExitEpoch,
+			WithdrawableEpoch:          validatorProof.Validator.WithdrawableEpoch,
+		},
+		Witnesses:     fixedSizeToHashes(validatorProof.Witnesses),
+		SlotWitnesses: fixedSizeToHashes(slotProof.Witnesses),
+	}
+	s.validatorProofs[validatorIndex] = response
+	return response, nil
+}
+
+// Get the URL of the proof server the node is configured to use, or an empty string if it builds its own proofs
+func getProofServerUrl(c *cli.Command) (string, error) {
+	cfg, err := GetConfig(c)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(cfg.Smartnode.ProofServerUrl.Value.(string), "/"), nil
+}
+
+// Get a validator proof from a proof server and check it against our own Beacon Node
+func getValidatorProofFromServer(bc beacon.Client, serverUrl string, slot uint64, validatorIndex uint64, validatorPubkey types.ValidatorPubkey) (megapool.ValidatorProof, uint64, megapool.SlotProof, error) {
+	var response api.MegapoolValidatorProofResponse
+	if err := getProofFromServer(fmt.Sprintf(proofServerValidatorRoute, serverUrl, slot, validatorIndex), &response); err != nil {
+		return megapool.ValidatorProof{}, 0, megapool.SlotProof{}, err
+	}
+	if response.ValidatorIndex != validatorIndex || response.Validator.Pubkey != validatorPubkey {
+		return megapool.ValidatorProof{}, 0, megapool.SlotProof{}, fmt.Errorf("the proof server returned a proof for validator %d (%s) instead of validator %d (%s)", response.ValidatorIndex, response.Validator.Pubkey.Hex(), validatorIndex, validatorPubkey.Hex())
+	}
+
+	validatorProof := megapool.ValidatorProof{
+		ValidatorIndex: new(big.Int).SetUint64(response.ValidatorIndex),
+		Validator: megapool.ProvedValidator{
+			Pubkey:                     response.Validator.Pubkey.Bytes(),
+			WithdrawalCredentials:      response.Validator.WithdrawalCredentials,
+			EffectiveBalance:           response.Validator.EffectiveBalance,
+			Slashed:                    response.Validator.Slashed,
+			ActivationEligibilityEpoch: response.Validator.ActivationEligibilityEpoch,
+			ActivationEpoch:            response.Validator.ActivationEpoch,
+			ExitEpoch:                  response.Validator.ExitEpoch,
+			WithdrawableEpoch:          response.Validator.WithdrawableEpoch,
+		},
+		Witnesses: hashesToFixedSize(response.Witnesses),
+	}
+	slotProof := megapool.SlotProof{
+		Slot:      response.Slot,
+		Witnesses: hashesToFixedSize(response.SlotWitnesses),
+	}
+	if err := VerifyValidatorProof(bc, validatorProof, slotProof); err != nil {
+		return megapool.ValidatorProof{}, 0, megapool.SlotProof{}, err
+	}
+	return validatorProof, response.SlotTimestamp, slotProof, nil
+}
+
+// Get a withdrawal proof from a proof server. It's checked against our own Beacon Node along with the
+// validator proof once both are available.
+func getWithdrawalProofFromServer(serverUrl string, withdrawalSlot uint64, validatorIndex uint64) (megapool.FinalBalanceProof, uint64, error) {
+	// The proof server anchors its proofs in its own finalized states, so it doesn't need a finalized slot
+	var response WithdrawalProofResponse
+	if err := getProofFromServer(fmt.Sprintf(proofServerWithdrawalRoute, serverUrl, 0, withdrawalSlot, validatorIndex), &response); err != nil {
+		return megapool.FinalBalanceProof{}, 0, err
+	}
+	if response.Withdrawal.ValidatorIndex != validatorIndex {
+		return megapool.FinalBalanceProof{}, 0, fmt.Errorf("the proof server returned a withdrawal for validator %d instead of validator %d", response.Withdrawal.ValidatorIndex, validatorIndex)
+	}
+	return withdrawalProofFromResponse(response, validatorIndex), response.Slot, nil
+}
+
+// Get a proof from the Rocket Pool API or a proof server
+func getProofFromServer(url string, proof any) error {
+	client := http.Client{Timeout: proofServerTimeout}
+	response, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode != http.StatusOK {
+		// Proof servers report the reason in the standard API response
+		var apiResponse api.APIResponse
+		if json.Unmarshal(body, &apiResponse) == nil && apiResponse.Error != "" {
+			return fmt.Errorf("%s returned %s: %s", url, response.Status, apiResponse.Error)
+		}
+		return fmt.Errorf("%s returned %s", url, response.Status)
+	}
+	return json.Unmarshal(body, proof)
+}
+
+// Convert [][32]byte to []common.Hash
+func fixedSizeToHashes(witnesses [][32]byte) []common.Hash {
+	hashes := make([]common.Hash, len(witnesses))
+	for i, w := range witnesses {
+		hashes[i] = common.Hash(w)
+	}
+	return hashes
+}
+
+// Convert []common.Hash to [][32]byte
+func hashesToFixedSize(hashes []common.Hash) [][32]byte {
+	witnesses := make([][32]byte, len(hashes))
+	for i, h := range hashes {
+		witnesses[i] = [32]byte(h)
+	}
+	return witnesses
+}
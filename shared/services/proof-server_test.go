@@ -0,0 +1,140 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/types/eth2/generic"
+)
+
+func TestProofServerValidatorProof(t *testing.T) {
+	state := minimalGloasState(100)
+	state.Validators = []*generic.Validator{
+		{Pubkey: bytes.Repeat([]byte{0x01}, 48), WithdrawalCredentials: make([]byte, 32)},
+		{Pubkey: bytes.Repeat([]byte{0x02}, 48), WithdrawalCredentials: CalculateMegapoolWithdrawalCredentials(common.HexToAddress("0x1234")).Bytes(), EffectiveBalance: 32_000_000_000},
+	}
+	state.Balances = []uint64{32_000_000_000, 32_000_000_000}
+	cached := &proofServerState{
+		state:           state,
+		slotTimestamp:   1234,
+		validatorProofs: map[uint64]api.MegapoolValidatorProofResponse{},
+	}
+
+	proof, err := cached.getValidatorProof(1)
+	if err != nil {
+		t.Fatalf("validator proof: %v", err)
+	}
+	if proof.Slot != 100 || proof.SlotTimestamp != 1234 || proof.ValidatorIndex != 1 {
+		t.Errorf("unexpected proof metadata: slot %d, timestamp %d, index %d", proof.Slot, proof.SlotTimestamp, proof.ValidatorIndex)
+	}
+	if proof.Validator.Pubkey != types.BytesToValidatorPubkey(state.Validators[1].Pubkey) || proof.Validator.EffectiveBalance != 32_000_000_000 {
+		t.Errorf("unexpected validator in proof: %+v", proof.Validator)
+	}
+	if len(proof.Witnesses) == 0 || len(proof.SlotWitnesses) == 0 {
+		t.Errorf("expected witnesses, got %d validator and %d slot witnesses", len(proof.Witnesses), len(proof.SlotWitnesses))
+	}
+
+	// Proofs are built once per state
+	if _, exists := cached.validatorProofs[1]; !exists {
+		t.Error("expected the proof to be cached")
+	}
+
+	// Validators that don't exist in the state are rejected
+	if _, err := cached.getValidatorProof(2); err == nil {
+		t.Error("expected an error for a validator that doesn't exist")
+	}
+}
+
+func TestProofServerOnlyServesCachedProofs(t *testing.T) {
+	state := minimalGloasState(100)
+	state.Validators = []*generic.Validator{
+		{Pubkey: bytes.Repeat([]byte{0x01}, 48), WithdrawalCredentials: make([]byte, 32)},
+	}
+	state.Balances = []uint64{32_000_000_000}
+	server := &ProofServer{
+		withdrawalProofs: map[proofServerWithdrawalKey]api.MegapoolWithdrawalProofResponse{
+			{withdrawalSlot: 50, validatorIndex: 0}: {Slot: 100, WithdrawalSlot: 60},
+		},
+	}
+
+	// Nothing is served before a state is cached
+	if _, err := server.GetCachedValidatorProof(0, 0); !errors.Is(err, ErrProofNotCached) {
+		t.Errorf("expected a missing proof with no cached states, got %v", err)
+	}
+
+	// Cached slots are served, and the newest one is used for slot 0
+	server.states = []*proofServerState{{state: state, validatorProofs: map[uint64]api.MegapoolValidatorProofResponse{}}}
+	for _, slot := range []uint64{0, 100} {
+		proof, err := server.GetCachedValidatorProof(slot, 0)
+		if err != nil || proof.Slot != 100 {
+			t.Errorf("expected a proof at slot 100 for slot %d, got %d (%v)", slot, proof.Slot, err)
+		}
+	}
+
+	// Other slots aren't downloaded
+	if _, err := server.GetCachedValidatorProof(101, 0); !errors.Is(err, ErrProofNotCached) {
+		t.Errorf("expected a missing proof for an uncached slot, got %v", err)
+	}
+	if len(server.states) != 1 {
+		t.Errorf("expected the cached states to be untouched, got %d", len(server.states))
+	}
+
+	// Only withdrawals that were already found are served
+	if proof, err := server.GetCachedWithdrawalProof(50, 0); err != nil || proof.WithdrawalSlot != 60 {
+		t.Errorf("expected the cached withdrawal proof, got %+v (%v)", proof, err)
+	}
+	if _, err := server.GetCachedWithdrawalProof(51, 0); !errors.Is(err, ErrProofNotCached) {
+		t.Errorf("expected a missing withdrawal proof, got %v", err)
+	}
+}
+
+func TestGetProofFromServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/missing") {
+			w.WriteHeader(http.StatusInternalServerError)
+			_ = json.NewEncoder(w).Encode(api.APIResponse{Status: "error", Error: "no withdrawal found"})
+			return
+		}
+		if strings.HasSuffix(r.URL.Path, "/down") {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(api.MegapoolWithdrawalProofResponse{
+			Status:         "success",
+			Slot:           200,
+			WithdrawalSlot: 150,
+			WithdrawalNum:  3,
+			Withdrawal:     api.ProvenWithdrawal{Index: 7, ValidatorIndex: 9, AmountInGwei: 32_000_000_000},
+			Witnesses:      []common.Hash{{0x01}, {0x02}},
+		})
+	}))
+	defer server.Close()
+
+	// The proof server's responses decode into the same type as the Rocket Pool API's
+	var response WithdrawalProofResponse
+	if err := getProofFromServer(server.URL+"/proof", &response); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	proof := withdrawalProofFromResponse(response, 9)
+	if response.Slot != 200 || proof.WithdrawalSlot != 150 || proof.IndexInWithdrawalsArray != 3 || proof.WithdrawalIndex != 7 || len(proof.Witnesses) != 2 || proof.Witnesses[1][0] != 0x02 {
+		t.Errorf("unexpected proof: %+v", proof)
+	}
+
+	// Errors reported by the server are surfaced
+	err := getProofFromServer(server.URL+"/missing", &response)
+	if err == nil || !strings.Contains(err.Error(), "no withdrawal found") {
+		t.Errorf("expected the server's error, got %v", err)
+	}
+	if err := getProofFromServer(server.URL+"/down", &response); err == nil {
+		t.Error("expected an error for a failed request")
+	}
+}
@@ -0,0 +1,148 @@
+package proposals
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/bindings/dao"
+	"github.com/rocket-pool/smartnode/bindings/dao/protocol"
+	"github.com/rocket-pool/smartnode/bindings/network"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Get the next phase change of a Protocol DAO proposal. Returns false if the proposal has no phase changes left.
+func GetPDAOProposalEvent(prop protocol.ProtocolDaoProposalDetails) (api.GovernanceEvent, bool) {
+	event := api.GovernanceEvent{
+		DAO:        api.GovernanceDAO_Protocol,
+		ProposalID: fmt.Sprint(prop.ID),
+		Title:      prop.Message,
+		State:      types.ProtocolDaoProposalStates[prop.State],
+	}
+	switch prop.State {
+	case types.ProtocolDaoProposalState_Pending:
+		event.NextPhase = "Voting starts"
+		event.Time = prop.VotingStartTime
+	case types.ProtocolDaoProposalState_ActivePhase1:
+		event.NextPhase = "Phase 1 (delegate voting) ends"
+		event.Time = prop.Phase1EndTime
+	case types.ProtocolDaoProposalState_ActivePhase2:
+		event.NextPhase = "Phase 2 (vote overrides) ends"
+		event.Time = prop.Phase2EndTime
+	case types.ProtocolDaoProposalState_Succeeded:
+		event.NextPhase = "Execution window closes"
+		event.Time = prop.ExpiryTime
+	default:
+		return event, false
+	}
+	return event, true
+}
+
+// Get the next phase change of an Oracle DAO or Security Council proposal. Returns false if the proposal has no phase changes left.
+func GetDAOProposalEvent(daoName api.GovernanceDAO, prop dao.ProposalDetails) (api.GovernanceEvent, bool) {
+	event := api.GovernanceEvent{
+		DAO:        daoName,
+		ProposalID: fmt.Sprint(prop.ID),
+		Title:      prop.Message,
+		State:      prop.State.String(),
+		Voted:      prop.State == types.Active && prop.MemberVoted,
+	}
+	switch prop.State {
+	case types.Pending:
+		event.NextPhase = "Voting starts"
+		event.Time = time.Unix(int64(prop.StartTime), 0)
+	case types.Active:
+		event.NextPhase = "Voting ends"
+		event.Time = time.Unix(int64(prop.EndTime), 0)
+	case types.Succeeded:
+		event.NextPhase = "Execution window closes"
+		event.Time = time.Unix(int64(prop.ExpiryTime), 0)
+	default:
+		return event, false
+	}
+	return event, true
+}
+
+// Get the next phase change of a Snapshot proposal. Returns false if the proposal has no phase changes left.
+func GetSnapshotProposalEvent(prop api.SnapshotProposal) (api.GovernanceEvent, bool) {
+	event := api.GovernanceEvent{
+		DAO:        api.GovernanceDAO_Snapshot,
+		ProposalID: prop.Id,
+		Title:      prop.Title,
+		State:      prop.State,
+		Link:       prop.Link,
+	}
+	switch prop.State {
+	case "pending":
+		event.NextPhase = "Voting starts"
+		event.Time = time.Unix(prop.Start, 0)
+	case "active":
+		event.NextPhase = "Voting ends"
+		event.Time = time.Unix(prop.End, 0)
+	default:
+		return event, false
+	}
+	return event, true
+}
+
+// Sort governance events by the time of their next phase change, soonest first
+func SortGovernanceEvents(events []api.GovernanceEvent) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Time.Before(events[j].Time)
+	})
+}
+
+// Check if the node has voted on an active Protocol DAO proposal, and if its voting power would go uncast if it doesn't vote in the current phase
+func GetPDAOVoteStatus(rp *rocketpool.RocketPool, prop protocol.ProtocolDaoProposalDetails, nodeAddress common.Address, opts *bind.CallOpts) (bool, bool, error) {
+	if prop.State != types.ProtocolDaoProposalState_ActivePhase1 && prop.State != types.ProtocolDaoProposalState_ActivePhase2 {
+		return false, false, nil
+	}
+
+	nodeVote, err := protocol.GetAddressVoteDirection(rp, prop.ID, nodeAddress, opts)
+	if err != nil {
+		return false, false, fmt.Errorf("error getting the node's vote on proposal %d: %w", prop.ID, err)
+	}
+	if nodeVote != types.VoteDirection_NoVote {
+		return true, false, nil
+	}
+
+	delegate, err := network.GetVotingDelegate(rp, nodeAddress, prop.TargetBlock, opts)
+	if err != nil {
+		return false, false, fmt.Errorf("error getting the node's delegate for proposal %d: %w", prop.ID, err)
+	}
+	votingPower, err := network.GetVotingPower(rp, nodeAddress, prop.TargetBlock, opts)
+	if err != nil {
+		return false, false, fmt.Errorf("error getting the node's voting power for proposal %d: %w", prop.ID, err)
+	}
+	delegateVote := types.VoteDirection_NoVote
+	if delegate != nodeAddress && prop.State == types.ProtocolDaoProposalState_ActivePhase2 {
+		delegateVote, err = protocol.GetAddressVoteDirection(rp, prop.ID, delegate, opts)
+		if err != nil {
+			return false, false, fmt.Errorf("error getting the delegate's vote on proposal %d: %w", prop.ID, err)
+		}
+	}
+
+	return false, pdaoVoteNeeded(prop.State, delegate == nodeAddress, delegateVote, votingPower), nil
+}
+
+// Check if a node that hasn't voted yet would leave its voting power uncast by not voting in the current phase.
+// Nodes vote for themselves in phase 1 if they're their own delegate; otherwise they can only override in phase 2, which matters if their delegate didn't vote.
+func pdaoVoteNeeded(state types.ProtocolDaoProposalState, isOwnDelegate bool, delegateVote types.VoteDirection, votingPower *big.Int) bool {
+	if votingPower == nil || votingPower.Sign() == 0 {
+		return false
+	}
+	switch state {
+	case types.ProtocolDaoProposalState_ActivePhase1:
+		return isOwnDelegate
+	case types.ProtocolDaoProposalState_ActivePhase2:
+		return !isOwnDelegate && delegateVote == types.VoteDirection_NoVote
+	default:
+		return false
+	}
+}
@@ -0,0 +1,79 @@
+package proposals
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/rocket-pool/smartnode/bindings/dao"
+	"github.com/rocket-pool/smartnode/bindings/dao/protocol"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func TestGovernanceEvents(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	events := []api.GovernanceEvent{}
+
+	pdaoProp := protocol.ProtocolDaoProposalDetails{
+		ID:            3,
+		State:         types.ProtocolDaoProposalState_ActivePhase2,
+		Phase1EndTime: now.Add(-time.Hour),
+		Phase2EndTime: now.Add(3 * time.Hour),
+	}
+	event, ok := GetPDAOProposalEvent(pdaoProp)
+	if !ok || !event.Time.Equal(pdaoProp.Phase2EndTime) {
+		t.Fatalf("got %v (%t), want the end of phase 2", event, ok)
+	}
+	events = append(events, event)
+
+	pdaoProp.State = types.ProtocolDaoProposalState_Defeated
+	if _, ok := GetPDAOProposalEvent(pdaoProp); ok {
+		t.Error("expected no event for a defeated proposal")
+	}
+
+	odaoProp := dao.ProposalDetails{ID: 7, State: types.Pending, StartTime: uint64(now.Add(time.Hour).Unix())}
+	event, ok = GetDAOProposalEvent(api.GovernanceDAO_Oracle, odaoProp)
+	if !ok || event.Time.Unix() != int64(odaoProp.StartTime) {
+		t.Fatalf("got %v (%t), want the start of voting", event, ok)
+	}
+	events = append(events, event)
+
+	event, ok = GetSnapshotProposalEvent(api.SnapshotProposal{Id: "0xabc", State: "active", End: now.Add(2 * time.Hour).Unix()})
+	if !ok {
+		t.Fatal("expected an event for an active Snapshot proposal")
+	}
+	events = append(events, event)
+	if _, ok := GetSnapshotProposalEvent(api.SnapshotProposal{State: "closed"}); ok {
+		t.Error("expected no event for a closed Snapshot proposal")
+	}
+
+	SortGovernanceEvents(events)
+	if events[0].DAO != api.GovernanceDAO_Oracle || events[1].DAO != api.GovernanceDAO_Snapshot || events[2].DAO != api.GovernanceDAO_Protocol {
+		t.Errorf("events are out of order: %v", events)
+	}
+}
+
+func TestPDAOVoteNeeded(t *testing.T) {
+	power := big.NewInt(1)
+	tests := []struct {
+		name          string
+		state         types.ProtocolDaoProposalState
+		isOwnDelegate bool
+		delegateVote  types.VoteDirection
+		votingPower   *big.Int
+		want          bool
+	}{
+		{"own delegate in phase 1", types.ProtocolDaoProposalState_ActivePhase1, true, types.VoteDirection_NoVote, power, true},
+		{"delegated in phase 1", types.ProtocolDaoProposalState_ActivePhase1, false, types.VoteDirection_NoVote, power, false},
+		{"own delegate in phase 2", types.ProtocolDaoProposalState_ActivePhase2, true, types.VoteDirection_NoVote, power, false},
+		{"delegate didn't vote", types.ProtocolDaoProposalState_ActivePhase2, false, types.VoteDirection_NoVote, power, true},
+		{"delegate voted", types.ProtocolDaoProposalState_ActivePhase2, false, types.VoteDirection_For, power, false},
+		{"no voting power", types.ProtocolDaoProposalState_ActivePhase1, true, types.VoteDirection_NoVote, big.NewInt(0), false},
+	}
+	for _, test := range tests {
+		if got := pdaoVoteNeeded(test.state, test.isOwnDelegate, test.delegateVote, test.votingPower); got != test.want {
+			t.Errorf("%s: got %t, want %t", test.name, got, test.want)
+		}
+	}
+}
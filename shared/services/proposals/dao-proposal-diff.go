@@ -0,0 +1,327 @@
+package proposals
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/rocket-pool/smartnode/bindings/dao"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	psettings "github.com/rocket-pool/smartnode/bindings/settings/protocol"
+	tnsettings "github.com/rocket-pool/smartnode/bindings/settings/trustednode"
+	"github.com/rocket-pool/smartnode/shared/math"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+const (
+	OracleDAOProposalsContractName       string = "rocketDAONodeTrustedProposals"
+	SecurityCouncilProposalsContractName string = "rocketDAOSecurityProposals"
+)
+
+// The unit a DAO setting's uint256 value is expressed in
+type settingUnit int
+
+const (
+	settingUnit_Count settingUnit = iota
+	settingUnit_Eth
+	settingUnit_Rpl
+	settingUnit_Percent
+	settingUnit_Seconds
+	settingUnit_Blocks
+)
+
+// The units of the uint256 settings the Oracle DAO and security council can change, by settings contract and path.
+// Settings that aren't listed are shown as plain integers.
+var daoSettingUnits = map[string]map[string]settingUnit{
+	tnsettings.MembersSettingsContractName: {
+		tnsettings.QuorumSettingPath:            settingUnit_Percent,
+		tnsettings.RPLBondSettingPath:           settingUnit_Rpl,
+		tnsettings.ChallengeCooldownSettingPath: settingUnit_Seconds,
+		tnsettings.ChallengeWindowSettingPath:   settingUnit_Seconds,
+		tnsettings.ChallengeCostSettingPath:     settingUnit_Eth,
+	},
+	tnsettings.MinipoolSettingsContractName: {
+		tnsettings.ScrubPeriodPath:               settingUnit_Seconds,
+		tnsettings.PromotionScrubPeriodPath:      settingUnit_Seconds,
+		tnsettings.BondReductionWindowStartPath:  settingUnit_Seconds,
+		tnsettings.BondReductionWindowLengthPath: settingUnit_Seconds,
+		tnsettings.DissolvePeriodPath:            settingUnit_Seconds,
+	},
+	tnsettings.ProposalsSettingsContractName: {
+		tnsettings.CooldownTimeSettingPath:  settingUnit_Seconds,
+		tnsettings.VoteTimeSettingPath:      settingUnit_Seconds,
+		tnsettings.VoteDelayTimeSettingPath: settingUnit_Seconds,
+		tnsettings.ExecuteTimeSettingPath:   settingUnit_Seconds,
+		tnsettings.ActionTimeSettingPath:    settingUnit_Seconds,
+	},
+	psettings.AuctionSettingsContractName: {
+		psettings.LotMinimumEthValueSettingPath:    settingUnit_Eth,
+		psettings.LotMaximumEthValueSettingPath:    settingUnit_Eth,
+		psettings.LotDurationSettingPath:           settingUnit_Seconds,
+		psettings.LotStartingPriceRatioSettingPath: settingUnit_Percent,
+		psettings.LotReservePriceRatioSettingPath:  settingUnit_Percent,
+	},
+	psettings.DepositSettingsContractName: {
+		psettings.MinimumDepositSettingPath:         settingUnit_Eth,
+		psettings.MaximumDepositPoolSizeSettingPath: settingUnit_Eth,
+		psettings.DepositFeeSettingPath:             settingUnit_Percent,
+	},
+	psettings.MinipoolSettingsContractName: {
+		psettings.MinipoolLaunchTimeoutSettingPath:              settingUnit_Seconds,
+		psettings.MinipoolUserDistributeWindowStartSettingPath:  settingUnit_Seconds,
+		psettings.MinipoolUserDistributeWindowLengthSettingPath: settingUnit_Seconds,
+	},
+	psettings.NetworkSettingsContractName: {
+		psettings.NodeConsensusThresholdSettingPath:                  settingUnit_Percent,
+		psettings.SubmitBalancesFrequencySettingPath:                 settingUnit_Seconds,
+		psettings.SubmitPricesFrequencySettingPath:                   settingUnit_Seconds,
+		psettings.MinimumNodeFeeSettingPath:                          settingUnit_Percent,
+		psettings.TargetNodeFeeSettingPath:                           settingUnit_Percent,
+		psettings.MaximumNodeFeeSettingPath:                          settingUnit_Percent,
+		psettings.NodeFeeDemandRangeSettingPath:                      settingUnit_Eth,
+		psettings.TargetRethCollateralRateSettingPath:                settingUnit_Percent,
+		psettings.NetworkPenaltyThresholdSettingPath:                 settingUnit_Percent,
+		psettings.NetworkPenaltyPerRateSettingPath:                   settingUnit_Percent,
+		psettings.NetworkNodeCommissionSharePath:                     settingUnit_Percent,
+		psettings.NetworkNodeCommissionShareSecurityCouncilAdderPath: settingUnit_Percent,
+		psettings.NetworkVoterSharePath:                              settingUnit_Percent,
+		psettings.NetworkPDAOSharePath:                               settingUnit_Percent,
+		psettings.NetworkMaxNodeShareSecurityCouncilAdderPath:        settingUnit_Percent,
+		psettings.NetworkMaxRethBalanceDeltaPath:                     settingUnit_Percent,
+	},
+	psettings.NodeSettingsContractName: {
+		psettings.MinimumLegacyRplStakePath:      settingUnit_Percent,
+		psettings.ReducedBondSettingPath:         settingUnit_Eth,
+		psettings.NodeUnstakingPeriodSettingPath: settingUnit_Seconds,
+	},
+	psettings.ProposalsSettingsContractName: {
+		psettings.VotePhase1TimeSettingPath:      settingUnit_Seconds,
+		psettings.VotePhase2TimeSettingPath:      settingUnit_Seconds,
+		psettings.VoteDelayTimeSettingPath:       settingUnit_Seconds,
+		psettings.ExecuteTimeSettingPath:         settingUnit_Seconds,
+		psettings.ProposalBondSettingPath:        settingUnit_Rpl,
+		psettings.ChallengeBondSettingPath:       settingUnit_Rpl,
+		psettings.ChallengePeriodSettingPath:     settingUnit_Seconds,
+		psettings.ProposalQuorumSettingPath:      settingUnit_Percent,
+		psettings.ProposalVetoQuorumSettingPath:  settingUnit_Percent,
+		psettings.ProposalMaxBlockAgeSettingPath: settingUnit_Blocks,
+	},
+	psettings.SecuritySettingsContractName: {
+		psettings.SecurityMembersQuorumSettingPath:       settingUnit_Percent,
+		psettings.SecurityMembersLeaveTimeSettingPath:    settingUnit_Seconds,
+		psettings.SecurityProposalVoteTimeSettingPath:    settingUnit_Seconds,
+		psettings.SecurityProposalExecuteTimeSettingPath: settingUnit_Seconds,
+		psettings.SecurityProposalActionTimeSettingPath:  settingUnit_Seconds,
+	},
+}
+
+// Decode the payload of an Oracle DAO or security council proposal. Setting changes are compared with the setting's
+// current value, and contract upgrades with the contract currently registered under the upgraded name.
+func GetDAOProposalDiff(rp *rocketpool.RocketPool, daoName string, payload []byte, opts *bind.CallOpts) (api.DAOProposalDiff, error) {
+	method, args, err := dao.DecodeProposalPayload(rp, daoName, payload, opts)
+	if err != nil {
+		return api.DAOProposalDiff{}, err
+	}
+
+	diff := api.DAOProposalDiff{
+		Method:    method.RawName,
+		Arguments: make([]api.DAOProposalArgument, len(args)),
+	}
+	for i, arg := range args {
+		diff.Arguments[i] = api.DAOProposalArgument{
+			Name:  method.Inputs[i].Name,
+			Type:  method.Inputs[i].Type.String(),
+			Value: dao.FormatProposalPayloadArgument(method.Inputs[i].Type, arg),
+		}
+	}
+
+	switch method.RawName {
+	case "proposalSettingUint", "proposalSettingBool":
+		// The Oracle DAO names the settings contract, the security council names the protocol DAO settings namespace
+		contractName := args[0].(string)
+		if daoName == SecurityCouncilProposalsContractName {
+			contractName, err = psettings.GetSettingContractName(contractName)
+			if err != nil {
+				return api.DAOProposalDiff{}, err
+			}
+		}
+		change, err := getDAOSettingChange(rp, contractName, args[1].(string), args[2], opts)
+		if err != nil {
+			return api.DAOProposalDiff{}, err
+		}
+		diff.SettingChange = &change
+
+	case "proposalUpgrade":
+		upgrade, err := getDAOProposalUpgrade(rp, args[0].(string), args[1].(string), args[2].(string), args[3].(common.Address), opts)
+		if err != nil {
+			return api.DAOProposalDiff{}, err
+		}
+		diff.Upgrade = &upgrade
+	}
+
+	return diff, nil
+}
+
+// Get the current and proposed values of a setting a proposal changes
+func getDAOSettingChange(rp *rocketpool.RocketPool, contractName string, path string, proposedValue any, opts *bind.CallOpts) (api.DAOProposalSettingChange, error) {
+	change := api.DAOProposalSettingChange{
+		Contract: contractName,
+		Setting:  path,
+	}
+	settingsContract, err := rp.GetContract(contractName, opts)
+	if err != nil {
+		return api.DAOProposalSettingChange{}, fmt.Errorf("error getting settings contract %s: %w", contractName, err)
+	}
+
+	switch value := proposedValue.(type) {
+	case bool:
+		currentValue := new(bool)
+		if err := settingsContract.Call(opts, currentValue, "getSettingBool", path); err != nil {
+			return api.DAOProposalSettingChange{}, fmt.Errorf("error getting current value of %s: %w", path, err)
+		}
+		change.CurrentValue = strconv.FormatBool(*currentValue)
+		change.ProposedValue = strconv.FormatBool(value)
+
+	case *big.Int:
+		currentValue := new(*big.Int)
+		if err := settingsContract.Call(opts, currentValue, "getSettingUint", path); err != nil {
+			return api.DAOProposalSettingChange{}, fmt.Errorf("error getting current value of %s: %w", path, err)
+		}
+		unit := daoSettingUnits[contractName][path]
+		change.CurrentValue = formatSettingValue(unit, *currentValue)
+		change.ProposedValue = formatSettingValue(unit, value)
+
+	default:
+		return api.DAOProposalSettingChange{}, fmt.Errorf("unsupported value type %T for setting %s", proposedValue, path)
+	}
+
+	return change, nil
+}
+
+// Format a uint256 setting value in its unit, keeping the raw value for anything that isn't a plain number
+func formatSettingValue(unit settingUnit, value *big.Int) string {
+	switch unit {
+	case settingUnit_Eth:
+		return fmt.Sprintf("%s ETH", strconv.FormatFloat(math.WeiToEth(value), 'f', -1, 64))
+	case settingUnit_Rpl:
+		return fmt.Sprintf("%s RPL", strconv.FormatFloat(math.WeiToEth(value), 'f', -1, 64))
+	case settingUnit_Percent:
+		return fmt.Sprintf("%s%% (%s)", strconv.FormatFloat(math.WeiToEth(value)*100, 'f', -1, 64), value.String())
+	case settingUnit_Seconds:
+		if !value.IsInt64() {
+			return fmt.Sprintf("%s seconds", value.String())
+		}
+		return fmt.Sprintf("%s seconds (%s)", value.String(), time.Duration(value.Int64())*time.Second)
+	case settingUnit_Blocks:
+		return fmt.Sprintf("%s blocks", value.String())
+	default:
+		return value.String()
+	}
+}
+
+// Compare a proposed contract or ABI upgrade with the contract currently registered under the name
+func getDAOProposalUpgrade(rp *rocketpool.RocketPool, upgradeType string, contractName string, compressedAbi string, contractAddress common.Address, opts *bind.CallOpts) (api.DAOProposalUpgrade, error) {
+	upgrade := api.DAOProposalUpgrade{
+		Type:            upgradeType,
+		ContractName:    contractName,
+		ProposedAddress: contractAddress,
+	}
+	var blockNumber *big.Int
+	if opts != nil {
+		blockNumber = opts.BlockNumber
+	}
+
+	// Get the current contract
+	currentAddress, err := rp.GetAddress(contractName, opts)
+	if err != nil {
+		return api.DAOProposalUpgrade{}, err
+	}
+	if *currentAddress != (common.Address{}) {
+		upgrade.CurrentAddress = currentAddress
+		code, err := rp.Client.CodeAt(context.Background(), *currentAddress, blockNumber)
+		if err != nil {
+			return api.DAOProposalUpgrade{}, fmt.Errorf("error getting code of current %s contract: %w", contractName, err)
+		}
+		codeHash := crypto.Keccak256Hash(code)
+		upgrade.CurrentCodeHash = &codeHash
+	}
+
+	// Get the proposed contract; ABI-only upgrades don't have one
+	if contractAddress != (common.Address{}) {
+		code, err := rp.Client.CodeAt(context.Background(), contractAddress, blockNumber)
+		if err != nil {
+			return api.DAOProposalUpgrade{}, fmt.Errorf("error getting code of proposed %s contract: %w", contractName, err)
+		}
+		upgrade.ProposedCodeHash = crypto.Keccak256Hash(code)
+		upgrade.ProposedCodeSize = len(code)
+	}
+
+	// Compare the ABIs
+	proposedAbi, err := rocketpool.DecodeAbi(compressedAbi)
+	if err != nil {
+		return api.DAOProposalUpgrade{}, fmt.Errorf("error decoding proposed %s ABI: %w", contractName, err)
+	}
+	currentAbiEncoded, err := rp.RocketStorage.GetString(opts, crypto.Keccak256Hash([]byte("contract.abi"), []byte(contractName)))
+	if err != nil {
+		return api.DAOProposalUpgrade{}, fmt.Errorf("error loading current %s ABI: %w", contractName, err)
+	}
+	currentEntries := []string{}
+	if currentAbiEncoded != "" {
+		currentAbi, err := rocketpool.DecodeAbi(currentAbiEncoded)
+		if err != nil {
+			return api.DAOProposalUpgrade{}, fmt.Errorf("error decoding current %s ABI: %w", contractName, err)
+		}
+		currentEntries = getAbiEntries(currentAbi)
+	}
+	upgrade.AddedAbiEntries, upgrade.RemovedAbiEntries = diffAbiEntries(currentEntries, getAbiEntries(proposedAbi))
+
+	return upgrade, nil
+}
+
+// Get the signatures of an ABI's functions and events
+func getAbiEntries(contractAbi *abi.ABI) []string {
+	entries := []string{}
+	for _, method := range contractAbi.Methods {
+		entries = append(entries, "function "+method.Sig)
+	}
+	for _, event := range contractAbi.Events {
+		entries = append(entries, "event "+event.Sig)
+	}
+	return entries
+}
+
+// Get the entries only in the proposed list and the entries only in the current list, each sorted
+func diffAbiEntries(current []string, proposed []string) ([]string, []string) {
+	currentSet := map[string]bool{}
+	for _, entry := range current {
+		currentSet[entry] = true
+	}
+	proposedSet := map[string]bool{}
+	for _, entry := range proposed {
+		proposedSet[entry] = true
+	}
+
+	added := []string{}
+	for _, entry := range proposed {
+		if !currentSet[entry] {
+			added = append(added, entry)
+		}
+	}
+	removed := []string{}
+	for _, entry := range current {
+		if !proposedSet[entry] {
+			removed = append(removed, entry)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
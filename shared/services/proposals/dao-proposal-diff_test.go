@@ -0,0 +1,47 @@
+package proposals
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/math"
+)
+
+func TestFormatSettingValue(t *testing.T) {
+	tests := []struct {
+		unit  settingUnit
+		value *big.Int
+		want  string
+	}{
+		{settingUnit_Eth, math.EthToWei(0.5), "0.5 ETH"},
+		{settingUnit_Rpl, math.EthToWei(1750), "1750 RPL"},
+		{settingUnit_Percent, math.EthToWei(0.51), "51% (510000000000000000)"},
+		{settingUnit_Seconds, big.NewInt(604800), "604800 seconds (168h0m0s)"},
+		{settingUnit_Blocks, big.NewInt(1024), "1024 blocks"},
+		{settingUnit_Count, big.NewInt(40), "40"},
+	}
+	for _, test := range tests {
+		if got := formatSettingValue(test.unit, test.value); got != test.want {
+			t.Errorf("formatSettingValue(%d, %s) = %q, want %q", test.unit, test.value, got, test.want)
+		}
+	}
+}
+
+func TestDiffAbiEntries(t *testing.T) {
+	current := []string{"function getBalance()", "function deposit()", "event Deposited(address,uint256)"}
+	proposed := []string{"function getBalance()", "function deposit(uint256)", "event Deposited(address,uint256)", "function withdraw()"}
+
+	added, removed := diffAbiEntries(current, proposed)
+	if want := []string{"function deposit(uint256)", "function withdraw()"}; !reflect.DeepEqual(added, want) {
+		t.Errorf("added = %v, want %v", added, want)
+	}
+	if want := []string{"function deposit()"}; !reflect.DeepEqual(removed, want) {
+		t.Errorf("removed = %v, want %v", removed, want)
+	}
+
+	added, removed = diffAbiEntries(nil, []string{"function deposit()"})
+	if len(added) != 1 || len(removed) != 0 {
+		t.Errorf("a new contract should only add entries, got added %v and removed %v", added, removed)
+	}
+}
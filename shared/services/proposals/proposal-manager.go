@@ -186,6 +186,40 @@ func (m *ProposalManager) GetNodeTree(blockNumber uint32, nodeIndex uint64, snap
 	return tree, nil
 }
 
+// Get the network tree for a block that's cached on disk, or nil if there isn't one
+func (m *ProposalManager) GetCachedNetworkTree(blockNumber uint32) (*NetworkVotingTree, error) {
+	return m.networkTreeMgr.LoadFromDisk(blockNumber)
+}
+
+// Build the network tree for a block from its voting info snapshot, without loading or saving the cached tree
+func (m *ProposalManager) RecomputeNetworkTree(snapshot *VotingInfoSnapshot, depthPerRound uint64) *NetworkVotingTree {
+	return m.networkTreeMgr.CreateNetworkVotingTree(snapshot, depthPerRound)
+}
+
+// Build the tree that a submitted root's index belongs to from the voting info snapshot, without loading or saving any cached trees.
+// Indices in the network tree use the provided recomputed network tree; indices in a node's tree rebuild that node's tree.
+func (m *ProposalManager) RecomputeTreeForIndex(snapshot *VotingInfoSnapshot, networkTree *NetworkVotingTree, index uint64, depthPerRound uint64) *VotingTree {
+	rpNodeIndex := getRPNodeIndexFromTreeNodeIndex(snapshot, index)
+	if rpNodeIndex == nil {
+		return networkTree.VotingTree
+	}
+	treeIndex := getTreeNodeIndexFromRPNodeIndex(snapshot, *rpNodeIndex)
+	return m.nodeTreeMgr.CreateNodeVotingTree(snapshot, *rpNodeIndex, treeIndex, depthPerRound).VotingTree
+}
+
+// Get the voting tree of the node with the provided address
+func (m *ProposalManager) GetNodeTreeForAddress(blockNumber uint32, nodeAddress common.Address) (*NodeVotingTree, error) {
+	snapshot, err := m.GetVotingInfoSnapshot(blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	nodeIndex, err := getRPNodeIndexFromSnapshot(snapshot, nodeAddress)
+	if err != nil {
+		return nil, err
+	}
+	return m.GetNodeTree(blockNumber, nodeIndex, snapshot)
+}
+
 // Get the artifacts required for voting on a proposal: the node's total delegated voting power, the node index, and a Merkle proof for the node's
 // corresponding leaf index in the network tree
 func (m *ProposalManager) GetArtifactsForVoting(blockNumber uint32, nodeAddress common.Address) (*big.Int, uint64, []types.VotingTreeNode, error) {
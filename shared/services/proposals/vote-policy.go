@@ -0,0 +1,284 @@
+package proposals
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gopkg.in/yaml.v2"
+
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/shared/math"
+)
+
+// A vote the policy can cast
+type PolicyVote string
+
+const (
+	PolicyVoteNone    PolicyVote = "none"
+	PolicyVoteAbstain PolicyVote = "abstain"
+	PolicyVoteFor     PolicyVote = "for"
+	PolicyVoteAgainst PolicyVote = "against"
+	PolicyVoteVeto    PolicyVote = "veto"
+)
+
+// The kind of change a proposal makes, derived from its payload
+type ProposalKind string
+
+const (
+	ProposalKindSetting              ProposalKind = "setting"
+	ProposalKindRewardsPercentages   ProposalKind = "rewards-percentages"
+	ProposalKindOneTimeSpend         ProposalKind = "one-time-spend"
+	ProposalKindRecurringSpend       ProposalKind = "recurring-spend"
+	ProposalKindRecurringSpendUpdate ProposalKind = "recurring-spend-update"
+	ProposalKindSecurityCouncil      ProposalKind = "security-council"
+	ProposalKindUnknown              ProposalKind = "unknown"
+)
+
+// A policy that decides how the node daemon votes on Protocol DAO proposals. It's read from a YAML file, e.g.:
+//
+//	default: abstain
+//	followDelegate: true
+//	rules:
+//	  - name: large spends
+//	    kinds: [one-time-spend, recurring-spend]
+//	    minSpendRpl: 10000
+//	    vote: against
+//	  - name: proposal 42
+//	    proposalIds: [42]
+//	    vote: for
+type VotePolicy struct {
+	// The vote to cast when no rule matches and the delegate isn't being followed
+	Default PolicyVote `yaml:"default"`
+
+	// Keep the delegate's vote during phase 2 if it voted and no rule matches
+	FollowDelegate bool `yaml:"followDelegate"`
+
+	// The rules to check, in order; the first one that matches decides the vote
+	Rules []VoteRule `yaml:"rules"`
+}
+
+// A rule that decides the vote for the proposals it matches. Every criterion that is set has to match.
+type VoteRule struct {
+	Name        string         `yaml:"name"`
+	ProposalIds []uint64       `yaml:"proposalIds"`
+	Proposers   []string       `yaml:"proposers"`
+	Kinds       []ProposalKind `yaml:"kinds"`
+
+	// Only match spends of at least this much RPL; recurring spends are compared per period
+	MinSpendRpl float64 `yaml:"minSpendRpl"`
+
+	Vote PolicyVote `yaml:"vote"`
+}
+
+// The details of a proposal the policy decides on
+type PolicyProposal struct {
+	ID       uint64
+	Proposer common.Address
+	Kind     ProposalKind
+
+	// The amount of RPL a spend proposal pays out, or nil for other proposals
+	SpendAmount *big.Int
+}
+
+// How the policy voted on a proposal, and why
+type VoteDecision struct {
+	Vote   PolicyVote
+	Reason string
+}
+
+// Load the voting policy from a file. Returns nil if the file doesn't exist.
+func LoadVotePolicy(path string) (*VotePolicy, error) {
+	bytes, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading voting policy file [%s]: %w", path, err)
+	}
+
+	policy := &VotePolicy{}
+	if err := yaml.UnmarshalStrict(bytes, policy); err != nil {
+		return nil, fmt.Errorf("error parsing voting policy file [%s]: %w", path, err)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid voting policy file [%s]: %w", path, err)
+	}
+	return policy, nil
+}
+
+// Check that the policy only uses known votes, kinds and addresses
+func (p *VotePolicy) Validate() error {
+	if p.Default == "" {
+		p.Default = PolicyVoteNone
+	}
+	if err := validatePolicyVote(p.Default); err != nil {
+		return fmt.Errorf("default: %w", err)
+	}
+	for i, rule := range p.Rules {
+		if err := validatePolicyVote(rule.Vote); err != nil {
+			return fmt.Errorf("rule %s: %w", rule.describe(i), err)
+		}
+		for _, proposer := range rule.Proposers {
+			if !common.IsHexAddress(proposer) {
+				return fmt.Errorf("rule %s: invalid proposer address %q", rule.describe(i), proposer)
+			}
+		}
+		for _, kind := range rule.Kinds {
+			switch kind {
+			case ProposalKindSetting, ProposalKindRewardsPercentages, ProposalKindOneTimeSpend, ProposalKindRecurringSpend,
+				ProposalKindRecurringSpendUpdate, ProposalKindSecurityCouncil, ProposalKindUnknown:
+			default:
+				return fmt.Errorf("rule %s: unknown proposal kind %q", rule.describe(i), kind)
+			}
+		}
+		if rule.MinSpendRpl < 0 {
+			return fmt.Errorf("rule %s: minSpendRpl can't be negative", rule.describe(i))
+		}
+	}
+	return nil
+}
+
+// Decide how to vote on a proposal. delegateVote is the vote of the node's delegate if it's someone else, or NoVote.
+func (p *VotePolicy) Decide(proposal PolicyProposal, delegateVote types.VoteDirection) VoteDecision {
+	for i, rule := range p.Rules {
+		if rule.matches(proposal) {
+			return VoteDecision{
+				Vote:   rule.Vote,
+				Reason: fmt.Sprintf("matched rule %s", rule.describe(i)),
+			}
+		}
+	}
+
+	if p.FollowDelegate && delegateVote != types.VoteDirection_NoVote {
+		return VoteDecision{
+			Vote:   GetPolicyVote(delegateVote),
+			Reason: "no rule matched, so following the delegate's vote",
+		}
+	}
+
+	return VoteDecision{
+		Vote:   p.Default,
+		Reason: "no rule matched, so using the default vote",
+	}
+}
+
+// Check if a rule matches a proposal
+func (r VoteRule) matches(proposal PolicyProposal) bool {
+	if len(r.ProposalIds) > 0 && !contains(r.ProposalIds, proposal.ID) {
+		return false
+	}
+	if len(r.Proposers) > 0 {
+		matched := false
+		for _, proposer := range r.Proposers {
+			if common.HexToAddress(proposer) == proposal.Proposer {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if len(r.Kinds) > 0 && !contains(r.Kinds, proposal.Kind) {
+		return false
+	}
+	if r.MinSpendRpl > 0 {
+		if proposal.SpendAmount == nil || proposal.SpendAmount.Cmp(math.EthToWei(r.MinSpendRpl)) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Get a name for a rule to use in logs
+func (r VoteRule) describe(index int) string {
+	if r.Name != "" {
+		return fmt.Sprintf("%d (%s)", index+1, r.Name)
+	}
+	return fmt.Sprint(index + 1)
+}
+
+// Get the kind of a proposal from the rocketDAOProtocolProposals method its payload calls and that method's arguments,
+// along with the RPL amount it pays out if it's a spend
+func GetProposalKind(method string, args []any) (ProposalKind, *big.Int) {
+	switch method {
+	case "proposalSettingMulti", "proposalSettingUint", "proposalSettingBool", "proposalSettingAddress", "proposalSettingAddressList":
+		return ProposalKindSetting, nil
+	case "proposalSettingRewardsClaimers":
+		return ProposalKindRewardsPercentages, nil
+	case "proposalSecurityInvite", "proposalSecurityKick", "proposalSecurityKickMulti", "proposalSecurityReplace":
+		return ProposalKindSecurityCouncil, nil
+	}
+
+	// Spends have their amount as the third argument
+	var kind ProposalKind
+	switch method {
+	case "proposalTreasuryOneTimeSpend":
+		kind = ProposalKindOneTimeSpend
+	case "proposalTreasuryNewContract":
+		kind = ProposalKindRecurringSpend
+	case "proposalTreasuryUpdateContract":
+		kind = ProposalKindRecurringSpendUpdate
+	default:
+		return ProposalKindUnknown, nil
+	}
+	if len(args) < 3 {
+		return kind, nil
+	}
+	amount, _ := args[2].(*big.Int)
+	return kind, amount
+}
+
+// Convert a vote direction to the equivalent policy vote
+func GetPolicyVote(direction types.VoteDirection) PolicyVote {
+	switch direction {
+	case types.VoteDirection_Abstain:
+		return PolicyVoteAbstain
+	case types.VoteDirection_For:
+		return PolicyVoteFor
+	case types.VoteDirection_Against:
+		return PolicyVoteAgainst
+	case types.VoteDirection_AgainstWithVeto:
+		return PolicyVoteVeto
+	default:
+		return PolicyVoteNone
+	}
+}
+
+// Convert a policy vote to the vote direction to submit
+func (v PolicyVote) Direction() types.VoteDirection {
+	switch v {
+	case PolicyVoteAbstain:
+		return types.VoteDirection_Abstain
+	case PolicyVoteFor:
+		return types.VoteDirection_For
+	case PolicyVoteAgainst:
+		return types.VoteDirection_Against
+	case PolicyVoteVeto:
+		return types.VoteDirection_AgainstWithVeto
+	default:
+		return types.VoteDirection_NoVote
+	}
+}
+
+func validatePolicyVote(vote PolicyVote) error {
+	switch vote {
+	case PolicyVoteNone, PolicyVoteAbstain, PolicyVoteFor, PolicyVoteAgainst, PolicyVoteVeto:
+		return nil
+	default:
+		return fmt.Errorf("unknown vote %q (expected none, abstain, for, against or veto)", vote)
+	}
+}
+
+func contains[T comparable](values []T, value T) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
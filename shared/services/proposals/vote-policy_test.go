@@ -0,0 +1,117 @@
+package proposals
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/shared/math"
+)
+
+const testPolicy = `
+default: abstain
+followDelegate: true
+rules:
+  - name: large spends
+    kinds: [one-time-spend, recurring-spend]
+    minSpendRpl: 1000
+    vote: against
+  - proposers: ["0x1111111111111111111111111111111111111111"]
+    vote: for
+`
+
+func loadTestPolicy(t *testing.T, contents string) (*VotePolicy, error) {
+	path := filepath.Join(t.TempDir(), "policy.yml")
+	if err := os.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatalf("error writing policy: %v", err)
+	}
+	return LoadVotePolicy(path)
+}
+
+func TestVotePolicyDecide(t *testing.T) {
+	policy, err := loadTestPolicy(t, testPolicy)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	trusted := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	tests := []struct {
+		name         string
+		proposal     PolicyProposal
+		delegateVote types.VoteDirection
+		want         PolicyVote
+	}{
+		{
+			name:     "large spend",
+			proposal: PolicyProposal{ID: 1, Proposer: trusted, Kind: ProposalKindOneTimeSpend, SpendAmount: math.EthToWei(5000)},
+			want:     PolicyVoteAgainst,
+		},
+		{
+			name:     "small spend falls through to the proposer rule",
+			proposal: PolicyProposal{ID: 2, Proposer: trusted, Kind: ProposalKindOneTimeSpend, SpendAmount: math.EthToWei(10)},
+			want:     PolicyVoteFor,
+		},
+		{
+			name:         "no rule, delegate voted",
+			proposal:     PolicyProposal{ID: 3, Kind: ProposalKindSetting},
+			delegateVote: types.VoteDirection_Against,
+			want:         PolicyVoteAgainst,
+		},
+		{
+			name:     "no rule, delegate didn't vote",
+			proposal: PolicyProposal{ID: 4, Kind: ProposalKindSetting},
+			want:     PolicyVoteAbstain,
+		},
+	}
+	for _, test := range tests {
+		decision := policy.Decide(test.proposal, test.delegateVote)
+		if decision.Vote != test.want {
+			t.Errorf("%s: got %s (%s), want %s", test.name, decision.Vote, decision.Reason, test.want)
+		}
+	}
+}
+
+func TestLoadVotePolicy(t *testing.T) {
+	policy, err := LoadVotePolicy(filepath.Join(t.TempDir(), "missing.yml"))
+	if err != nil || policy != nil {
+		t.Fatalf("expected no policy and no error for a missing file, got %v, %v", policy, err)
+	}
+
+	policy, err = loadTestPolicy(t, "rules: []\n")
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if policy.Default != PolicyVoteNone {
+		t.Errorf("expected the default vote to be none, got %s", policy.Default)
+	}
+
+	invalid := []string{
+		"default: maybe\n",
+		"rules:\n  - kinds: [spend]\n    vote: for\n",
+		"rules:\n  - proposers: [nope]\n    vote: for\n",
+		"unknownField: true\n",
+	}
+	for _, contents := range invalid {
+		if _, err := loadTestPolicy(t, contents); err == nil {
+			t.Errorf("expected an error loading %q", contents)
+		}
+	}
+}
+
+func TestGetProposalKind(t *testing.T) {
+	kind, amount := GetProposalKind("proposalTreasuryNewContract", []any{"contract", common.Address{}, big.NewInt(7), big.NewInt(1), big.NewInt(2), big.NewInt(3)})
+	if kind != ProposalKindRecurringSpend || amount.Cmp(big.NewInt(7)) != 0 {
+		t.Errorf("got %s / %v, want a recurring spend of 7", kind, amount)
+	}
+	kind, amount = GetProposalKind("proposalSettingMulti", nil)
+	if kind != ProposalKindSetting || amount != nil {
+		t.Errorf("got %s / %v, want a setting without an amount", kind, amount)
+	}
+	if kind, _ := GetProposalKind("proposalSomethingNew", nil); kind != ProposalKindUnknown {
+		t.Errorf("got %s, want unknown", kind)
+	}
+}
@@ -0,0 +1,137 @@
+package proposals
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+var (
+	oneEth   = big.NewInt(1e18)
+	_1_5_Eth = big.NewInt(15e17)
+)
+
+// Calculate a node's own voting power from the network state, before any delegation
+func GetNodeVotingPower(s *state.NetworkState, nodeIdx int) *big.Int {
+	node := s.NodeDetails[nodeIdx]
+
+	activeMinipoolCount := int64(0)
+	for _, mpd := range s.MinipoolDetailsByNode[node.NodeAddress] {
+		// Ignore finalised
+		if mpd.Finalised {
+			continue
+		}
+
+		activeMinipoolCount++
+	}
+
+	// Get provided ETH (32 * minipoolCount - matched)
+	ethProvided := big.NewInt(activeMinipoolCount * 32)
+	ethProvided.Mul(ethProvided, oneEth)
+	ethProvided.Sub(ethProvided, node.MinipoolETHBorrowed)
+
+	// Add megapool provided ETH
+	if node.MegapoolDeployed {
+		megapoolProvidedEth := s.MegapoolDetails[node.MegapoolAddress].NodeBond
+		ethProvided.Add(ethProvided, megapoolProvidedEth)
+	}
+
+	// Get total RPL staked
+	nodeStake := big.NewInt(0)
+	nodeStake.Add(nodeStake, node.LegacyStakedRPL)
+	nodeStake.Add(nodeStake, node.MegapoolStakedRPL)
+
+	rplPrice := s.NetworkDetails.RplPrice
+
+	// No RPL staked means no voting power
+	if nodeStake.Sign() == 0 {
+		return big.NewInt(0)
+	}
+
+	// First calculate the maximum rpl that can be used as input
+	// maxVotingRpl := (eligibleBondedEth * 1.5 Eth / RplPrice)
+	maxVotingRpl := big.NewInt(0)
+	maxVotingRpl.Mul(ethProvided, _1_5_Eth)
+	maxVotingRpl.Quo(maxVotingRpl, rplPrice)
+
+	// Determine the voting RPL
+	// votingRpl := min(maxVotingRpl, nodeStake)
+	var votingRpl *big.Int
+	if maxVotingRpl.Cmp(nodeStake) <= 0 {
+		votingRpl = maxVotingRpl
+	} else {
+		votingRpl = nodeStake
+	}
+
+	// Now take the square root
+	// Because the units are in wei, we need to multiply votingRpl by 1 Eth before square rooting.
+	votingPower := big.NewInt(0)
+	votingPower.Mul(votingRpl, oneEth)
+	votingPower.Sqrt(votingPower)
+	return votingPower
+
+}
+
+// Get the voting power each node holds in a voting info snapshot once delegations are applied, along with the
+// network's total voting power. Only nodes that hold voting power are returned, sorted from the most power to the least.
+func GetDelegatedVotingPower(snapshot *VotingInfoSnapshot) (*big.Int, []api.PDAONodeVotingPower) {
+	total := big.NewInt(0)
+	holders := map[common.Address]*api.PDAONodeVotingPower{}
+	getHolder := func(address common.Address) *api.PDAONodeVotingPower {
+		holder, exists := holders[address]
+		if !exists {
+			holder = &api.PDAONodeVotingPower{
+				Address:        address,
+				OwnVotingPower: big.NewInt(0),
+				VotingPower:    big.NewInt(0),
+				Delegators:     []api.PDAODelegation{},
+			}
+			holders[address] = holder
+		}
+		return holder
+	}
+
+	for _, info := range snapshot.Info {
+		// Nodes that never set a delegate vote for themselves
+		delegate := info.Delegate
+		if delegate == (common.Address{}) {
+			delegate = info.NodeAddress
+		}
+		total.Add(total, info.VotingPower)
+
+		node := getHolder(info.NodeAddress)
+		node.Delegate = delegate
+		node.OwnVotingPower.Set(info.VotingPower)
+
+		holder := getHolder(delegate)
+		holder.VotingPower.Add(holder.VotingPower, info.VotingPower)
+		if delegate != info.NodeAddress && info.VotingPower.Sign() > 0 {
+			holder.Delegators = append(holder.Delegators, api.PDAODelegation{
+				Delegator:   info.NodeAddress,
+				VotingPower: info.VotingPower,
+			})
+		}
+	}
+
+	nodes := []api.PDAONodeVotingPower{}
+	for _, holder := range holders {
+		if holder.VotingPower.Sign() == 0 {
+			continue
+		}
+		sort.Slice(holder.Delegators, func(i, j int) bool {
+			return holder.Delegators[i].VotingPower.Cmp(holder.Delegators[j].VotingPower) > 0
+		})
+		nodes = append(nodes, *holder)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if cmp := nodes[i].VotingPower.Cmp(nodes[j].VotingPower); cmp != 0 {
+			return cmp > 0
+		}
+		return nodes[i].Address.Cmp(nodes[j].Address) < 0
+	})
+	return total, nodes
+}
@@ -0,0 +1,50 @@
+package proposals
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/bindings/types"
+)
+
+func TestGetDelegatedVotingPower(t *testing.T) {
+	alice := common.HexToAddress("0x01")
+	bob := common.HexToAddress("0x02")
+	carol := common.HexToAddress("0x03")
+	dave := common.HexToAddress("0x04")
+
+	snapshot := &VotingInfoSnapshot{
+		Info: []types.NodeVotingInfo{
+			{NodeAddress: alice, VotingPower: big.NewInt(100), Delegate: alice},
+			{NodeAddress: bob, VotingPower: big.NewInt(40), Delegate: alice},
+			{NodeAddress: carol, VotingPower: big.NewInt(30), Delegate: common.Address{}},
+			{NodeAddress: dave, VotingPower: big.NewInt(50), Delegate: carol},
+		},
+	}
+
+	total, nodes := GetDelegatedVotingPower(snapshot)
+	if total.Cmp(big.NewInt(220)) != 0 {
+		t.Errorf("total = %s, want 220", total)
+	}
+
+	// Bob and Dave delegated all of their power, so only Alice and Carol hold any
+	if len(nodes) != 2 {
+		t.Fatalf("got %d nodes holding voting power, want 2", len(nodes))
+	}
+	if nodes[0].Address != alice || nodes[0].VotingPower.Cmp(big.NewInt(140)) != 0 {
+		t.Errorf("first node is %s with %s, want Alice with 140", nodes[0].Address.Hex(), nodes[0].VotingPower)
+	}
+	if len(nodes[0].Delegators) != 1 || nodes[0].Delegators[0].Delegator != bob {
+		t.Errorf("Alice's delegators are %v, want Bob", nodes[0].Delegators)
+	}
+
+	// Nodes without a delegate vote for themselves
+	if nodes[1].Address != carol || nodes[1].Delegate != carol || nodes[1].VotingPower.Cmp(big.NewInt(80)) != 0 {
+		t.Errorf("second node is %s (delegate %s) with %s, want Carol delegating to themselves with 80", nodes[1].Address.Hex(), nodes[1].Delegate.Hex(), nodes[1].VotingPower)
+	}
+	if nodes[1].OwnVotingPower.Cmp(big.NewInt(30)) != 0 {
+		t.Errorf("Carol's own voting power is %s, want 30", nodes[1].OwnVotingPower)
+	}
+}
@@ -0,0 +1,334 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/rocket-pool/smartnode/bindings/deposit"
+	"github.com/rocket-pool/smartnode/bindings/logs"
+	"github.com/rocket-pool/smartnode/bindings/megapool"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/bindings/settings/protocol"
+	"github.com/rocket-pool/smartnode/bindings/storage"
+	rpmath "github.com/rocket-pool/smartnode/shared/math"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+const (
+	// The default number of days of deposit pool history the queue forecast is based on
+	DefaultQueueForecastLookbackDays uint64 = 14
+
+	// The z-score for the forecast's confidence range (a 90% two-sided interval)
+	queueForecastConfidenceZ float64 = 1.645
+
+	expressQueueKey  string = "deposit.queue.express"
+	standardQueueKey string = "deposit.queue.standard"
+)
+
+// A queued validator in the order it will be assigned
+type forecastQueueEntry struct {
+	storage.DepositQueueValue
+	Express bool
+}
+
+// The recent deposit pool activity a queue forecast is based on
+type queueForecastHistory struct {
+	// The user ETH deposited into the deposit pool each day, oldest first
+	DailyInflows []float64
+
+	// The number of megapool validators assigned each day, oldest first
+	DailyAssignments []float64
+}
+
+// Forecast when each validator in the megapool queues will be assigned, based on the last lookbackDays of
+// deposit pool inflows and assignments
+func GetQueueForecast(rp *rocketpool.RocketPool, bc beacon.Client, cfg *config.RocketPoolConfig, lookbackDays uint64) (api.QueueForecast, error) {
+	if lookbackDays == 0 {
+		lookbackDays = DefaultQueueForecastLookbackDays
+	}
+
+	// Get the queues in assignment order
+	expressEntries, err := getQueueEntries(rp, expressQueueKey)
+	if err != nil {
+		return api.QueueForecast{}, fmt.Errorf("error getting the express queue: %w", err)
+	}
+	standardEntries, err := getQueueEntries(rp, standardQueueKey)
+	if err != nil {
+		return api.QueueForecast{}, fmt.Errorf("error getting the standard queue: %w", err)
+	}
+	queueIndex, err := deposit.GetQueueIndex(rp, nil)
+	if err != nil {
+		return api.QueueForecast{}, fmt.Errorf("error getting the queue index: %w", err)
+	}
+	expressQueueRate, err := protocol.GetExpressQueueRate(rp, nil)
+	if err != nil {
+		return api.QueueForecast{}, fmt.Errorf("error getting the express queue rate: %w", err)
+	}
+	entries := orderQueueEntries(expressEntries, standardEntries, uint64(queueIndex), expressQueueRate)
+
+	// Get the ETH that's already available for assignments
+	userBalance, err := deposit.GetUserBalance(rp, nil)
+	if err != nil {
+		return api.QueueForecast{}, fmt.Errorf("error getting the deposit pool's user balance: %w", err)
+	}
+
+	// Get the recent history
+	eth2Config, err := bc.GetEth2Config()
+	if err != nil {
+		return api.QueueForecast{}, err
+	}
+	eventLogInterval, err := cfg.GetEventLogInterval()
+	if err != nil {
+		return api.QueueForecast{}, err
+	}
+	history, err := getQueueForecastHistory(rp, eth2Config, big.NewInt(int64(eventLogInterval)), lookbackDays)
+	if err != nil {
+		return api.QueueForecast{}, err
+	}
+
+	forecast := forecastQueue(entries, userBalance, history, time.Now())
+	forecast.LookbackDays = lookbackDays
+	forecast.ExpressQueueLength = uint64(len(expressEntries))
+	forecast.StandardQueueLength = uint64(len(standardEntries))
+	forecast.ExpressQueueRate = expressQueueRate
+	return forecast, nil
+}
+
+// Get every entry in one of the deposit queues, from the head of the queue
+func getQueueEntries(rp *rocketpool.RocketPool, queueKey string) ([]storage.DepositQueueValue, error) {
+	var maxSliceLength = big.NewInt(100)
+	entries := []storage.DepositQueueValue{}
+	index := big.NewInt(0)
+	for {
+		slice, err := storage.Scan(rp, crypto.Keccak256Hash([]byte(queueKey)), index, maxSliceLength, nil)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, slice.Entries...)
+		if slice.NextIndex.Sign() == 0 {
+			return entries, nil
+		}
+		index = slice.NextIndex
+	}
+}
+
+// Interleave the express and standard queues the same way the deposit pool does: expressQueueRate
+// express validators are assigned for every standard validator, and either queue fills the other's
+// turn when it's empty
+func orderQueueEntries(express []storage.DepositQueueValue, standard []storage.DepositQueueValue, queueIndex uint64, expressQueueRate uint64) []forecastQueueEntry {
+	entries := make([]forecastQueueEntry, 0, len(express)+len(standard))
+	interval := expressQueueRate + 1
+	for step := uint64(0); len(express) > 0 || len(standard) > 0; step++ {
+		isExpressSlot := (queueIndex+step)%interval != expressQueueRate
+		if (isExpressSlot && len(express) > 0) || len(standard) == 0 {
+			entries = append(entries, forecastQueueEntry{DepositQueueValue: express[0], Express: true})
+			express = express[1:]
+		} else {
+			entries = append(entries, forecastQueueEntry{DepositQueueValue: standard[0]})
+			standard = standard[1:]
+		}
+	}
+	return entries
+}
+
+// Get the daily deposit pool inflows and megapool assignments over the lookback window
+func getQueueForecastHistory(rp *rocketpool.RocketPool, eth2Config beacon.Eth2Config, intervalSize *big.Int, lookbackDays uint64) (queueForecastHistory, error) {
+	history := queueForecastHistory{
+		DailyInflows:     make([]float64, lookbackDays),
+		DailyAssignments: make([]float64, lookbackDays),
+	}
+
+	// Get the block range
+	secondsPerSlot := eth2Config.SecondsPerSlot
+	if secondsPerSlot == 0 {
+		secondsPerSlot = 12
+	}
+	blocksPerDay := uint64(86400) / secondsPerSlot
+	latestBlock, err := rp.Client.BlockNumber(context.Background())
+	if err != nil {
+		return history, fmt.Errorf("error getting the latest block: %w", err)
+	}
+	var fromBlock uint64
+	if latestBlock > lookbackDays*blocksPerDay {
+		fromBlock = latestBlock - lookbackDays*blocksPerDay + 1
+	}
+	fromBlockBig := new(big.Int).SetUint64(fromBlock)
+	toBlockBig := new(big.Int).SetUint64(latestBlock)
+	dayOf := func(blockNumber uint64) int {
+		return int(lookbackDays) - 1 - int((latestBlock-blockNumber)/blocksPerDay)
+	}
+
+	// Get the user deposits
+	rocketDepositPool, err := rp.GetContract("rocketDepositPool", nil)
+	if err != nil {
+		return history, err
+	}
+	depositReceived, exists := rocketDepositPool.ABI.Events["DepositReceived"]
+	if !exists {
+		return history, fmt.Errorf("the deposit pool does not have a DepositReceived event")
+	}
+	depositLogs, err := logs.FilterContractLogs(rp, "rocketDepositPool", logs.FilterQuery{
+		FromBlock: fromBlockBig,
+		ToBlock:   toBlockBig,
+		Topics:    [][]common.Hash{{depositReceived.ID}},
+	}, intervalSize, nil)
+	if err != nil {
+		return history, fmt.Errorf("error getting deposit pool deposits: %w", err)
+	}
+	for _, log := range depositLogs {
+		values := map[string]interface{}{}
+		if err := rocketDepositPool.Contract.UnpackLogIntoMap(values, "DepositReceived", log); err != nil {
+			return history, fmt.Errorf("error unpacking deposit event: %w", err)
+		}
+		amount, ok := values["amount"].(*big.Int)
+		if !ok {
+			return history, fmt.Errorf("deposit event is missing its amount")
+		}
+		day := dayOf(log.BlockNumber)
+		if day >= 0 {
+			history.DailyInflows[day] += rpmath.WeiToEth(amount)
+		}
+	}
+
+	// Get the assignments; these are emitted by each megapool, so they're filtered by topic alone
+	assignedEventId, err := megapool.GetValidatorEventId("MegapoolValidatorAssigned")
+	if err != nil {
+		return history, err
+	}
+	assignedLogs, err := logs.GetLogs(rp, nil, [][]common.Hash{{assignedEventId}}, intervalSize, fromBlockBig, toBlockBig, nil)
+	if err != nil {
+		return history, fmt.Errorf("error getting megapool assignments: %w", err)
+	}
+	for _, log := range assignedLogs {
+		day := dayOf(log.BlockNumber)
+		if day >= 0 {
+			history.DailyAssignments[day]++
+		}
+	}
+
+	return history, nil
+}
+
+// Forecast when each queued validator will be assigned. A validator can't be assigned until the deposit pool
+// has enough user ETH for it and everything ahead of it, and the network has historically only worked through
+// the queue so quickly, so its forecast is the later of those two estimates. The range comes from the
+// day-to-day variance of each, narrowing as the forecast averages over more days.
+func forecastQueue(entries []forecastQueueEntry, userBalance *big.Int, history queueForecastHistory, now time.Time) api.QueueForecast {
+	inflowMean, inflowStdDev := meanAndStdDev(history.DailyInflows)
+	assignmentMean, assignmentStdDev := meanAndStdDev(history.DailyAssignments)
+	forecast := api.QueueForecast{
+		UserBalance:            userBalance,
+		DailyInflowMean:        inflowMean,
+		DailyInflowStdDev:      inflowStdDev,
+		DailyAssignmentsMean:   assignmentMean,
+		DailyAssignmentsStdDev: assignmentStdDev,
+		Entries:                make([]api.QueueForecastEntry, 0, len(entries)),
+	}
+
+	available := rpmath.WeiToEth(userBalance)
+	required := float64(0)
+	for i, entry := range entries {
+		position := uint64(i + 1)
+		required += float64(entry.RequestedValue-min(entry.SuppliedValue, entry.RequestedValue)) / 1000
+		shortfall := max(required-available, 0)
+
+		// Expected days until assignment
+		fundingDays := daysToReach(shortfall, inflowMean)
+		throughputDays := float64(0)
+		if assignmentMean > 0 {
+			throughputDays = float64(position) / assignmentMean
+		}
+		expected := max(fundingDays, throughputDays)
+
+		// The range, based on how much the daily rates vary over a window of the expected length
+		window := math.Max(expected, 1)
+		if math.IsInf(window, 1) {
+			window = float64(len(history.DailyInflows))
+		}
+		inflowMargin := queueForecastConfidenceZ * inflowStdDev / math.Sqrt(window)
+		assignmentMargin := queueForecastConfidenceZ * assignmentStdDev / math.Sqrt(window)
+		earliest := daysToReach(shortfall, inflowMean+inflowMargin)
+		latest := daysToReach(shortfall, inflowMean-inflowMargin)
+		if assignmentMean > 0 {
+			earliest = max(earliest, float64(position)/(assignmentMean+assignmentMargin))
+			latest = max(latest, daysToReach(float64(position), assignmentMean-assignmentMargin))
+		}
+
+		forecastEntry := api.QueueForecastEntry{
+			Position:        position,
+			MegapoolAddress: entry.Receiver,
+			ValidatorId:     entry.ValidatorID,
+			ExpressQueue:    entry.Express,
+			EthRequired:     shortfall,
+			Funded:          shortfall == 0,
+			Unbounded:       math.IsInf(latest, 1),
+		}
+		if !math.IsInf(expected, 1) {
+			forecastEntry.ExpectedTime = addDays(now, expected)
+		}
+		forecastEntry.EarliestTime = addDays(now, earliest)
+		if !forecastEntry.Unbounded {
+			forecastEntry.LatestTime = addDays(now, latest)
+		}
+		forecast.Entries = append(forecast.Entries, forecastEntry)
+	}
+	return forecast
+}
+
+// The number of days it takes to reach amount at the given daily rate
+func daysToReach(amount float64, dailyRate float64) float64 {
+	if amount <= 0 {
+		return 0
+	}
+	if dailyRate <= 0 {
+		return math.Inf(1)
+	}
+	return amount / dailyRate
+}
+
+func addDays(t time.Time, days float64) time.Time {
+	return t.Add(time.Duration(days * float64(24*time.Hour))).Truncate(time.Second)
+}
+
+// Get the mean and sample standard deviation of a set of daily samples
+func meanAndStdDev(samples []float64) (float64, float64) {
+	if len(samples) == 0 {
+		return 0, 0
+	}
+	sum := float64(0)
+	for _, sample := range samples {
+		sum += sample
+	}
+	mean := sum / float64(len(samples))
+	if len(samples) == 1 {
+		return mean, 0
+	}
+	variance := float64(0)
+	for _, sample := range samples {
+		variance += (sample - mean) * (sample - mean)
+	}
+	return mean, math.Sqrt(variance / float64(len(samples)-1))
+}
+
+// Get the forecast entries for one megapool's validators, by validator ID
+func GetMegapoolQueueForecast(forecast api.QueueForecast, megapoolAddress common.Address) []api.QueueForecastEntry {
+	entries := []api.QueueForecastEntry{}
+	for _, entry := range forecast.Entries {
+		if entry.MegapoolAddress == megapoolAddress {
+			entries = append(entries, entry)
+		}
+	}
+	sort.Slice(entries, func(i int, j int) bool {
+		return entries[i].ValidatorId < entries[j].ValidatorId
+	})
+	return entries
+}
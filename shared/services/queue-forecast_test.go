@@ -0,0 +1,93 @@
+package services
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/bindings/storage"
+	rpmath "github.com/rocket-pool/smartnode/shared/math"
+)
+
+func TestOrderQueueEntries(t *testing.T) {
+	express := []storage.DepositQueueValue{{ValidatorID: 1}, {ValidatorID: 2}, {ValidatorID: 3}, {ValidatorID: 4}}
+	standard := []storage.DepositQueueValue{{ValidatorID: 10}, {ValidatorID: 11}}
+
+	// Two express validators per standard one, starting one express slot into the cycle
+	entries := orderQueueEntries(express, standard, 1, 2)
+	expected := []uint32{1, 10, 2, 3, 11, 4}
+	if len(entries) != len(expected) {
+		t.Fatalf("expected %d entries, got %d", len(expected), len(entries))
+	}
+	for i, id := range expected {
+		if entries[i].ValidatorID != id {
+			t.Errorf("position %d: expected validator %d, got %d", i+1, id, entries[i].ValidatorID)
+		}
+		if entries[i].Express != (id < 10) {
+			t.Errorf("position %d: wrong queue for validator %d", i+1, id)
+		}
+	}
+
+	// An empty queue gives up its turns
+	entries = orderQueueEntries(nil, standard, 2, 2)
+	if len(entries) != 2 || entries[0].ValidatorID != 10 || entries[1].ValidatorID != 11 {
+		t.Errorf("unexpected order with an empty express queue: %+v", entries)
+	}
+}
+
+func TestForecastQueue(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	megapoolAddress := common.HexToAddress("0x1234")
+	entries := []forecastQueueEntry{
+		{DepositQueueValue: storage.DepositQueueValue{Receiver: megapoolAddress, ValidatorID: 0, RequestedValue: 32000, SuppliedValue: 4000}, Express: true},
+		{DepositQueueValue: storage.DepositQueueValue{Receiver: megapoolAddress, ValidatorID: 1, RequestedValue: 32000, SuppliedValue: 4000}},
+		{DepositQueueValue: storage.DepositQueueValue{ValidatorID: 0, RequestedValue: 32000, SuppliedValue: 4000}},
+	}
+
+	// 30 ETH is already available and 28 ETH arrives per day, varying by 10 ETH
+	history := queueForecastHistory{
+		DailyInflows:     []float64{18, 38, 18, 38},
+		DailyAssignments: []float64{10, 10, 10, 10},
+	}
+	forecast := forecastQueue(entries, rpmath.EthToWei(30), history, now)
+	if forecast.DailyInflowMean != 28 || math.Abs(forecast.DailyInflowStdDev-11.547) > 0.001 {
+		t.Errorf("unexpected inflow stats: %f ± %f", forecast.DailyInflowMean, forecast.DailyInflowStdDev)
+	}
+	if len(forecast.Entries) != 3 {
+		t.Fatalf("expected 3 entries, got %d", len(forecast.Entries))
+	}
+
+	// The first validator is funded, so it only waits on the queue's throughput
+	first := forecast.Entries[0]
+	if !first.Funded || first.EthRequired != 0 || first.ExpectedTime != now.Add(144*time.Minute) {
+		t.Errorf("unexpected forecast for the first validator: %+v", first)
+	}
+
+	// The second needs 26 more ETH, which takes just under a day at the mean inflow
+	second := forecast.Entries[1]
+	if second.Funded || second.EthRequired != 26 || second.Position != 2 {
+		t.Errorf("unexpected forecast for the second validator: %+v", second)
+	}
+	expected := addDays(now, 26.0/28)
+	if second.ExpectedTime != expected {
+		t.Errorf("expected the second validator at %s, got %s", expected, second.ExpectedTime)
+	}
+	if !second.EarliestTime.Before(second.ExpectedTime) || !second.LatestTime.After(second.ExpectedTime) || second.Unbounded {
+		t.Errorf("expected a range around the forecast, got %s to %s", second.EarliestTime, second.LatestTime)
+	}
+
+	// With no inflows, unfunded validators can't be forecast
+	forecast = forecastQueue(entries, big.NewInt(0), queueForecastHistory{DailyInflows: []float64{0, 0}}, now)
+	for _, entry := range forecast.Entries {
+		if !entry.ExpectedTime.IsZero() || !entry.Unbounded {
+			t.Errorf("expected no forecast without inflows, got %+v", entry)
+		}
+	}
+
+	if entries := GetMegapoolQueueForecast(forecastQueue(entries, big.NewInt(0), history, now), megapoolAddress); len(entries) != 2 {
+		t.Errorf("expected 2 validators for the megapool, got %d", len(entries))
+	}
+}
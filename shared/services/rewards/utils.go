@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"math/big"
 	"net/http"
 	"os"
@@ -77,6 +78,60 @@ func GetClaimStatus(rp *rocketpool.RocketPool, nodeAddress common.Address) (uncl
 	return
 }
 
+// The rewards a node hasn't claimed yet
+type UnclaimedRewards struct {
+	Rpl *big.Int
+	Eth *big.Int
+
+	// Unclaimed intervals whose rewards file isn't available locally, so they aren't included in the totals
+	MissingIntervals []uint64
+}
+
+// Gets the RPL and ETH each node can claim from the rewards intervals it hasn't claimed yet.
+// Each interval's rewards file is only read once for all of the nodes.
+func GetUnclaimedRewards(rp *rocketpool.RocketPool, cfg *config.RocketPoolConfig, nodeAddresses []common.Address) (map[common.Address]*UnclaimedRewards, error) {
+	rewardsFiles := map[uint64]IRewardsFile{}
+	unclaimedRewards := map[common.Address]*UnclaimedRewards{}
+	for _, nodeAddress := range nodeAddresses {
+		rewards := &UnclaimedRewards{
+			Rpl: big.NewInt(0),
+			Eth: big.NewInt(0),
+		}
+		unclaimedRewards[nodeAddress] = rewards
+
+		unclaimed, _, err := GetClaimStatus(rp, nodeAddress)
+		if err != nil {
+			return nil, fmt.Errorf("error getting the claimed intervals for node %s: %w", nodeAddress.Hex(), err)
+		}
+		for _, interval := range unclaimed {
+			rewardsFile, loaded := rewardsFiles[interval]
+			if !loaded {
+				path := cfg.Smartnode.GetRewardsTreePath(interval, true, config.RewardsExtensionJSON)
+				localFile, err := ReadLocalRewardsFile(path)
+				if errors.Is(err, fs.ErrNotExist) {
+					rewardsFiles[interval] = nil
+				} else if err != nil {
+					return nil, err
+				} else {
+					rewardsFile = localFile.Impl()
+					rewardsFiles[interval] = rewardsFile
+				}
+			}
+			if rewardsFile == nil {
+				rewards.MissingIntervals = append(rewards.MissingIntervals, interval)
+				continue
+			}
+			if !rewardsFile.HasRewardsFor(nodeAddress) {
+				continue
+			}
+			rewards.Rpl.Add(rewards.Rpl, rewardsFile.GetNodeCollateralRpl(nodeAddress))
+			rewards.Rpl.Add(rewards.Rpl, rewardsFile.GetNodeOracleDaoRpl(nodeAddress))
+			rewards.Eth.Add(rewards.Eth, rewardsFile.GetNodeEth(nodeAddress))
+		}
+	}
+	return unclaimedRewards, nil
+}
+
 // Gets the information for an interval including the file status, the validity, and the node's rewards
 func GetIntervalInfo(rp *rocketpool.RocketPool, cfg *config.RocketPoolConfig, nodeAddress common.Address, interval uint64, opts *bind.CallOpts) (info IntervalInfo, err error) {
 	info.Index = interval
@@ -72,6 +72,9 @@ type Globals struct {
 	GasLimit    uint64
 	DebugPrint  bool
 	CustomNonce *big.Int
+
+	// If set, transactions are written unsigned to this file instead of being sent
+	ExportUnsignedPath string
 }
 
 // Rocket Pool client
@@ -145,6 +148,11 @@ func SetDefaults(g Globals) {
 	Defaults = g
 }
 
+// Write the next transaction unsigned to the given file instead of sending it
+func SetExportUnsignedPath(path string) {
+	Defaults.ExportUnsignedPath = path
+}
+
 // Create new Rocket Pool client from CLI context without checking for sync status
 // Only use this function from commands that may work if the Daemon service doesn't exist
 // Most users should call NewClient().WithStatus() or NewClient().WithReady()
@@ -1392,6 +1400,9 @@ func (c *Client) callHTTPAPICtx(ctx context.Context, method, path string, params
 		if c.globals.CustomNonce != nil {
 			params.Set("nonce", c.globals.CustomNonce.String())
 		}
+		if c.globals.ExportUnsignedPath != "" {
+			params.Set("exportUnsigned", "true")
+		}
 		body := []byte(params.Encode())
 		req, err = http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
 		if err == nil {
@@ -1436,6 +1447,12 @@ func (c *Client) callHTTPAPICtx(ctx context.Context, method, path string, params
 		return nil, fmt.Errorf("HTTP API %s %s returned status %d: %s", method, path, resp.StatusCode, string(responseBytes))
 	}
 
+	if method == http.MethodPost && c.globals.ExportUnsignedPath != "" {
+		if err := c.exportUnsignedTransaction(responseBytes); err != nil {
+			return nil, err
+		}
+	}
+
 	return responseBytes, nil
 }
 
@@ -0,0 +1,25 @@
+package rocketpool
+
+import (
+	"fmt"
+
+	"github.com/goccy/go-json"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Get the next phase change of every open governance proposal
+func (c *Client) GovernanceUpcoming() (api.GovernanceUpcomingResponse, error) {
+	responseBytes, err := c.callHTTPAPI("GET", "/api/governance/upcoming", nil)
+	if err != nil {
+		return api.GovernanceUpcomingResponse{}, fmt.Errorf("Could not get upcoming governance events: %w", err)
+	}
+	var response api.GovernanceUpcomingResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.GovernanceUpcomingResponse{}, fmt.Errorf("Could not decode upcoming governance events response: %w", err)
+	}
+	if response.Error != "" {
+		return api.GovernanceUpcomingResponse{}, fmt.Errorf("Could not get upcoming governance events: %s", response.Error)
+	}
+	return response, nil
+}
@@ -608,3 +608,55 @@ func (c *Client) GetNewValidatorBondRequirement() (api.GetNewValidatorBondRequir
 // DissolveWithProof and CanDissolveWithProof client methods added above.
 // CanDissolveWithProof / DissolveWithProof (also known as DissolveWithProof) are
 // already implemented above.
+
+// Generate standard deposit data for the node's next megapool validators without saving their keys or making a deposit
+func (c *Client) GetMegapoolDepositData(count uint64) (api.MegapoolDepositDataResponse, error) {
+	responseBytes, err := c.callHTTPAPI("GET", "/api/megapool/deposit-data", url.Values{"count": {strconv.FormatUint(count, 10)}})
+	if err != nil {
+		return api.MegapoolDepositDataResponse{}, fmt.Errorf("Could not get megapool deposit data: %w", err)
+	}
+	var response api.MegapoolDepositDataResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.MegapoolDepositDataResponse{}, fmt.Errorf("Could not decode megapool deposit data response: %w", err)
+	}
+	if response.Error != "" {
+		return api.MegapoolDepositDataResponse{}, fmt.Errorf("Could not get megapool deposit data: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Check deposit data against the node's megapool withdrawal credentials and the Beacon Chain's config
+func (c *Client) VerifyMegapoolDepositData(entries []api.DepositDataEntry) (api.VerifyMegapoolDepositDataResponse, error) {
+	entriesJSON, err := json.Marshal(entries)
+	if err != nil {
+		return api.VerifyMegapoolDepositDataResponse{}, fmt.Errorf("Could not encode deposit data: %w", err)
+	}
+	responseBytes, err := c.callHTTPAPI("POST", "/api/megapool/verify-deposit-data", url.Values{"depositData": {string(entriesJSON)}})
+	if err != nil {
+		return api.VerifyMegapoolDepositDataResponse{}, fmt.Errorf("Could not verify megapool deposit data: %w", err)
+	}
+	var response api.VerifyMegapoolDepositDataResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.VerifyMegapoolDepositDataResponse{}, fmt.Errorf("Could not decode verify megapool deposit data response: %w", err)
+	}
+	if response.Error != "" {
+		return api.VerifyMegapoolDepositDataResponse{}, fmt.Errorf("Could not verify megapool deposit data: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get the timeline of one of the node's megapool validators
+func (c *Client) GetMegapoolValidatorHistory(validatorId uint32) (api.MegapoolValidatorHistoryResponse, error) {
+	responseBytes, err := c.callHTTPAPI("GET", "/api/megapool/validator-history", url.Values{"validatorId": {strconv.FormatUint(uint64(validatorId), 10)}})
+	if err != nil {
+		return api.MegapoolValidatorHistoryResponse{}, fmt.Errorf("Could not get megapool validator history: %w", err)
+	}
+	var response api.MegapoolValidatorHistoryResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.MegapoolValidatorHistoryResponse{}, fmt.Errorf("Could not decode megapool validator history response: %w", err)
+	}
+	if response.Error != "" {
+		return api.MegapoolValidatorHistoryResponse{}, fmt.Errorf("Could not get megapool validator history: %s", response.Error)
+	}
+	return response, nil
+}
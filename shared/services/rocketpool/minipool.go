@@ -186,6 +186,57 @@ func (c *Client) ExitMinipool(address common.Address) (api.ExitMinipoolResponse,
 	return response, nil
 }
 
+// Create a plan to migrate minipools to the node's megapool, exiting them immediately
+func (c *Client) CreateMinipoolMigrationPlan(addresses []common.Address, useExpressTickets bool) (api.MinipoolMigrationPlanResponse, error) {
+	responseBytes, err := c.callHTTPAPI("POST", "/api/minipool/migrate/create-plan", url.Values{
+		"addresses":         {joinAddresses(addresses)},
+		"useExpressTickets": {strconv.FormatBool(useExpressTickets)},
+	})
+	if err != nil {
+		return api.MinipoolMigrationPlanResponse{}, fmt.Errorf("Could not create minipool migration plan: %w", err)
+	}
+	var response api.MinipoolMigrationPlanResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.MinipoolMigrationPlanResponse{}, fmt.Errorf("Could not decode create minipool migration plan response: %w", err)
+	}
+	if response.Error != "" {
+		return api.MinipoolMigrationPlanResponse{}, fmt.Errorf("Could not create minipool migration plan: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get the node's minipool migration plan
+func (c *Client) GetMinipoolMigrationPlan() (api.MinipoolMigrationPlanResponse, error) {
+	responseBytes, err := c.callHTTPAPI("GET", "/api/minipool/migrate/status", nil)
+	if err != nil {
+		return api.MinipoolMigrationPlanResponse{}, fmt.Errorf("Could not get minipool migration plan: %w", err)
+	}
+	var response api.MinipoolMigrationPlanResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.MinipoolMigrationPlanResponse{}, fmt.Errorf("Could not decode minipool migration plan response: %w", err)
+	}
+	if response.Error != "" {
+		return api.MinipoolMigrationPlanResponse{}, fmt.Errorf("Could not get minipool migration plan: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Stop tracking the node's minipool migration plan
+func (c *Client) CancelMinipoolMigrationPlan() (api.CancelMinipoolMigrationResponse, error) {
+	responseBytes, err := c.callHTTPAPI("POST", "/api/minipool/migrate/cancel", nil)
+	if err != nil {
+		return api.CancelMinipoolMigrationResponse{}, fmt.Errorf("Could not cancel minipool migration plan: %w", err)
+	}
+	var response api.CancelMinipoolMigrationResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CancelMinipoolMigrationResponse{}, fmt.Errorf("Could not decode cancel minipool migration plan response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CancelMinipoolMigrationResponse{}, fmt.Errorf("Could not cancel minipool migration plan: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Check all of the node's minipools for closure eligibility, and return the details of the closeable ones
 func (c *Client) GetMinipoolCloseDetailsForNode() (api.GetMinipoolCloseDetailsForNodeResponse, error) {
 	responseBytes, err := c.callHTTPAPI("GET", "/api/minipool/get-minipool-close-details-for-node", nil)
@@ -1425,3 +1425,90 @@ func (c *Client) GetBondRequirement(numValidators uint64) (api.GetBondRequiremen
 	}
 	return response, nil
 }
+
+// Get the status of every node on the watch list
+func (c *Client) WatchedNodesStatus() (api.WatchedNodesStatusResponse, error) {
+	responseBytes, err := c.callHTTPAPI("GET", "/api/node/watched-nodes", nil)
+	if err != nil {
+		return api.WatchedNodesStatusResponse{}, fmt.Errorf("Could not get watched nodes status: %w", err)
+	}
+	var response api.WatchedNodesStatusResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.WatchedNodesStatusResponse{}, fmt.Errorf("Could not decode watched nodes status response: %w", err)
+	}
+	if response.Error != "" {
+		return api.WatchedNodesStatusResponse{}, fmt.Errorf("Could not get watched nodes status: %s", response.Error)
+	}
+	for i := range response.Nodes {
+		node := &response.Nodes[i]
+		zeroIfNil(&node.EthBalance)
+		zeroIfNil(&node.RplBalance)
+		zeroIfNil(&node.RplStake)
+		zeroIfNil(&node.EthBonded)
+		zeroIfNil(&node.EthBorrowed)
+		zeroIfNil(&node.FeeDistributorShare)
+		zeroIfNil(&node.MegapoolPendingRewards)
+		zeroIfNil(&node.MegapoolRefundValue)
+		zeroIfNil(&node.MegapoolDebt)
+	}
+	return response, nil
+}
+
+// Add nodes to the watch list
+func (c *Client) WatchNodes(addresses []common.Address) (api.WatchNodesResponse, error) {
+	responseBytes, err := c.callHTTPAPI("POST", "/api/node/watch-nodes", url.Values{"addresses": {joinAddresses(addresses)}})
+	if err != nil {
+		return api.WatchNodesResponse{}, fmt.Errorf("Could not watch nodes: %w", err)
+	}
+	var response api.WatchNodesResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.WatchNodesResponse{}, fmt.Errorf("Could not decode watch nodes response: %w", err)
+	}
+	if response.Error != "" {
+		return api.WatchNodesResponse{}, fmt.Errorf("Could not watch nodes: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Remove nodes from the watch list
+func (c *Client) UnwatchNodes(addresses []common.Address) (api.UnwatchNodesResponse, error) {
+	responseBytes, err := c.callHTTPAPI("POST", "/api/node/unwatch-nodes", url.Values{"addresses": {joinAddresses(addresses)}})
+	if err != nil {
+		return api.UnwatchNodesResponse{}, fmt.Errorf("Could not unwatch nodes: %w", err)
+	}
+	var response api.UnwatchNodesResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.UnwatchNodesResponse{}, fmt.Errorf("Could not decode unwatch nodes response: %w", err)
+	}
+	if response.Error != "" {
+		return api.UnwatchNodesResponse{}, fmt.Errorf("Could not unwatch nodes: %s", response.Error)
+	}
+	return response, nil
+}
+
+func joinAddresses(addresses []common.Address) string {
+	strs := make([]string, len(addresses))
+	for i, address := range addresses {
+		strs[i] = address.Hex()
+	}
+	return strings.Join(strs, ",")
+}
+
+// Simulate exiting a set of megapool validators and minipools; each selection is a comma-separated list or "all"
+func (c *Client) GetNodeExitPlan(validatorIds string, minipools string) (api.NodeExitPlanResponse, error) {
+	responseBytes, err := c.callHTTPAPI("GET", "/api/node/exit-plan", url.Values{
+		"validatorIds": {validatorIds},
+		"minipools":    {minipools},
+	})
+	if err != nil {
+		return api.NodeExitPlanResponse{}, fmt.Errorf("Could not get node exit plan: %w", err)
+	}
+	var response api.NodeExitPlanResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeExitPlanResponse{}, fmt.Errorf("Could not decode node exit plan response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeExitPlanResponse{}, fmt.Errorf("Could not get node exit plan: %s", response.Error)
+	}
+	return response, nil
+}
@@ -82,6 +82,38 @@ func (c *Client) TNDAOProposal(id uint64) (api.TNDAOProposalResponse, error) {
 	return response, nil
 }
 
+// Decode an oracle DAO proposal's payload and compare it with the current settings or contracts
+func (c *Client) TNDAOProposalDiff(id uint64) (api.TNDAOProposalDiffResponse, error) {
+	responseBytes, err := c.callHTTPAPI("GET", "/api/odao/proposal-diff", url.Values{"id": {strconv.FormatUint(id, 10)}})
+	if err != nil {
+		return api.TNDAOProposalDiffResponse{}, fmt.Errorf("Could not get oracle DAO proposal diff: %w", err)
+	}
+	var response api.TNDAOProposalDiffResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.TNDAOProposalDiffResponse{}, fmt.Errorf("Could not decode oracle DAO proposal diff response: %w", err)
+	}
+	if response.Error != "" {
+		return api.TNDAOProposalDiffResponse{}, fmt.Errorf("Could not get oracle DAO proposal diff: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get the node's oracle DAO duty report over the last lookbackDays
+func (c *Client) TNDAODuties(lookbackDays uint64) (api.TNDAODutiesResponse, error) {
+	responseBytes, err := c.callHTTPAPI("GET", "/api/odao/duties", url.Values{"lookbackDays": {strconv.FormatUint(lookbackDays, 10)}})
+	if err != nil {
+		return api.TNDAODutiesResponse{}, fmt.Errorf("Could not get oracle DAO duties: %w", err)
+	}
+	var response api.TNDAODutiesResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.TNDAODutiesResponse{}, fmt.Errorf("Could not decode oracle DAO duties response: %w", err)
+	}
+	if response.Error != "" {
+		return api.TNDAODutiesResponse{}, fmt.Errorf("Could not get oracle DAO duties: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Check whether the node can propose inviting a new member
 func (c *Client) CanProposeInviteToTNDAO(memberAddress common.Address, memberId, memberUrl string) (api.CanProposeTNDAOInviteResponse, error) {
 	responseBytes, err := c.callHTTPAPI("GET", "/api/odao/can-propose-invite", url.Values{
@@ -252,6 +252,80 @@ func (c *Client) PDAOProposeSettingMulti(settings []api.PDAOBatchSetting, custom
 	return response, nil
 }
 
+// Simulate executing a proposal that updates one or more PDAO settings
+func (c *Client) PDAOSimulateSettingMulti(settings []api.PDAOBatchSetting) (api.SimulatePDAOSettingMultiResponse, error) {
+	settingsJSON, err := json.Marshal(settings)
+	if err != nil {
+		return api.SimulatePDAOSettingMultiResponse{}, fmt.Errorf("Could not encode multi-setting proposal: %w", err)
+	}
+	responseBytes, err := c.callHTTPAPI("POST", "/api/pdao/simulate-setting-multi", url.Values{
+		"settings": {string(settingsJSON)},
+	})
+	if err != nil {
+		return api.SimulatePDAOSettingMultiResponse{}, fmt.Errorf("Could not get protocol DAO simulate-setting-multi: %w", err)
+	}
+	var response api.SimulatePDAOSettingMultiResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SimulatePDAOSettingMultiResponse{}, fmt.Errorf("Could not decode protocol DAO simulate-setting-multi response: %w", err)
+	}
+	if response.Error != "" {
+		return api.SimulatePDAOSettingMultiResponse{}, fmt.Errorf("Could not get protocol DAO simulate-setting-multi: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Export the pDAO voting trees for a block, optionally including a node's tree
+func (c *Client) PDAOExportVotingTree(blockNumber uint32, nodeAddress *common.Address) (api.PDAOExportVotingTreeResponse, error) {
+	params := url.Values{"blockNumber": {strconv.FormatUint(uint64(blockNumber), 10)}}
+	if nodeAddress != nil {
+		params.Set("address", nodeAddress.Hex())
+	}
+	responseBytes, err := c.callHTTPAPI("GET", "/api/pdao/export-voting-tree", params)
+	if err != nil {
+		return api.PDAOExportVotingTreeResponse{}, fmt.Errorf("Could not export protocol DAO voting tree: %w", err)
+	}
+	var response api.PDAOExportVotingTreeResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.PDAOExportVotingTreeResponse{}, fmt.Errorf("Could not decode protocol DAO voting tree export response: %w", err)
+	}
+	if response.Error != "" {
+		return api.PDAOExportVotingTreeResponse{}, fmt.Errorf("Could not export protocol DAO voting tree: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Check the roots submitted for a proposal against the locally computed voting tree
+func (c *Client) PDAOVerifyVotingTree(proposalID uint64) (api.PDAOVerifyVotingTreeResponse, error) {
+	responseBytes, err := c.callHTTPAPI("GET", "/api/pdao/verify-voting-tree", url.Values{"id": {strconv.FormatUint(proposalID, 10)}})
+	if err != nil {
+		return api.PDAOVerifyVotingTreeResponse{}, fmt.Errorf("Could not verify protocol DAO voting tree: %w", err)
+	}
+	var response api.PDAOVerifyVotingTreeResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.PDAOVerifyVotingTreeResponse{}, fmt.Errorf("Could not decode protocol DAO voting tree verification response: %w", err)
+	}
+	if response.Error != "" {
+		return api.PDAOVerifyVotingTreeResponse{}, fmt.Errorf("Could not verify protocol DAO voting tree: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get the voting power distribution at a proposal's target block, including delegations
+func (c *Client) PDAOVotingPower(proposalID uint64) (api.PDAOVotingPowerResponse, error) {
+	responseBytes, err := c.callHTTPAPI("GET", "/api/pdao/voting-power", url.Values{"id": {strconv.FormatUint(proposalID, 10)}})
+	if err != nil {
+		return api.PDAOVotingPowerResponse{}, fmt.Errorf("Could not get protocol DAO voting power: %w", err)
+	}
+	var response api.PDAOVotingPowerResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.PDAOVotingPowerResponse{}, fmt.Errorf("Could not decode protocol DAO voting power response: %w", err)
+	}
+	if response.Error != "" {
+		return api.PDAOVotingPowerResponse{}, fmt.Errorf("Could not get protocol DAO voting power: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Propose updating a PDAO setting
 func (c *Client) PDAOProposeSetting(contract string, setting string, value string, blockNumber uint32) (api.ProposePDAOSettingResponse, error) {
 	responseBytes, err := c.callHTTPAPI("POST", "/api/pdao/propose-setting", url.Values{
@@ -309,6 +383,26 @@ func (c *Client) PDAOCanProposeRewardsPercentages(node *big.Int, odao *big.Int,
 	return response, nil
 }
 
+// Simulate executing a proposal for new RPL rewards allocation percentages
+func (c *Client) PDAOSimulateRewardsPercentages(node *big.Int, odao *big.Int, pdao *big.Int) (api.SimulatePDAOSettingMultiResponse, error) {
+	responseBytes, err := c.callHTTPAPI("GET", "/api/pdao/simulate-rewards-percentages", url.Values{
+		"node": {node.String()},
+		"odao": {odao.String()},
+		"pdao": {pdao.String()},
+	})
+	if err != nil {
+		return api.SimulatePDAOSettingMultiResponse{}, fmt.Errorf("Could not get protocol DAO simulate-rewards-percentages: %w", err)
+	}
+	var response api.SimulatePDAOSettingMultiResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SimulatePDAOSettingMultiResponse{}, fmt.Errorf("Could not decode protocol DAO simulate-rewards-percentages response: %w", err)
+	}
+	if response.Error != "" {
+		return api.SimulatePDAOSettingMultiResponse{}, fmt.Errorf("Could not get protocol DAO simulate-rewards-percentages: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Propose new RPL rewards allocation percentages
 func (c *Client) PDAOProposeRewardsPercentages(node *big.Int, odao *big.Int, pdao *big.Int, blockNumber uint32) (api.ProposePDAOSettingResponse, error) {
 	responseBytes, err := c.callHTTPAPI("POST", "/api/pdao/propose-rewards-percentages", url.Values{
@@ -882,6 +976,57 @@ func (c *Client) ClearSignallingAddress() (api.PDAOSetSignallingAddressResponse,
 	return response, nil
 }
 
+// Check whether the node can vote on a Snapshot proposal
+func (c *Client) PDAOCanSnapshotVote(proposalID common.Hash) (api.PDAOCanSnapshotVoteResponse, error) {
+	responseBytes, err := c.callHTTPAPI("GET", "/api/pdao/can-snapshot-vote", url.Values{"id": {proposalID.Hex()}})
+	if err != nil {
+		return api.PDAOCanSnapshotVoteResponse{}, fmt.Errorf("Could not get protocol DAO can-snapshot-vote: %w", err)
+	}
+	var response api.PDAOCanSnapshotVoteResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.PDAOCanSnapshotVoteResponse{}, fmt.Errorf("Could not decode protocol DAO can-snapshot-vote response: %w", err)
+	}
+	if response.Error != "" {
+		return api.PDAOCanSnapshotVoteResponse{}, fmt.Errorf("Could not get protocol DAO can-snapshot-vote: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Vote on a Snapshot proposal with the node wallet
+func (c *Client) PDAOSnapshotVote(proposalID common.Hash, choice uint32, reason string) (api.PDAOSnapshotVoteResponse, error) {
+	return c.submitSnapshotVote(url.Values{
+		"id":     {proposalID.Hex()},
+		"choice": {strconv.FormatUint(uint64(choice), 10)},
+		"reason": {reason},
+	})
+}
+
+// Vote on a Snapshot proposal with a vote signed by the node's signalling address
+func (c *Client) PDAOSnapshotVoteSigned(proposalID common.Hash, choice uint32, reason string, timestamp uint64, signature string) (api.PDAOSnapshotVoteResponse, error) {
+	return c.submitSnapshotVote(url.Values{
+		"id":        {proposalID.Hex()},
+		"choice":    {strconv.FormatUint(uint64(choice), 10)},
+		"reason":    {reason},
+		"timestamp": {strconv.FormatUint(timestamp, 10)},
+		"signature": {signature},
+	})
+}
+
+func (c *Client) submitSnapshotVote(params url.Values) (api.PDAOSnapshotVoteResponse, error) {
+	responseBytes, err := c.callHTTPAPI("POST", "/api/pdao/snapshot-vote", params)
+	if err != nil {
+		return api.PDAOSnapshotVoteResponse{}, fmt.Errorf("Could not vote on Snapshot proposal: %w", err)
+	}
+	var response api.PDAOSnapshotVoteResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.PDAOSnapshotVoteResponse{}, fmt.Errorf("Could not decode Snapshot vote response: %w", err)
+	}
+	if response.Error != "" {
+		return api.PDAOSnapshotVoteResponse{}, fmt.Errorf("Could not vote on Snapshot proposal: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Check whether the node can propose a list of addresses that can update commission share parameters
 func (c *Client) PDAOCanProposeAllowListedControllers(addressList string) (api.PDAOACanProposeAllowListedControllersResponse, error) {
 	responseBytes, err := c.callHTTPAPI("GET", "/api/pdao/can-propose-allow-listed-controllers", url.Values{"addressList": {addressList}})
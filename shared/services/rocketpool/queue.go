@@ -110,3 +110,19 @@ func (c *Client) GetQueueDetails() (api.GetQueueDetailsResponse, error) {
 	}
 	return response, nil
 }
+
+// Forecast when the validators in the megapool queues will be assigned
+func (c *Client) GetQueueForecast(lookbackDays uint32) (api.QueueForecastResponse, error) {
+	responseBytes, err := c.callHTTPAPI("GET", "/api/queue/forecast", url.Values{"lookbackDays": {fmt.Sprintf("%d", lookbackDays)}})
+	if err != nil {
+		return api.QueueForecastResponse{}, fmt.Errorf("Could not get queue forecast: %w", err)
+	}
+	var response api.QueueForecastResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.QueueForecastResponse{}, fmt.Errorf("Could not decode queue forecast response: %w", err)
+	}
+	if response.Error != "" {
+		return api.QueueForecastResponse{}, fmt.Errorf("Could not get queue forecast: %s", response.Error)
+	}
+	return response, nil
+}
@@ -73,6 +73,22 @@ func (c *Client) SecurityProposal(id uint64) (api.SecurityProposalResponse, erro
 	return response, nil
 }
 
+// Decode a security council proposal's payload and compare it with the current settings
+func (c *Client) SecurityProposalDiff(id uint64) (api.SecurityProposalDiffResponse, error) {
+	responseBytes, err := c.callHTTPAPI("GET", "/api/security/proposal-diff", url.Values{"id": {fmt.Sprintf("%d", id)}})
+	if err != nil {
+		return api.SecurityProposalDiffResponse{}, fmt.Errorf("Could not get security council proposal diff: %w", err)
+	}
+	var response api.SecurityProposalDiffResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SecurityProposalDiffResponse{}, fmt.Errorf("Could not decode security council proposal diff response: %w", err)
+	}
+	if response.Error != "" {
+		return api.SecurityProposalDiffResponse{}, fmt.Errorf("Could not get security council proposal diff: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Check whether the node can propose to leave the security council
 func (c *Client) SecurityProposeLeave() (api.SecurityProposeLeaveResponse, error) {
 	responseBytes, err := c.callHTTPAPI("POST", "/api/security/propose-leave", nil)
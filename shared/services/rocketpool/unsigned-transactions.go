@@ -0,0 +1,79 @@
+package rocketpool
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/goccy/go-json"
+	"github.com/mitchellh/go-homedir"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Returned by API calls whose transaction was written to a file for offline signing instead of being sent
+type UnsignedTransactionExportedError struct {
+	Path        string
+	Transaction api.UnsignedTransaction
+}
+
+func (e *UnsignedTransactionExportedError) Error() string {
+	return fmt.Sprintf("the unsigned transaction was written to %s", e.Path)
+}
+
+// If the daemon returned an unsigned transaction instead of sending it, write it to the export file
+func (c *Client) exportUnsignedTransaction(responseBytes []byte) error {
+	var response api.UnsignedTransactionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil || response.UnsignedTransaction == nil {
+		// This route doesn't build a transaction
+		return nil
+	}
+
+	path, err := homedir.Expand(c.globals.ExportUnsignedPath)
+	if err != nil {
+		return fmt.Errorf("error expanding unsigned transaction path: %w", err)
+	}
+	txBytes, err := json.MarshalIndent(response.UnsignedTransaction, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error serializing unsigned transaction: %w", err)
+	}
+	if err := os.WriteFile(path, txBytes, 0644); err != nil {
+		return fmt.Errorf("error writing unsigned transaction to %s: %w", path, err)
+	}
+	return &UnsignedTransactionExportedError{
+		Path:        path,
+		Transaction: *response.UnsignedTransaction,
+	}
+}
+
+// Sign a transaction that was exported unsigned with the node wallet
+func (c *Client) SignTransaction(tx api.UnsignedTransaction) (api.SignTransactionResponse, error) {
+	responseBytes, err := c.callHTTPAPI("POST", "/api/wallet/sign-tx", url.Values{"serializedTx": {tx.SerializedTx}})
+	if err != nil {
+		return api.SignTransactionResponse{}, fmt.Errorf("Could not sign transaction: %w", err)
+	}
+	var response api.SignTransactionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SignTransactionResponse{}, fmt.Errorf("Could not decode sign transaction response: %w", err)
+	}
+	if response.Error != "" {
+		return api.SignTransactionResponse{}, fmt.Errorf("Could not sign transaction: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Submit a transaction that was signed offline
+func (c *Client) BroadcastTransaction(tx api.SignedTransaction) (api.BroadcastTransactionResponse, error) {
+	responseBytes, err := c.callHTTPAPI("POST", "/api/node/broadcast", url.Values{"serializedTx": {tx.SerializedTx}})
+	if err != nil {
+		return api.BroadcastTransactionResponse{}, fmt.Errorf("Could not broadcast transaction: %w", err)
+	}
+	var response api.BroadcastTransactionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.BroadcastTransactionResponse{}, fmt.Errorf("Could not decode broadcast transaction response: %w", err)
+	}
+	if response.Error != "" {
+		return api.BroadcastTransactionResponse{}, fmt.Errorf("Could not broadcast transaction: %s", response.Error)
+	}
+	return response, nil
+}
@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/goccy/go-json"
 
+	"github.com/rocket-pool/smartnode/bindings/types"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
 
@@ -241,6 +244,85 @@ func (c *Client) ExportWallet() (api.ExportWalletResponse, error) {
 	return response, nil
 }
 
+// Export the node's validator keys as EIP-2335 keystores.
+// Empty filters export every validator key the node has.
+func (c *Client) ExportValidatorKeys(pubkeys []types.ValidatorPubkey, minipools []common.Address, megapoolValidatorIds []uint32) (api.ExportValidatorKeysResponse, error) {
+	// Every key is re-encrypted with scrypt, which can take a while for large nodes
+	responseBytes, err := c.callHTTPAPICtx(context.Background(), "POST", "/api/wallet/export-validator-keys", validatorKeyFilterParams(pubkeys, minipools, megapoolValidatorIds))
+	if err != nil {
+		return api.ExportValidatorKeysResponse{}, fmt.Errorf("Could not export validator keys: %w", err)
+	}
+	var response api.ExportValidatorKeysResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ExportValidatorKeysResponse{}, fmt.Errorf("Could not decode export validator keys response: %w", err)
+	}
+	if response.Error != "" {
+		return api.ExportValidatorKeysResponse{}, fmt.Errorf("Could not export validator keys: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Compare the node's derived validator keys and Validator Client keystores against its on-chain validators.
+// A bound of 0 checks a default number of indices past the wallet's next key index.
+func (c *Client) AuditValidatorKeys(bound uint) (api.AuditValidatorKeysResponse, error) {
+	// Deriving every key in the bound can take a while
+	responseBytes, err := c.callHTTPAPICtx(context.Background(), "GET", "/api/wallet/audit-validator-keys", url.Values{"bound": {strconv.FormatUint(uint64(bound), 10)}})
+	if err != nil {
+		return api.AuditValidatorKeysResponse{}, fmt.Errorf("Could not audit validator keys: %w", err)
+	}
+	var response api.AuditValidatorKeysResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.AuditValidatorKeysResponse{}, fmt.Errorf("Could not decode audit validator keys response: %w", err)
+	}
+	if response.Error != "" {
+		return api.AuditValidatorKeysResponse{}, fmt.Errorf("Could not audit validator keys: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Import EIP-2335 keystores for the node's validators into all of its Validator Client keystores.
+// Empty filters import every provided key that belongs to the node.
+func (c *Client) ImportValidatorKeys(keys []api.ImportedValidatorKey, pubkeys []types.ValidatorPubkey, minipools []common.Address, megapoolValidatorIds []uint32) (api.ImportValidatorKeysResponse, error) {
+	keysJSON, err := json.Marshal(keys)
+	if err != nil {
+		return api.ImportValidatorKeysResponse{}, fmt.Errorf("Could not encode validator keys: %w", err)
+	}
+	params := validatorKeyFilterParams(pubkeys, minipools, megapoolValidatorIds)
+	params.Set("keys", string(keysJSON))
+	responseBytes, err := c.callHTTPAPICtx(context.Background(), "POST", "/api/wallet/import-validator-keys", params)
+	if err != nil {
+		return api.ImportValidatorKeysResponse{}, fmt.Errorf("Could not import validator keys: %w", err)
+	}
+	var response api.ImportValidatorKeysResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ImportValidatorKeysResponse{}, fmt.Errorf("Could not decode import validator keys response: %w", err)
+	}
+	if response.Error != "" {
+		return api.ImportValidatorKeysResponse{}, fmt.Errorf("Could not import validator keys: %s", response.Error)
+	}
+	return response, nil
+}
+
+func validatorKeyFilterParams(pubkeys []types.ValidatorPubkey, minipools []common.Address, megapoolValidatorIds []uint32) url.Values {
+	pubkeyStrings := make([]string, len(pubkeys))
+	for i, pubkey := range pubkeys {
+		pubkeyStrings[i] = pubkey.Hex()
+	}
+	minipoolStrings := make([]string, len(minipools))
+	for i, address := range minipools {
+		minipoolStrings[i] = address.Hex()
+	}
+	idStrings := make([]string, len(megapoolValidatorIds))
+	for i, id := range megapoolValidatorIds {
+		idStrings[i] = strconv.FormatUint(uint64(id), 10)
+	}
+	return url.Values{
+		"pubkeys":              {strings.Join(pubkeyStrings, ",")},
+		"minipools":            {strings.Join(minipoolStrings, ",")},
+		"megapoolValidatorIds": {strings.Join(idStrings, ",")},
+	}
+}
+
 // Set the node address to an arbitrary address
 func (c *Client) Masquerade(address common.Address, observe bool) (api.MasqueradeResponse, error) {
 	observeStr := "false"
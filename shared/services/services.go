@@ -92,7 +92,8 @@ func GetWallet(c *cli.Command) (wallet.Wallet, error) {
 // body (maxFee, maxPrioFee, gasLimit fields in Gwei / uint64).  This ensures
 // the fee cap and tip cap the user selected interactively in the CLI are
 // honoured by the daemon, which otherwise only knows about the values in the
-// config file.
+// config file. If the request has an unsigned transaction recorder attached,
+// the transactor records its transactions instead of signing and sending them.
 func GetNodeAccountTransactorFromRequest(c *cli.Command, r *http.Request) (*bind.TransactOpts, error) {
 	w, err := GetWallet(c)
 	if err != nil {
@@ -122,6 +123,13 @@ func GetNodeAccountTransactorFromRequest(c *cli.Command, r *http.Request) (*bind
 			opts.Nonce = nonce
 		}
 	}
+	if recorder := getUnsignedTransactionRecorder(r); recorder != nil {
+		cfg, err := getConfig(c)
+		if err != nil {
+			return nil, err
+		}
+		recorder.apply(opts, big.NewInt(int64(cfg.Smartnode.GetChainID())))
+	}
 	return opts, nil
 }
 
@@ -237,6 +245,19 @@ func GetBeaconState(bc beacon.Client) (eth2.BeaconState, error) {
 	return beaconState, nil
 }
 
+// Get the beacon state for a specific slot, e.g. the slot a proof is anchored in
+func GetBeaconStateForSlot(bc beacon.Client, slot uint64) (eth2.BeaconState, error) {
+	beaconStateResponse, err := bc.GetBeaconStateSSZ(slot)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the beacon state for slot %d: %w", slot, err)
+	}
+	beaconState, err := eth2.NewBeaconState(beaconStateResponse.Data, beaconStateResponse.Size, beaconStateResponse.Fork)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding the beacon state for slot %d: %w", slot, err)
+	}
+	return beaconState, nil
+}
+
 //
 // Service instance getters
 //
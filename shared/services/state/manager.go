@@ -77,6 +77,18 @@ func (m *NetworkStateManager) GetHeadStateForNode(nodeAddress common.Address) (*
 	return m.createNetworkState(targetSlot, []common.Address{nodeAddress})
 }
 
+// Get the state of the network for a set of nodes using the latest Execution layer block
+func (m *NetworkStateManager) GetHeadStateForNodes(nodeAddresses []common.Address) (*NetworkState, error) {
+	if len(nodeAddresses) == 0 {
+		return nil, fmt.Errorf("no node addresses provided")
+	}
+	targetSlot, err := m.getHeadSlot()
+	if err != nil {
+		return nil, fmt.Errorf("error getting latest Beacon slot: %w", err)
+	}
+	return m.createNetworkState(targetSlot, nodeAddresses)
+}
+
 // Get the state of the network at the provided Beacon slot
 func (m *NetworkStateManager) GetStateForSlot(slotNumber uint64) (*NetworkState, error) {
 	return m.createNetworkState(slotNumber, nil)
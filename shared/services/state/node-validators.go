@@ -0,0 +1,62 @@
+package state
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+// The number of a node's minipool and megapool validators in each Beacon chain status
+type NodeValidatorCounts struct {
+	NotOnBeacon int `json:"notOnBeacon"`
+	Pending     int `json:"pending"`
+	Active      int `json:"active"`
+	Exiting     int `json:"exiting"`
+	Exited      int `json:"exited"`
+	Slashed     int `json:"slashed"`
+}
+
+// Get the number of the node's validators in each Beacon chain status.
+// Slashed validators are only counted as slashed, regardless of their exit status.
+func (s *NetworkState) GetNodeValidatorCounts(nodeAddress common.Address) NodeValidatorCounts {
+	counts := NodeValidatorCounts{}
+	emptyPubkey := types.ValidatorPubkey{}
+
+	for _, mpd := range s.MinipoolDetailsByNode[nodeAddress] {
+		if mpd.Pubkey == emptyPubkey {
+			continue
+		}
+		counts.add(s.MinipoolValidatorDetails[mpd.Pubkey])
+	}
+
+	node, exists := s.NodeDetailsByAddress[nodeAddress]
+	if exists && node.MegapoolDeployed {
+		for _, pubkey := range s.MegapoolToPubkeysMap[node.MegapoolAddress] {
+			counts.add(s.MegapoolValidatorDetails[pubkey])
+		}
+	}
+
+	return counts
+}
+
+func (c *NodeValidatorCounts) add(status beacon.ValidatorStatus) {
+	if !status.Exists {
+		c.NotOnBeacon++
+		return
+	}
+	if status.Slashed {
+		c.Slashed++
+		return
+	}
+	switch status.Status {
+	case beacon.ValidatorState_PendingInitialized, beacon.ValidatorState_PendingQueued:
+		c.Pending++
+	case beacon.ValidatorState_ActiveOngoing:
+		c.Active++
+	case beacon.ValidatorState_ActiveExiting:
+		c.Exiting++
+	default:
+		c.Exited++
+	}
+}
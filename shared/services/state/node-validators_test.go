@@ -0,0 +1,43 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+func TestGetNodeValidatorCounts(t *testing.T) {
+	s := buildTestState()
+	nodeAddrA := common.HexToAddress("0xAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA")
+	nodeAddrB := common.HexToAddress("0xBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBB")
+
+	// Node A: one active minipool, one slashed minipool, and a megapool validator that hasn't been seen on Beacon yet
+	pubkeyA1 := newTestPubkey(0xA1)
+	pubkeyA2 := newTestPubkey(0xA2)
+	megapoolPubkey := newTestPubkey(0xCC)
+	s.MinipoolValidatorDetails[pubkeyA1] = beacon.ValidatorStatus{Pubkey: pubkeyA1, Exists: true, Status: beacon.ValidatorState_ActiveOngoing}
+	s.MinipoolValidatorDetails[pubkeyA2] = beacon.ValidatorStatus{Pubkey: pubkeyA2, Exists: true, Status: beacon.ValidatorState_ExitedSlashed, Slashed: true}
+	delete(s.MegapoolValidatorDetails, megapoolPubkey)
+
+	// Node B: one exiting minipool
+	pubkeyB1 := newTestPubkey(0xB1)
+	s.MinipoolValidatorDetails[pubkeyB1] = beacon.ValidatorStatus{Pubkey: pubkeyB1, Exists: true, Status: beacon.ValidatorState_ActiveExiting}
+
+	countsA := s.GetNodeValidatorCounts(nodeAddrA)
+	wantA := NodeValidatorCounts{NotOnBeacon: 1, Active: 1, Slashed: 1}
+	if countsA != wantA {
+		t.Errorf("node A: got %+v, want %+v", countsA, wantA)
+	}
+
+	countsB := s.GetNodeValidatorCounts(nodeAddrB)
+	wantB := NodeValidatorCounts{Exiting: 1}
+	if countsB != wantB {
+		t.Errorf("node B: got %+v, want %+v", countsB, wantB)
+	}
+
+	if counts := s.GetNodeValidatorCounts(common.Address{}); counts != (NodeValidatorCounts{}) {
+		t.Errorf("unknown node: expected no validators, got %+v", counts)
+	}
+}
@@ -11,6 +11,7 @@ import (
 type NetworkStateProvider interface {
 	GetHeadState() (*NetworkState, error)
 	GetHeadStateForNode(nodeAddress common.Address) (*NetworkState, error)
+	GetHeadStateForNodes(nodeAddresses []common.Address) (*NetworkState, error)
 	GetStateForSlot(slotNumber uint64) (*NetworkState, error)
 	GetLatestBeaconBlock() (beacon.BeaconBlock, error)
 	GetLatestFinalizedBeaconBlock() (beacon.BeaconBlock, error)
@@ -165,6 +165,10 @@ func (c *StaticBeaconClient) GetValidatorSyncDuties(_ []string, _ uint64) (map[s
 	return nil, ErrStaticMode
 }
 
+func (c *StaticBeaconClient) GetValidatorSyncCommitteeIndices(_ []string, _ uint64) (map[string][]uint64, error) {
+	return nil, ErrStaticMode
+}
+
 func (c *StaticBeaconClient) GetValidatorProposerDuties(_ []string, _ uint64) (map[string]uint64, error) {
 	return nil, ErrStaticMode
 }
@@ -63,6 +63,10 @@ func (p *StaticNetworkStateProvider) GetHeadStateForNode(_ common.Address) (*Net
 	return p.state, nil
 }
 
+func (p *StaticNetworkStateProvider) GetHeadStateForNodes(_ []common.Address) (*NetworkState, error) {
+	return p.state, nil
+}
+
 func (p *StaticNetworkStateProvider) GetStateForSlot(_ uint64) (*NetworkState, error) {
 	return p.state, nil
 }
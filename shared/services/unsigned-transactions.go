@@ -0,0 +1,67 @@
+package services
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+type unsignedTransactionRecorderKey struct{}
+
+// Records the transactions built for a request that asked for them to be exported unsigned instead of sent
+type UnsignedTransactionRecorder struct {
+	from         common.Address
+	transactions []*types.Transaction
+	lock         sync.Mutex
+}
+
+// Attach a new recorder to the request, so any transactor created for it will record its transactions instead of sending them
+func WithUnsignedTransactionRecorder(r *http.Request) (*http.Request, *UnsignedTransactionRecorder) {
+	recorder := &UnsignedTransactionRecorder{}
+	return r.WithContext(context.WithValue(r.Context(), unsignedTransactionRecorderKey{}, recorder)), recorder
+}
+
+// Get the account the transactions are from, and the transactions that were recorded
+func (r *UnsignedTransactionRecorder) GetTransactions() (common.Address, []*types.Transaction) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.from, r.transactions
+}
+
+// Set up a transactor to record its transactions unsigned instead of signing and sending them
+func (r *UnsignedTransactionRecorder) apply(opts *bind.TransactOpts, chainID *big.Int) {
+	opts.NoSend = true
+	opts.Signer = func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		// Unsigned EIP-1559 transactions don't get a chain ID until they're signed, so add it here for the offline signer to check
+		if tx.Type() == types.DynamicFeeTxType {
+			tx = types.NewTx(&types.DynamicFeeTx{
+				ChainID:    chainID,
+				Nonce:      tx.Nonce(),
+				GasTipCap:  tx.GasTipCap(),
+				GasFeeCap:  tx.GasFeeCap(),
+				Gas:        tx.Gas(),
+				To:         tx.To(),
+				Value:      tx.Value(),
+				Data:       tx.Data(),
+				AccessList: tx.AccessList(),
+			})
+		}
+
+		r.lock.Lock()
+		defer r.lock.Unlock()
+		r.from = address
+		r.transactions = append(r.transactions, tx)
+		return tx, nil
+	}
+}
+
+// Get the unsigned transaction recorder attached to a request, if there is one
+func getUnsignedTransactionRecorder(r *http.Request) *UnsignedTransactionRecorder {
+	recorder, _ := r.Context().Value(unsignedTransactionRecorderKey{}).(*UnsignedTransactionRecorder)
+	return recorder
+}
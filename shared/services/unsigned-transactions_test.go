@@ -0,0 +1,61 @@
+package services
+
+import (
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestUnsignedTransactionRecorder(t *testing.T) {
+	// Requests without a recorder are left alone
+	r := httptest.NewRequest("POST", "/api/node/stake-rpl", nil)
+	if getUnsignedTransactionRecorder(r) != nil {
+		t.Fatal("expected no recorder on a plain request")
+	}
+
+	r, recorder := WithUnsignedTransactionRecorder(r)
+	if getUnsignedTransactionRecorder(r) != recorder {
+		t.Fatal("expected the request to carry the recorder")
+	}
+
+	from := common.HexToAddress("0xAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA")
+	to := common.HexToAddress("0xBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBB")
+	opts := &bind.TransactOpts{From: from}
+	recorder.apply(opts, big.NewInt(560048))
+	if !opts.NoSend {
+		t.Error("expected the transactor to not send transactions")
+	}
+
+	// The bindings build EIP-1559 transactions without a chain ID
+	unsigned := types.NewTx(&types.DynamicFeeTx{
+		Nonce:     7,
+		GasTipCap: big.NewInt(1e9),
+		GasFeeCap: big.NewInt(20e9),
+		Gas:       100000,
+		To:        &to,
+		Value:     big.NewInt(0),
+		Data:      []byte{0x01, 0x02},
+	})
+	tx, err := opts.Signer(from, unsigned)
+	if err != nil {
+		t.Fatalf("unexpected error from the recording signer: %v", err)
+	}
+	if v, r, s := tx.RawSignatureValues(); v.Sign() != 0 || r.Sign() != 0 || s.Sign() != 0 {
+		t.Error("expected the recorded transaction to be unsigned")
+	}
+	if tx.ChainId().Cmp(big.NewInt(560048)) != 0 {
+		t.Errorf("expected chain ID 560048, got %s", tx.ChainId())
+	}
+
+	recordedFrom, txs := recorder.GetTransactions()
+	if recordedFrom != from {
+		t.Errorf("expected the transactions to be from %s, got %s", from.Hex(), recordedFrom.Hex())
+	}
+	if len(txs) != 1 || txs[0].Nonce() != 7 || txs[0].Gas() != 100000 || *txs[0].To() != to {
+		t.Errorf("expected the transaction to be recorded unchanged apart from its chain ID, got %+v", txs)
+	}
+}
@@ -23,5 +23,6 @@ func GenerateRandomPassword() (string, error) {
 type Keystore interface {
 	StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPath string) error
 	LoadValidatorKey(pubkey types.ValidatorPubkey) (*eth2types.BLSPrivateKey, error)
+	HasValidatorKey(pubkey types.ValidatorPubkey) (bool, error)
 	GetKeystoreDir() string
 }
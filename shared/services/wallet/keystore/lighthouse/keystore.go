@@ -181,3 +181,22 @@ func (ks *Keystore) LoadValidatorKey(pubkey types.ValidatorPubkey) (*eth2types.B
 	return privateKey, nil
 
 }
+
+// Check if the keystore has a key and secret for a validator without decrypting it
+func (ks *Keystore) HasValidatorKey(pubkey types.ValidatorPubkey) (bool, error) {
+
+	// Get the key and secret file paths
+	keyFilePath := filepath.Join(ks.keystorePath, KeystoreDir, ValidatorsDir, hexutil.AddPrefix(pubkey.Hex()), KeyFileName)
+	secretFilePath := filepath.Join(ks.keystorePath, KeystoreDir, SecretsDir, hexutil.AddPrefix(pubkey.Hex()))
+
+	for _, path := range []string{keyFilePath, secretFilePath} {
+		_, err := os.Stat(path)
+		if os.IsNotExist(err) {
+			return false, nil
+		} else if err != nil {
+			return false, fmt.Errorf("couldn't check the Lighthouse keystore for pubkey %s: %w", pubkey.Hex(), err)
+		}
+	}
+	return true, nil
+
+}
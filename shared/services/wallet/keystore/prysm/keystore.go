@@ -268,3 +268,22 @@ func (ks *Keystore) LoadValidatorKey(pubkey types.ValidatorPubkey) (*eth2types.B
 	return nil, nil
 
 }
+
+// Check if the account store has a key for a validator
+func (ks *Keystore) HasValidatorKey(pubkey types.ValidatorPubkey) (bool, error) {
+
+	// Initialize the account store
+	err := ks.initialize()
+	if err != nil {
+		return false, err
+	}
+
+	// Find the validator key in the account store
+	for ki := 0; ki < len(ks.as.PublicKeys); ki++ {
+		if bytes.Equal(pubkey.Bytes(), ks.as.PublicKeys[ki]) {
+			return true, nil
+		}
+	}
+	return false, nil
+
+}
@@ -41,6 +41,12 @@ func (w *masqueradeWallet) LoadValidatorKey(pubkey types.ValidatorPubkey) (*eth2
 
 }
 
+// Checks which of the wallet's keystores have a validator key
+func (w *masqueradeWallet) GetValidatorKeyKeystores(pubkey types.ValidatorPubkey) (map[string]bool, error) {
+	return nil, ErrIsMasquerading
+
+}
+
 // Deletes all of the keystore directories and persistent VC storage
 func (w *masqueradeWallet) DeleteValidatorStores() error {
 	return ErrIsMasquerading
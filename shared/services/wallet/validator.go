@@ -135,6 +135,21 @@ func (w *hdWallet) LoadValidatorKey(pubkey types.ValidatorPubkey) (*eth2types.BL
 
 }
 
+// Checks which of the wallet's keystores have a validator key, without decrypting it
+func (w *hdWallet) GetValidatorKeyKeystores(pubkey types.ValidatorPubkey) (map[string]bool, error) {
+
+	presence := make(map[string]bool, len(w.keystores))
+	for name := range w.keystores {
+		hasKey, err := w.keystores[name].HasValidatorKey(pubkey)
+		if err != nil {
+			return nil, fmt.Errorf("error checking the %s keystore: %w", name, err)
+		}
+		presence[name] = hasKey
+	}
+	return presence, nil
+
+}
+
 // Deletes all of the keystore directories and persistent VC storage
 func (w *hdWallet) DeleteValidatorStores() error {
 
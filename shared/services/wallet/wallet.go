@@ -52,6 +52,7 @@ type Wallet interface {
 	Initialize(derivationPath string, walletIndex uint) (string, error)
 	IsInitialized() bool
 	LoadValidatorKey(pubkey rptypes.ValidatorPubkey) (*eth2types.BLSPrivateKey, error)
+	GetValidatorKeyKeystores(pubkey rptypes.ValidatorPubkey) (map[string]bool, error)
 	Recover(derivationPath string, walletIndex uint, mnemonic string) error
 	RecoverValidatorKey(pubkey rptypes.ValidatorPubkey, startIndex uint) (uint, error)
 	Reload() error
@@ -0,0 +1,132 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/goccy/go-json"
+)
+
+type watchListFile struct {
+	Addresses []common.Address `json:"addresses"`
+}
+
+// Simple class to wrap the file holding the node addresses to watch in read-only mode
+type WatchListManager struct {
+	path      string
+	addresses []common.Address
+}
+
+// Creates a new watch list manager
+func NewWatchListManager(path string) *WatchListManager {
+	return &WatchListManager{
+		path:      path,
+		addresses: []common.Address{},
+	}
+}
+
+// Gets the watched addresses saved on disk. Returns an empty list if the watch list file doesn't exist.
+func (m *WatchListManager) LoadAddresses() ([]common.Address, error) {
+	m.addresses = []common.Address{}
+
+	_, err := os.Stat(m.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return m.addresses, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error checking if watch list file exists: %w", err)
+	}
+
+	bytes, err := os.ReadFile(m.path)
+	if err != nil {
+		return nil, fmt.Errorf("error loading watch list file [%s]: %w", m.path, err)
+	}
+
+	var wf watchListFile
+	if err := json.Unmarshal(bytes, &wf); err != nil {
+		return nil, fmt.Errorf("error decoding watch list file [%s]: %w", m.path, err)
+	}
+	m.addresses = dedupeAddresses(wf.Addresses)
+	return m.addresses, nil
+}
+
+// Get the cached addresses
+func (m *WatchListManager) GetAddresses() []common.Address {
+	return m.addresses
+}
+
+// Adds addresses to the watch list and saves it to disk; returns the ones that weren't already on it
+func (m *WatchListManager) AddAddresses(addresses []common.Address) ([]common.Address, error) {
+	if _, err := m.LoadAddresses(); err != nil {
+		return nil, err
+	}
+
+	watched := make(map[common.Address]bool, len(m.addresses))
+	for _, address := range m.addresses {
+		watched[address] = true
+	}
+	added := []common.Address{}
+	for _, address := range dedupeAddresses(addresses) {
+		if watched[address] {
+			continue
+		}
+		added = append(added, address)
+	}
+	if len(added) == 0 {
+		return added, nil
+	}
+	return added, m.save(append(m.addresses, added...))
+}
+
+// Removes addresses from the watch list and saves it to disk; returns the ones that were actually on it
+func (m *WatchListManager) RemoveAddresses(addresses []common.Address) ([]common.Address, error) {
+	if _, err := m.LoadAddresses(); err != nil {
+		return nil, err
+	}
+
+	toRemove := make(map[common.Address]bool, len(addresses))
+	for _, address := range addresses {
+		toRemove[address] = true
+	}
+	remaining := []common.Address{}
+	removed := []common.Address{}
+	for _, address := range m.addresses {
+		if toRemove[address] {
+			removed = append(removed, address)
+			continue
+		}
+		remaining = append(remaining, address)
+	}
+	if len(removed) == 0 {
+		return removed, nil
+	}
+	return removed, m.save(remaining)
+}
+
+func (m *WatchListManager) save(addresses []common.Address) error {
+	bytes, err := json.Marshal(watchListFile{Addresses: addresses})
+	if err != nil {
+		return fmt.Errorf("error encoding watch list file: %w", err)
+	}
+	if err := os.WriteFile(m.path, bytes, addressFileMode); err != nil {
+		return fmt.Errorf("error writing watch list file [%s] to disk: %w", m.path, err)
+	}
+	m.addresses = addresses
+	return nil
+}
+
+// Remove duplicates while keeping the original order
+func dedupeAddresses(addresses []common.Address) []common.Address {
+	seen := make(map[common.Address]bool, len(addresses))
+	unique := make([]common.Address, 0, len(addresses))
+	for _, address := range addresses {
+		if seen[address] {
+			continue
+		}
+		seen[address] = true
+		unique = append(unique, address)
+	}
+	return unique
+}
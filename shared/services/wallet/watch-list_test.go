@@ -0,0 +1,59 @@
+package wallet
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestWatchListManager(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "watched-nodes")
+	nodeA := common.HexToAddress("0xAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA")
+	nodeB := common.HexToAddress("0xBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBB")
+	nodeC := common.HexToAddress("0xCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCC")
+
+	// A missing file is an empty watch list
+	m := NewWatchListManager(path)
+	addresses, err := m.LoadAddresses()
+	if err != nil {
+		t.Fatalf("unexpected error loading a missing watch list: %v", err)
+	}
+	if len(addresses) != 0 {
+		t.Fatalf("expected an empty watch list, got %v", addresses)
+	}
+
+	// Duplicates, both within the request and against the list, are ignored
+	added, err := m.AddAddresses([]common.Address{nodeA, nodeB, nodeA})
+	if err != nil {
+		t.Fatalf("unexpected error adding addresses: %v", err)
+	}
+	if len(added) != 2 {
+		t.Errorf("expected 2 addresses to be added, got %v", added)
+	}
+	added, err = m.AddAddresses([]common.Address{nodeB, nodeC})
+	if err != nil {
+		t.Fatalf("unexpected error adding addresses: %v", err)
+	}
+	if len(added) != 1 || added[0] != nodeC {
+		t.Errorf("expected only %s to be added, got %v", nodeC.Hex(), added)
+	}
+
+	// Removing keeps the order of the remaining addresses and skips unknown ones
+	removed, err := m.RemoveAddresses([]common.Address{nodeB, common.HexToAddress("0x01")})
+	if err != nil {
+		t.Fatalf("unexpected error removing addresses: %v", err)
+	}
+	if len(removed) != 1 || removed[0] != nodeB {
+		t.Errorf("expected only %s to be removed, got %v", nodeB.Hex(), removed)
+	}
+
+	// The changes are persisted
+	addresses, err = NewWatchListManager(path).LoadAddresses()
+	if err != nil {
+		t.Fatalf("unexpected error reloading the watch list: %v", err)
+	}
+	if len(addresses) != 2 || addresses[0] != nodeA || addresses[1] != nodeC {
+		t.Errorf("expected [%s %s], got %v", nodeA.Hex(), nodeC.Hex(), addresses)
+	}
+}
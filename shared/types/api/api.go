@@ -1,6 +1,111 @@
 package api
 
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	hexutils "github.com/rocket-pool/smartnode/shared/hex"
+)
+
 type APIResponse struct {
 	Status string `json:"status"`
 	Error  string `json:"error"`
 }
+
+// An unsigned EIP-1559 transaction exported for signing offline
+type UnsignedTransaction struct {
+	From                 common.Address  `json:"from"`
+	To                   *common.Address `json:"to"`
+	ChainID              *big.Int        `json:"chainId"`
+	Nonce                uint64          `json:"nonce"`
+	Value                *big.Int        `json:"value"`
+	Data                 string          `json:"data"`
+	GasLimit             uint64          `json:"gasLimit"`
+	MaxFeePerGas         *big.Int        `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *big.Int        `json:"maxPriorityFeePerGas"`
+	MaxCost              *big.Int        `json:"maxCost"`
+	SerializedTx         string          `json:"serializedTx"`
+}
+
+// Decode the serialized transaction, which is what actually gets signed, and make sure the other fields describe it
+// and that it's for the given chain
+func (t UnsignedTransaction) Decode(chainID *big.Int) (*types.Transaction, error) {
+	tx, err := DecodeUnsignedTransaction(t.SerializedTx, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	mismatches := []string{}
+	if (t.To == nil) != (tx.To() == nil) || (t.To != nil && *t.To != *tx.To()) {
+		mismatches = append(mismatches, "to")
+	}
+	if t.ChainID == nil || t.ChainID.Cmp(tx.ChainId()) != 0 {
+		mismatches = append(mismatches, "chain ID")
+	}
+	if t.Nonce != tx.Nonce() {
+		mismatches = append(mismatches, "nonce")
+	}
+	if t.Value == nil || t.Value.Cmp(tx.Value()) != 0 {
+		mismatches = append(mismatches, "value")
+	}
+	data, err := hex.DecodeString(hexutils.RemovePrefix(t.Data))
+	if err != nil || !bytes.Equal(data, tx.Data()) {
+		mismatches = append(mismatches, "data")
+	}
+	if t.GasLimit != tx.Gas() {
+		mismatches = append(mismatches, "gas limit")
+	}
+	if t.MaxFeePerGas == nil || t.MaxFeePerGas.Cmp(tx.GasFeeCap()) != 0 {
+		mismatches = append(mismatches, "max fee")
+	}
+	if t.MaxPriorityFeePerGas == nil || t.MaxPriorityFeePerGas.Cmp(tx.GasTipCap()) != 0 {
+		mismatches = append(mismatches, "max priority fee")
+	}
+	if len(mismatches) > 0 {
+		return nil, fmt.Errorf("the serialized transaction doesn't match its %s", strings.Join(mismatches, ", "))
+	}
+	return tx, nil
+}
+
+// Decode a serialized unsigned EIP-1559 transaction and make sure it's for the given chain
+func DecodeUnsignedTransaction(serializedTx string, chainID *big.Int) (*types.Transaction, error) {
+	txBytes, err := hex.DecodeString(hexutils.RemovePrefix(serializedTx))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing transaction bytes: %w", err)
+	}
+	var tx types.Transaction
+	if err := tx.UnmarshalBinary(txBytes); err != nil {
+		return nil, fmt.Errorf("error decoding transaction: %w", err)
+	}
+	if tx.Type() != types.DynamicFeeTxType {
+		return nil, fmt.Errorf("only EIP-1559 transactions can be signed, but this is a type %d transaction", tx.Type())
+	}
+	if v, r, s := tx.RawSignatureValues(); v.Sign() != 0 || r.Sign() != 0 || s.Sign() != 0 {
+		return nil, fmt.Errorf("the transaction is already signed")
+	}
+	if tx.ChainId().Cmp(chainID) != 0 {
+		return nil, fmt.Errorf("the transaction is for chain %s, but the node is configured for chain %s", tx.ChainId(), chainID)
+	}
+	return &tx, nil
+}
+
+// Returned instead of a route's normal response when its transaction was exported unsigned
+type UnsignedTransactionResponse struct {
+	Status              string               `json:"status"`
+	Error               string               `json:"error"`
+	UnsignedTransaction *UnsignedTransaction `json:"unsignedTransaction"`
+}
+
+// A transaction signed offline and ready to be broadcast
+type SignedTransaction struct {
+	From         common.Address `json:"from"`
+	Nonce        uint64         `json:"nonce"`
+	TxHash       common.Hash    `json:"txHash"`
+	SerializedTx string         `json:"serializedTx"`
+}
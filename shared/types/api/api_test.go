@@ -0,0 +1,90 @@
+package api
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	hexutils "github.com/rocket-pool/smartnode/shared/hex"
+)
+
+// Build the summary of an unsigned transaction the same way the node daemon exports it
+func newTestUnsignedTransaction(t *testing.T, tx *types.Transaction) UnsignedTransaction {
+	t.Helper()
+	serializedTx, err := tx.MarshalBinary()
+	if err != nil {
+		t.Fatalf("error serializing transaction: %v", err)
+	}
+	return UnsignedTransaction{
+		From:                 common.HexToAddress("0x01"),
+		To:                   tx.To(),
+		ChainID:              tx.ChainId(),
+		Nonce:                tx.Nonce(),
+		Value:                tx.Value(),
+		Data:                 hexutils.AddPrefix(hex.EncodeToString(tx.Data())),
+		GasLimit:             tx.Gas(),
+		MaxFeePerGas:         tx.GasFeeCap(),
+		MaxPriorityFeePerGas: tx.GasTipCap(),
+		SerializedTx:         hexutils.AddPrefix(hex.EncodeToString(serializedTx)),
+	}
+}
+
+func TestUnsignedTransactionDecode(t *testing.T) {
+	to := common.HexToAddress("0xAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA")
+	chainID := big.NewInt(560048)
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     7,
+		GasTipCap: big.NewInt(1e9),
+		GasFeeCap: big.NewInt(20e9),
+		Gas:       100000,
+		To:        &to,
+		Value:     big.NewInt(1e18),
+		Data:      []byte{0x12, 0x34, 0x56, 0x78, 0x00},
+	})
+
+	// A faithful summary decodes to the serialized transaction
+	unsignedTx := newTestUnsignedTransaction(t, tx)
+	decoded, err := unsignedTx.Decode(chainID)
+	if err != nil {
+		t.Fatalf("unexpected error decoding transaction: %v", err)
+	}
+	if decoded.Hash() != tx.Hash() {
+		t.Errorf("expected to decode %s, got %s", tx.Hash().Hex(), decoded.Hash().Hex())
+	}
+
+	// Summaries that don't describe the serialized transaction are rejected
+	other := common.HexToAddress("0xBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBB")
+	tampered := unsignedTx
+	tampered.To = &other
+	tampered.Value = big.NewInt(0)
+	tampered.Data = "0xdeadbeef"
+	_, err = tampered.Decode(chainID)
+	if err == nil || !strings.Contains(err.Error(), "to, value, data") {
+		t.Errorf("expected the mismatched fields to be reported, got %v", err)
+	}
+
+	// Transactions for another chain are rejected
+	if _, err := unsignedTx.Decode(big.NewInt(1)); err == nil || !strings.Contains(err.Error(), "chain") {
+		t.Errorf("expected a chain ID mismatch, got %v", err)
+	}
+
+	// Signed transactions are rejected
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("error generating key: %v", err)
+	}
+	signedTx, err := types.SignTx(tx, types.NewLondonSigner(chainID), key)
+	if err != nil {
+		t.Fatalf("error signing transaction: %v", err)
+	}
+	signed := newTestUnsignedTransaction(t, signedTx)
+	if _, err := signed.Decode(chainID); err == nil || !strings.Contains(err.Error(), "already signed") {
+		t.Errorf("expected a signed transaction to be rejected, got %v", err)
+	}
+}
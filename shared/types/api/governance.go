@@ -0,0 +1,116 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// The governance body a calendar event belongs to
+type GovernanceDAO string
+
+const (
+	GovernanceDAO_Protocol        GovernanceDAO = "pDAO"
+	GovernanceDAO_Oracle          GovernanceDAO = "oDAO"
+	GovernanceDAO_SecurityCouncil GovernanceDAO = "Security Council"
+	GovernanceDAO_Snapshot        GovernanceDAO = "Snapshot"
+)
+
+// The next phase change of a proposal
+type GovernanceEvent struct {
+	DAO        GovernanceDAO `json:"dao"`
+	ProposalID string        `json:"proposalId"`
+	Title      string        `json:"title"`
+	State      string        `json:"state"`
+	NextPhase  string        `json:"nextPhase"`
+	Time       time.Time     `json:"time"`
+	Link       string        `json:"link,omitempty"`
+
+	// True if the proposal is open for voting and the node has already voted on it
+	Voted bool `json:"voted"`
+
+	// True if the proposal is open for voting and the node's vote would go uncast if it doesn't vote before the phase ends
+	NeedsVote bool `json:"needsVote"`
+}
+
+type GovernanceUpcomingResponse struct {
+	Status        string            `json:"status"`
+	Error         string            `json:"error"`
+	Events        []GovernanceEvent `json:"events"`
+	SnapshotError string            `json:"snapshotError"`
+}
+
+// An argument of the DAO contract method a proposal's payload calls
+type DAOProposalArgument struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// A setting change made by an Oracle DAO or security council proposal, with both values formatted in the setting's units
+type DAOProposalSettingChange struct {
+	Contract      string `json:"contract"`
+	Setting       string `json:"setting"`
+	CurrentValue  string `json:"currentValue"`
+	ProposedValue string `json:"proposedValue"`
+}
+
+// A contract or ABI upgrade made by an Oracle DAO proposal
+type DAOProposalUpgrade struct {
+	Type         string `json:"type"`
+	ContractName string `json:"contractName"`
+
+	// The contract currently registered under the name; nil if there isn't one
+	CurrentAddress  *common.Address `json:"currentAddress,omitempty"`
+	CurrentCodeHash *common.Hash    `json:"currentCodeHash,omitempty"`
+
+	ProposedAddress  common.Address `json:"proposedAddress"`
+	ProposedCodeHash common.Hash    `json:"proposedCodeHash"`
+	ProposedCodeSize int            `json:"proposedCodeSize"`
+
+	// The ABI functions and events the upgrade adds or removes
+	AddedAbiEntries   []string `json:"addedAbiEntries"`
+	RemovedAbiEntries []string `json:"removedAbiEntries"`
+}
+
+// The decoded payload of an Oracle DAO or security council proposal
+type DAOProposalDiff struct {
+	Method        string                    `json:"method"`
+	Arguments     []DAOProposalArgument     `json:"arguments"`
+	SettingChange *DAOProposalSettingChange `json:"settingChange,omitempty"`
+	Upgrade       *DAOProposalUpgrade       `json:"upgrade,omitempty"`
+}
+
+// Summarize the proposal's change on a single line, e.g. "members.quorum: 51% -> 60%"
+func (d DAOProposalDiff) Summary() string {
+	if change := d.SettingChange; change != nil {
+		if change.CurrentValue == change.ProposedValue {
+			return fmt.Sprintf("%s: %s (unchanged)", change.Setting, change.CurrentValue)
+		}
+		return fmt.Sprintf("%s: %s -> %s", change.Setting, change.CurrentValue, change.ProposedValue)
+	}
+
+	if upgrade := d.Upgrade; upgrade != nil {
+		if upgrade.ProposedAddress == (common.Address{}) {
+			return fmt.Sprintf("%s %s: +%d/-%d ABI entries", upgrade.Type, upgrade.ContractName, len(upgrade.AddedAbiEntries), len(upgrade.RemovedAbiEntries))
+		}
+		current := "none"
+		if upgrade.CurrentAddress != nil {
+			current = upgrade.CurrentAddress.Hex()
+		}
+		return fmt.Sprintf("%s %s: %s -> %s", upgrade.Type, upgrade.ContractName, current, upgrade.ProposedAddress.Hex())
+	}
+
+	// Anything else is shown as the call it makes, leaving out arguments too long to read
+	args := make([]string, len(d.Arguments))
+	for i, arg := range d.Arguments {
+		if len(arg.Value) > 42 {
+			args[i] = fmt.Sprintf("%s: <%s>", arg.Name, arg.Type)
+			continue
+		}
+		args[i] = fmt.Sprintf("%s: %s", arg.Name, arg.Value)
+	}
+	return fmt.Sprintf("%s(%s)", d.Method, strings.Join(args, ", "))
+}
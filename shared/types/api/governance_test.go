@@ -0,0 +1,45 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestDAOProposalDiffSummary(t *testing.T) {
+	current := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	tests := []struct {
+		diff DAOProposalDiff
+		want string
+	}{
+		{
+			DAOProposalDiff{SettingChange: &DAOProposalSettingChange{Setting: "members.quorum", CurrentValue: "51%", ProposedValue: "60%"}},
+			"members.quorum: 51% -> 60%",
+		},
+		{
+			DAOProposalDiff{SettingChange: &DAOProposalSettingChange{Setting: "members.quorum", CurrentValue: "51%", ProposedValue: "51%"}},
+			"members.quorum: 51% (unchanged)",
+		},
+		{
+			DAOProposalDiff{Upgrade: &DAOProposalUpgrade{Type: "upgradeContract", ContractName: "rocketNodeManager", CurrentAddress: &current, ProposedAddress: common.HexToAddress("0x2222222222222222222222222222222222222222")}},
+			"upgradeContract rocketNodeManager: 0x1111111111111111111111111111111111111111 -> 0x2222222222222222222222222222222222222222",
+		},
+		{
+			DAOProposalDiff{Upgrade: &DAOProposalUpgrade{Type: "upgradeABI", ContractName: "rocketNodeManager", AddedAbiEntries: []string{"function a()", "function b()"}, RemovedAbiEntries: []string{"function c()"}}},
+			"upgradeABI rocketNodeManager: +2/-1 ABI entries",
+		},
+		{
+			DAOProposalDiff{Method: "proposalKick", Arguments: []DAOProposalArgument{
+				{Name: "_memberAddress", Type: "address", Value: current.Hex()},
+				{Name: "_rplFine", Type: "uint256", Value: "1000"},
+				{Name: "_abi", Type: "string", Value: "eJyLjgUAARUAuQ==eJyLjgUAARUAuQ==eJyLjgUAARUAuQ=="},
+			}},
+			"proposalKick(_memberAddress: 0x1111111111111111111111111111111111111111, _rplFine: 1000, _abi: <string>)",
+		},
+	}
+	for _, test := range tests {
+		if got := test.diff.Summary(); got != test.want {
+			t.Errorf("got summary %q, want %q", got, test.want)
+		}
+	}
+}
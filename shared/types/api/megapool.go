@@ -197,6 +197,111 @@ type BeaconWithdrawalQueueEstimateResponse struct {
 	ExitQueueGwei         uint64 `json:"exitQueueGwei"`
 	ChurnPerEpochGwei     uint64 `json:"churnPerEpochGwei"`
 	SecondsPerEpoch       uint64 `json:"secondsPerEpoch"`
+	SecondsPerSlot        uint64 `json:"secondsPerSlot"`
 	EstimatedQueueEpochs  uint64 `json:"estimatedQueueEpochs"`
 	EstimatedQueueSeconds uint64 `json:"estimatedQueueSeconds"`
+	SweepValidatorCount   uint64 `json:"sweepValidatorCount"`
+}
+
+// A single deposit in the standard deposit_data.json format used by the staking deposit CLI and the launchpad
+type DepositDataEntry struct {
+	Pubkey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                uint64 `json:"amount"`
+	Signature             string `json:"signature"`
+	DepositMessageRoot    string `json:"deposit_message_root"`
+	DepositDataRoot       string `json:"deposit_data_root"`
+	ForkVersion           string `json:"fork_version"`
+	NetworkName           string `json:"network_name,omitempty"`
+	DepositCliVersion     string `json:"deposit_cli_version,omitempty"`
+}
+
+type MegapoolDepositDataResponse struct {
+	Status                string             `json:"status"`
+	Error                 string             `json:"error"`
+	MegapoolAddress       common.Address     `json:"megapoolAddress"`
+	WithdrawalCredentials common.Hash        `json:"withdrawalCredentials"`
+	StartIndex            uint               `json:"startIndex"`
+	DepositData           []DepositDataEntry `json:"depositData"`
+}
+
+type DepositDataVerification struct {
+	Pubkey string   `json:"pubkey"`
+	Errors []string `json:"errors"`
+}
+
+type VerifyMegapoolDepositDataResponse struct {
+	Status                string                    `json:"status"`
+	Error                 string                    `json:"error"`
+	MegapoolAddress       common.Address            `json:"megapoolAddress"`
+	WithdrawalCredentials common.Hash               `json:"withdrawalCredentials"`
+	ForkVersion           string                    `json:"forkVersion"`
+	NetworkName           string                    `json:"networkName"`
+	Deposits              []DepositDataVerification `json:"deposits"`
+}
+
+// A withdrawal proven by a MegapoolWithdrawalProofResponse
+type ProvenWithdrawal struct {
+	Index                 uint64         `json:"index"`
+	ValidatorIndex        uint64         `json:"validatorIndex"`
+	WithdrawalCredentials common.Address `json:"withdrawalCredentials"`
+	AmountInGwei          uint64         `json:"amountInGwei"`
+}
+
+// A withdrawal proof served by the node's megapool proof server. Apart from the status and error
+// fields, this has the same shape as the withdrawal proofs served by the Rocket Pool API.
+type MegapoolWithdrawalProofResponse struct {
+	Status         string           `json:"status"`
+	Error          string           `json:"error"`
+	Slot           uint64           `json:"slot"`
+	WithdrawalSlot uint64           `json:"withdrawalSlot"`
+	WithdrawalNum  uint16           `json:"withdrawalNum"`
+	Withdrawal     ProvenWithdrawal `json:"withdrawal"`
+	Witnesses      []common.Hash    `json:"witnesses"`
+}
+
+// A validator record proven by a MegapoolValidatorProofResponse
+type ProvenValidator struct {
+	Pubkey                     types.ValidatorPubkey `json:"pubkey"`
+	WithdrawalCredentials      common.Hash           `json:"withdrawalCredentials"`
+	EffectiveBalance           uint64                `json:"effectiveBalance"`
+	Slashed                    bool                  `json:"slashed"`
+	ActivationEligibilityEpoch uint64                `json:"activationEligibilityEpoch"`
+	ActivationEpoch            uint64                `json:"activationEpoch"`
+	ExitEpoch                  uint64                `json:"exitEpoch"`
+	WithdrawableEpoch          uint64                `json:"withdrawableEpoch"`
+}
+
+// A validator proof and the matching slot proof served by the node's megapool proof server
+type MegapoolValidatorProofResponse struct {
+	Status         string          `json:"status"`
+	Error          string          `json:"error"`
+	Slot           uint64          `json:"slot"`
+	SlotTimestamp  uint64          `json:"slotTimestamp"`
+	ValidatorIndex uint64          `json:"validatorIndex"`
+	Validator      ProvenValidator `json:"validator"`
+	Witnesses      []common.Hash   `json:"witnesses"`
+	SlotWitnesses  []common.Hash   `json:"slotWitnesses"`
+}
+
+// A step in a megapool validator's lifecycle. Steps taken from the Beacon Chain don't have a block or transaction.
+type MegapoolValidatorHistoryEvent struct {
+	Step        string      `json:"step"`
+	Description string      `json:"description"`
+	Time        time.Time   `json:"time"`
+	Slot        uint64      `json:"slot"`
+	BlockNumber uint64      `json:"blockNumber"`
+	TxHash      common.Hash `json:"txHash"`
+	Pending     bool        `json:"pending"`
+}
+
+type MegapoolValidatorHistoryResponse struct {
+	Status          string                          `json:"status"`
+	Error           string                          `json:"error"`
+	ValidatorId     uint32                          `json:"validatorId"`
+	MegapoolAddress common.Address                  `json:"megapoolAddress"`
+	Pubkey          types.ValidatorPubkey           `json:"pubkey"`
+	ValidatorIndex  string                          `json:"validatorIndex"`
+	BeaconStatus    beacon.ValidatorState           `json:"beaconStatus"`
+	Events          []MegapoolValidatorHistoryEvent `json:"events"`
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/rocket-pool/smartnode/bindings/transactions/gaslimit"
 	"github.com/rocket-pool/smartnode/bindings/types"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/migration"
 )
 
 type MinipoolStatusResponse struct {
@@ -100,6 +101,16 @@ type ExitMinipoolResponse struct {
 	Error  string `json:"error"`
 }
 
+type MinipoolMigrationPlanResponse struct {
+	Status string          `json:"status"`
+	Error  string          `json:"error"`
+	Plan   *migration.Plan `json:"plan"`
+}
+type CancelMinipoolMigrationResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+}
+
 type CanChangeWithdrawalCredentialsResponse struct {
 	Status    string `json:"status"`
 	Error     string `json:"error"`
@@ -13,6 +13,7 @@ import (
 	"github.com/rocket-pool/smartnode/rocketpool-cli/cli/color"
 	"github.com/rocket-pool/smartnode/rocketpool/feerecipient"
 	"github.com/rocket-pool/smartnode/shared/services/rewards"
+	"github.com/rocket-pool/smartnode/shared/services/state"
 )
 
 type NodeStatusResponse struct {
@@ -563,6 +564,12 @@ type DepositContractInfoResponse struct {
 	SufficientSync        bool           `json:"sufficientSync"`
 }
 
+type BroadcastTransactionResponse struct {
+	Status string      `json:"status"`
+	Error  string      `json:"error"`
+	TxHash common.Hash `json:"txHash"`
+}
+
 type NodeSignResponse struct {
 	Status     string `json:"status"`
 	Error      string `json:"error"`
@@ -663,6 +670,7 @@ type ResolveEnsNameResponse struct {
 type SnapshotProposal struct {
 	Id            string    `json:"id"`
 	Title         string    `json:"title"`
+	Type          string    `json:"type"`
 	Start         int64     `json:"start"`
 	End           int64     `json:"end"`
 	State         string    `json:"state"`
@@ -895,3 +903,104 @@ type ClaimUnclaimedRewardsResponse struct {
 	Error  string      `json:"error"`
 	TxHash common.Hash `json:"txHash"`
 }
+
+type WatchedNodeStatus struct {
+	NodeAddress             common.Address            `json:"nodeAddress"`
+	Registered              bool                      `json:"registered"`
+	TimezoneLocation        string                    `json:"timezoneLocation"`
+	WithdrawalAddress       common.Address            `json:"withdrawalAddress"`
+	EthBalance              *big.Int                  `json:"ethBalance"`
+	RplBalance              *big.Int                  `json:"rplBalance"`
+	RplStake                *big.Int                  `json:"rplStake"`
+	EthBonded               *big.Int                  `json:"ethBonded"`
+	EthBorrowed             *big.Int                  `json:"ethBorrowed"`
+	MinipoolCount           uint64                    `json:"minipoolCount"`
+	SmoothingPoolRegistered bool                      `json:"smoothingPoolRegistered"`
+	FeeDistributorShare     *big.Int                  `json:"feeDistributorShare"`
+	MegapoolDeployed        bool                      `json:"megapoolDeployed"`
+	MegapoolAddress         common.Address            `json:"megapoolAddress"`
+	MegapoolValidatorCount  uint32                    `json:"megapoolValidatorCount"`
+	MegapoolPendingRewards  *big.Int                  `json:"megapoolPendingRewards"`
+	MegapoolRefundValue     *big.Int                  `json:"megapoolRefundValue"`
+	MegapoolDebt            *big.Int                  `json:"megapoolDebt"`
+	UnclaimedRplRewards     *big.Int                  `json:"unclaimedRplRewards"`
+	UnclaimedEthRewards     *big.Int                  `json:"unclaimedEthRewards"`
+	MissingRewardsIntervals []uint64                  `json:"missingRewardsIntervals"`
+	Validators              state.NodeValidatorCounts `json:"validators"`
+}
+
+type WatchedNodesStatusResponse struct {
+	Status        string              `json:"status"`
+	Error         string              `json:"error"`
+	ElBlockNumber uint64              `json:"elBlockNumber"`
+	Nodes         []WatchedNodeStatus `json:"nodes"`
+}
+
+type WatchNodesResponse struct {
+	Status       string           `json:"status"`
+	Error        string           `json:"error"`
+	AddedNodes   []common.Address `json:"addedNodes"`
+	WatchedNodes []common.Address `json:"watchedNodes"`
+}
+
+type UnwatchNodesResponse struct {
+	Status       string           `json:"status"`
+	Error        string           `json:"error"`
+	RemovedNodes []common.Address `json:"removedNodes"`
+	WatchedNodes []common.Address `json:"watchedNodes"`
+}
+
+// A validator included in a node exit plan
+type NodeExitPlanValidator struct {
+	IsMegapool      bool                    `json:"isMegapool"`
+	ValidatorId     uint32                  `json:"validatorId"`
+	MinipoolAddress common.Address          `json:"minipoolAddress"`
+	Pubkey          rptypes.ValidatorPubkey `json:"pubkey"`
+	BeaconBalance   *big.Int                `json:"beaconBalance"`
+	NodeShare       *big.Int                `json:"nodeShare"`
+}
+
+// The simulated outcome of exiting a set of the node's validators
+type NodeExitPlan struct {
+	Validators []NodeExitPlanValidator `json:"validators"`
+
+	// Megapool
+	MegapoolNodeBond        *big.Int `json:"megapoolNodeBond"`
+	MegapoolBondAfterExit   *big.Int `json:"megapoolBondAfterExit"`
+	MegapoolCapitalReleased *big.Int `json:"megapoolCapitalReleased"`
+	MegapoolUserCapital     *big.Int `json:"megapoolUserCapital"`
+	MegapoolShortfall       *big.Int `json:"megapoolShortfall"`
+	MegapoolRewards         *big.Int `json:"megapoolRewards"`
+	MegapoolNodeRewards     *big.Int `json:"megapoolNodeRewards"`
+	MegapoolDebt            *big.Int `json:"megapoolDebt"`
+	MegapoolDebtAfterExit   *big.Int `json:"megapoolDebtAfterExit"`
+	MegapoolRefundValue     *big.Int `json:"megapoolRefundValue"`
+	MegapoolEthReturned     *big.Int `json:"megapoolEthReturned"`
+
+	// Minipools
+	MinipoolEthReturned *big.Int `json:"minipoolEthReturned"`
+
+	TotalEthReturned *big.Int `json:"totalEthReturned"`
+
+	// Timing
+	ExitQueueSeconds         uint64 `json:"exitQueueSeconds"`
+	WithdrawableDelaySeconds uint64 `json:"withdrawableDelaySeconds"`
+	SweepSeconds             uint64 `json:"sweepSeconds"`
+	ExpectedSeconds          uint64 `json:"expectedSeconds"`
+	LatestSeconds            uint64 `json:"latestSeconds"`
+
+	// RPL rewards eligibility
+	RplStake                      *big.Int `json:"rplStake"`
+	EligibleBorrowedEthBefore     *big.Int `json:"eligibleBorrowedEthBefore"`
+	EligibleBorrowedEthAfter      *big.Int `json:"eligibleBorrowedEthAfter"`
+	RplPercentOfBorrowedEthBefore *big.Int `json:"rplPercentOfBorrowedEthBefore"`
+	RplPercentOfBorrowedEthAfter  *big.Int `json:"rplPercentOfBorrowedEthAfter"`
+	NodeWeightBefore              *big.Int `json:"nodeWeightBefore"`
+	NodeWeightAfter               *big.Int `json:"nodeWeightAfter"`
+}
+
+type NodeExitPlanResponse struct {
+	Status string       `json:"status"`
+	Error  string       `json:"error"`
+	Plan   NodeExitPlan `json:"plan"`
+}
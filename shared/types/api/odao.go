@@ -48,6 +48,75 @@ type TNDAOProposalResponse struct {
 	Proposal dao.ProposalDetails `json:"proposal"`
 }
 
+type TNDAOProposalDiffResponse struct {
+	Status     string          `json:"status"`
+	Error      string          `json:"error"`
+	ProposalID uint64          `json:"proposalId"`
+	Diff       DAOProposalDiff `json:"diff"`
+}
+
+// The duties an oDAO member's watchtower performs
+type TNDAODuty string
+
+const (
+	TNDAODuty_Balances TNDAODuty = "balances"
+	TNDAODuty_Prices   TNDAODuty = "prices"
+	TNDAODuty_Rewards  TNDAODuty = "rewards"
+	TNDAODuty_Scrub    TNDAODuty = "scrub"
+)
+
+// How a member performed a duty for a single round
+type TNDAODutyStatus string
+
+const (
+	// Submitted before the other members reached consensus
+	TNDAODutyStatus_OnTime TNDAODutyStatus = "on-time"
+	// Submitted after the other members had already reached consensus
+	TNDAODutyStatus_Late TNDAODutyStatus = "late"
+	// Consensus was reached without a submission from the member
+	TNDAODutyStatus_Missed TNDAODutyStatus = "missed"
+	// Submitted for a round that hasn't reached consensus, or never will
+	TNDAODutyStatus_Unfinalized TNDAODutyStatus = "unfinalized"
+)
+
+// A member's participation in one round of a duty; rounds are keyed by the reported block for balances and prices,
+// the rewards interval for rewards, and the minipool for scrubs
+type TNDAODutyRound struct {
+	Duty             TNDAODuty       `json:"duty"`
+	Round            string          `json:"round"`
+	Status           TNDAODutyStatus `json:"status"`
+	SubmissionBlock  uint64          `json:"submissionBlock"`
+	ConsensusBlock   uint64          `json:"consensusBlock"`
+	MatchedConsensus bool            `json:"matchedConsensus"`
+}
+
+// A member's participation in a duty across all of the rounds in the lookback window
+type TNDAODutySummary struct {
+	Duty        TNDAODuty `json:"duty"`
+	Rounds      int       `json:"rounds"`
+	OnTime      int       `json:"onTime"`
+	Late        int       `json:"late"`
+	Missed      int       `json:"missed"`
+	Unfinalized int       `json:"unfinalized"`
+	Mismatched  int       `json:"mismatched"`
+}
+
+type TNDAODuties struct {
+	Member       common.Address     `json:"member"`
+	LookbackDays uint64             `json:"lookbackDays"`
+	FromBlock    uint64             `json:"fromBlock"`
+	ToBlock      uint64             `json:"toBlock"`
+	Summaries    []TNDAODutySummary `json:"summaries"`
+	Rounds       []TNDAODutyRound   `json:"rounds"`
+}
+
+type TNDAODutiesResponse struct {
+	Status   string      `json:"status"`
+	Error    string      `json:"error"`
+	IsMember bool        `json:"isMember"`
+	Duties   TNDAODuties `json:"duties"`
+}
+
 type CanProposeTNDAOInviteResponse struct {
 	Status                 string          `json:"status"`
 	Error                  string          `json:"error"`
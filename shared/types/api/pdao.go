@@ -231,6 +231,23 @@ type ProposePDAOSettingMultiResponse struct {
 	TxHash     common.Hash `json:"txHash"`
 }
 
+type PDAOSimulatedSetting struct {
+	Contract     string `json:"contract"`
+	Setting      string `json:"setting"`
+	CurrentValue string `json:"currentValue"`
+	NewValue     string `json:"newValue"`
+}
+
+type SimulatePDAOSettingMultiResponse struct {
+	Status              string                 `json:"status"`
+	Error               string                 `json:"error"`
+	BlockNumber         uint64                 `json:"blockNumber"`
+	Reverted            bool                   `json:"reverted"`
+	RevertReason        string                 `json:"revertReason"`
+	Settings            []PDAOSimulatedSetting `json:"settings"`
+	InvariantViolations []string               `json:"invariantViolations"`
+}
+
 type PDAOGetRewardsPercentagesResponse struct {
 	Status      string   `json:"status"`
 	Error       string   `json:"error"`
@@ -521,6 +538,26 @@ type PDAOClearSignallingAddressResponse struct {
 	TxHash common.Hash `json:"txHash"`
 }
 
+type PDAOCanSnapshotVoteResponse struct {
+	Status            string                 `json:"status"`
+	Error             string                 `json:"error"`
+	CanVote           bool                   `json:"canVote"`
+	ProposalNotActive bool                   `json:"proposalNotActive"`
+	UnsupportedType   bool                   `json:"unsupportedType"`
+	Space             string                 `json:"space"`
+	Proposal          SnapshotProposal       `json:"proposal"`
+	NodeAddress       common.Address         `json:"nodeAddress"`
+	SignallingAddress common.Address         `json:"signallingAddress"`
+	ExistingVotes     []SnapshotProposalVote `json:"existingVotes"`
+}
+
+type PDAOSnapshotVoteResponse struct {
+	Status string         `json:"status"`
+	Error  string         `json:"error"`
+	Voter  common.Address `json:"voter"`
+	VoteID string         `json:"voteId"`
+}
+
 type PDAOACanProposeAllowListedControllersResponse struct {
 	Status                 string          `json:"status"`
 	Error                  string          `json:"error"`
@@ -535,3 +572,111 @@ type PDAOProposeAllowListedControllersResponse struct {
 	ProposalId uint64      `json:"proposalId"`
 	TxHash     common.Hash `json:"txHash"`
 }
+
+// A voting tree in the export format of `rocketpool pdao tree export`.
+// Nodes holds the whole tree as a flat array in breadth-first order: the root is nodes[0] and the children of nodes[i] are nodes[2i+1] and nodes[2i+2].
+// Each node's hash is keccak256(leftHash, leftSum, rightHash, rightSum) of its children with the sums as uint256s, and each leaf's hash is keccak256 of its sum.
+// VirtualRootIndex is the 1-based index of the root within the full pDAO voting tree, which is how the verifier contract addresses tree nodes.
+type PDAOVotingTree struct {
+	Depth            uint64                 `json:"depth"`
+	VirtualRootIndex uint64                 `json:"virtualRootIndex"`
+	Nodes            []types.VotingTreeNode `json:"nodes"`
+}
+
+// The voting tree artifacts for a block, in the export format of `rocketpool pdao tree export`
+type PDAOVotingTreeExport struct {
+	SmartnodeVersion string `json:"smartnodeVersion"`
+	Network          string `json:"network"`
+	BlockNumber      uint32 `json:"blockNumber"`
+	DepthPerRound    uint64 `json:"depthPerRound"`
+
+	// The network tree, whose leaves are the voting power delegated to each node in the order of the node set
+	NetworkTree PDAOVotingTree `json:"networkTree"`
+
+	// The pollard a proposal targeting this block submits: the network tree nodes depthPerRound levels below the root
+	Pollard []types.VotingTreeNode `json:"pollard"`
+
+	// The subtree of one node, whose leaves are the voting power each node in the node set delegated to it; only present if a node was requested
+	NodeAddress *common.Address `json:"nodeAddress,omitempty"`
+	NodeIndex   *uint64         `json:"nodeIndex,omitempty"`
+	NodeTree    *PDAOVotingTree `json:"nodeTree,omitempty"`
+}
+
+type PDAOExportVotingTreeResponse struct {
+	Status string               `json:"status"`
+	Error  string               `json:"error"`
+	Export PDAOVotingTreeExport `json:"export"`
+}
+
+// The result of checking a root submitted for a proposal against the locally recomputed voting tree
+type PDAOSubmittedRootCheck struct {
+	Index     uint64               `json:"index"`
+	Submitter common.Address       `json:"submitter"`
+	Time      time.Time            `json:"time"`
+	Root      types.VotingTreeNode `json:"root"`
+	Matches   bool                 `json:"matches"`
+
+	// The first tree index whose submitted node differs from the local tree, if the root doesn't match
+	MismatchIndex uint64 `json:"mismatchIndex,omitempty"`
+}
+
+type PDAOVerifyVotingTreeResponse struct {
+	Status      string                   `json:"status"`
+	Error       string                   `json:"error"`
+	ProposalID  uint64                   `json:"proposalId"`
+	BlockNumber uint32                   `json:"blockNumber"`
+	LocalRoot   types.VotingTreeNode     `json:"localRoot"`
+	Roots       []PDAOSubmittedRootCheck `json:"roots"`
+
+	// The root of the network tree cached on disk, if there is one, and whether it matches the recomputed tree
+	CachedRoot        *types.VotingTreeNode `json:"cachedRoot,omitempty"`
+	CachedTreeMatches bool                  `json:"cachedTreeMatches"`
+}
+
+// Voting power a node delegated to another node
+type PDAODelegation struct {
+	Delegator   common.Address `json:"delegator"`
+	VotingPower *big.Int       `json:"votingPower"`
+}
+
+// The voting power a node holds at a proposal's target block once delegations are applied
+type PDAONodeVotingPower struct {
+	Address  common.Address `json:"address"`
+	Delegate common.Address `json:"delegate"`
+
+	// The node's own voting power, before it's delegated
+	OwnVotingPower *big.Int `json:"ownVotingPower"`
+
+	// The voting power the node votes with: its own power if it didn't delegate it, plus the power delegated to it
+	VotingPower *big.Int         `json:"votingPower"`
+	Delegators  []PDAODelegation `json:"delegators"`
+}
+
+// How much of the voting power at an open proposal's target block hasn't been used to vote yet
+type PDAOProposalVotingPower struct {
+	ID               uint64                         `json:"id"`
+	Message          string                         `json:"message"`
+	State            types.ProtocolDaoProposalState `json:"state"`
+	BlockNumber      uint32                         `json:"blockNumber"`
+	TotalVotingPower *big.Int                       `json:"totalVotingPower"`
+	VotedPower       *big.Int                       `json:"votedPower"`
+	UnvotedPower     *big.Int                       `json:"unvotedPower"`
+	NodeVoted        bool                           `json:"nodeVoted"`
+}
+
+type PDAOVotingPowerResponse struct {
+	Status           string                `json:"status"`
+	Error            string                `json:"error"`
+	ProposalID       uint64                `json:"proposalId"`
+	BlockNumber      uint32                `json:"blockNumber"`
+	TotalVotingPower *big.Int              `json:"totalVotingPower"`
+	Nodes            []PDAONodeVotingPower `json:"nodes"`
+
+	// This node's voting power and delegations at the proposal's target block
+	Node PDAONodeVotingPower `json:"node"`
+
+	// This node's own voting power at the head of the chain, which proposals made from now on would use
+	NodeCurrentVotingPower *big.Int `json:"nodeCurrentVotingPower"`
+
+	OpenProposals []PDAOProposalVotingPower `json:"openProposals"`
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/smartnode/bindings/transactions/gaslimit"
@@ -53,3 +54,37 @@ type AssignDepositsResponse struct {
 	Error  string      `json:"error"`
 	TxHash common.Hash `json:"txHash"`
 }
+
+// The forecast assignment time of a validator in the megapool queues
+type QueueForecastEntry struct {
+	Position        uint64         `json:"position"`
+	MegapoolAddress common.Address `json:"megapoolAddress"`
+	ValidatorId     uint32         `json:"validatorId"`
+	ExpressQueue    bool           `json:"expressQueue"`
+	EthRequired     float64        `json:"ethRequired"`
+	Funded          bool           `json:"funded"`
+	ExpectedTime    time.Time      `json:"expectedTime"`
+	EarliestTime    time.Time      `json:"earliestTime"`
+	LatestTime      time.Time      `json:"latestTime"`
+	Unbounded       bool           `json:"unbounded"`
+}
+
+type QueueForecast struct {
+	LookbackDays           uint64               `json:"lookbackDays"`
+	ExpressQueueLength     uint64               `json:"expressQueueLength"`
+	StandardQueueLength    uint64               `json:"standardQueueLength"`
+	ExpressQueueRate       uint64               `json:"expressQueueRate"`
+	UserBalance            *big.Int             `json:"userBalance"`
+	DailyInflowMean        float64              `json:"dailyInflowMean"`
+	DailyInflowStdDev      float64              `json:"dailyInflowStdDev"`
+	DailyAssignmentsMean   float64              `json:"dailyAssignmentsMean"`
+	DailyAssignmentsStdDev float64              `json:"dailyAssignmentsStdDev"`
+	Entries                []QueueForecastEntry `json:"entries"`
+}
+
+type QueueForecastResponse struct {
+	Status          string         `json:"status"`
+	Error           string         `json:"error"`
+	MegapoolAddress common.Address `json:"megapoolAddress"`
+	Forecast        QueueForecast  `json:"forecast"`
+}
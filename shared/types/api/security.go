@@ -45,6 +45,13 @@ type SecurityProposalResponse struct {
 	Proposal dao.ProposalDetails `json:"proposal"`
 }
 
+type SecurityProposalDiffResponse struct {
+	Status     string          `json:"status"`
+	Error      string          `json:"error"`
+	ProposalID uint64          `json:"proposalId"`
+	Diff       DAOProposalDiff `json:"diff"`
+}
+
 type SecurityCanProposeInviteResponse struct {
 	Status              string          `json:"status"`
 	Error               string          `json:"error"`
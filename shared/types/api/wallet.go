@@ -94,6 +94,74 @@ type ExportWalletResponse struct {
 	AccountPrivateKey string `json:"accountPrivateKey"`
 }
 
+// A node validator key exported as an EIP-2335 keystore, along with the password that decrypts it.
+// MinipoolAddress is set for minipool validators, MegapoolValidatorId for megapool validators.
+type ExportedValidatorKey struct {
+	Keystore            ValidatorKeystore `json:"keystore"`
+	Password            string            `json:"password"`
+	MinipoolAddress     *common.Address   `json:"minipoolAddress,omitempty"`
+	MegapoolValidatorId *uint32           `json:"megapoolValidatorId,omitempty"`
+}
+
+type ExportValidatorKeysResponse struct {
+	Status string                 `json:"status"`
+	Error  string                 `json:"error"`
+	Keys   []ExportedValidatorKey `json:"keys"`
+	// Validators that matched the filter but don't have a key in any of the node's keystores
+	MissingKeys []types.ValidatorPubkey `json:"missingKeys"`
+}
+
+// An EIP-2335 keystore to import, along with the password that decrypts it
+type ImportedValidatorKey struct {
+	Keystore ValidatorKeystore `json:"keystore"`
+	Password string            `json:"password"`
+}
+
+type ImportValidatorKeysResponse struct {
+	Status       string                  `json:"status"`
+	Error        string                  `json:"error"`
+	ImportedKeys []types.ValidatorPubkey `json:"importedKeys"`
+	// Keystores that were valid but didn't match the filter, so they were left alone
+	SkippedKeys []types.ValidatorPubkey `json:"skippedKeys"`
+}
+
+// A validator key found during an audit of the node's keys.
+// WalletIndex is the derivation index of the key if it was found within the audited range.
+type AuditedValidatorKey struct {
+	Pubkey              types.ValidatorPubkey `json:"pubkey"`
+	WalletIndex         *uint                 `json:"walletIndex,omitempty"`
+	MinipoolAddress     *common.Address       `json:"minipoolAddress,omitempty"`
+	MegapoolValidatorId *uint32               `json:"megapoolValidatorId,omitempty"`
+	Keystores           []string              `json:"keystores"`
+	MissingKeystores    []string              `json:"missingKeystores"`
+}
+
+type AuditValidatorKeysResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	// The wallet's next key index and the number of derivation indices that were checked
+	NextAccount     uint     `json:"nextAccount"`
+	DerivedKeyCount uint     `json:"derivedKeyCount"`
+	Keystores       []string `json:"keystores"`
+	ValidatorCount  int      `json:"validatorCount"`
+	// Validators on chain that don't have a key in any of the node's keystores
+	MissingKeys []AuditedValidatorKey `json:"missingKeys"`
+	// Derived keys in the node's keystores that don't belong to any of its validators
+	OrphanedKeys []AuditedValidatorKey `json:"orphanedKeys"`
+	// Keys that are only in some of the node's keystores
+	PartialKeys []AuditedValidatorKey `json:"partialKeys"`
+	// Indices below the next key index that have neither a validator nor a stored key
+	UnusedIndices []uint `json:"unusedIndices"`
+	// The highest derivation index used by one of the node's validators, if any were found
+	HighestValidatorIndex *uint `json:"highestValidatorIndex,omitempty"`
+}
+
+type SignTransactionResponse struct {
+	Status            string            `json:"status"`
+	Error             string            `json:"error"`
+	SignedTransaction SignedTransaction `json:"signedTransaction"`
+}
+
 type SetEnsNameResponse struct {
 	Status    string          `json:"status"`
 	Error     string          `json:"error"`
@@ -9,25 +9,28 @@ import (
 // Important indices for proof generation:
 const BeaconBlockBodyChunksCeil uint64 = 16
 
+// GetGeneralizedIndexForWithdrawal returns the gindex of
+// body.execution_payload.withdrawals[index] inside a BeaconBlock.
+func GetGeneralizedIndexForWithdrawal(indexInWithdrawalsArray uint64) uint64 {
+	return generic.ConcatGindices(
+		// Navigate to the body
+		generic.BeaconBlockChunksCeil+generic.BeaconBlockBodyIndex,
+		// Then to the ExecutionPayload
+		BeaconBlockBodyChunksCeil+generic.BeaconBlockBodyExecutionPayloadIndex,
+		// Then to the withdrawals array
+		generic.BeaconBlockBodyExecutionPayloadChunksCeil+generic.BeaconBlockBodyExecutionPayloadWithdrawalsIndex,
+		// Finally to the withdrawal in question
+		generic.GetGeneralizedIndexForListElement(1, generic.BeaconBlockWithdrawalsArrayMax, indexInWithdrawalsArray),
+	)
+}
+
 func (b *SignedBeaconBlock) ProveWithdrawal(indexInWithdrawalsArray uint64) ([][]byte, error) {
 	tree, err := generic.SSZ.GetTree(b.Block)
 	if err != nil {
 		return nil, err
 	}
 
-	gid := uint64(1)
-	// Navigate to the body
-	gid = gid*generic.BeaconBlockChunksCeil + generic.BeaconBlockBodyIndex
-	// Then to the ExecutionPayload
-	gid = gid*BeaconBlockBodyChunksCeil + generic.BeaconBlockBodyExecutionPayloadIndex
-	// Then to the withdrawals array
-	gid = gid*generic.BeaconBlockBodyExecutionPayloadChunksCeil + generic.BeaconBlockBodyExecutionPayloadWithdrawalsIndex
-	// Then to the array contents
-	gid = gid * 2
-	// Finally to the withdrawal in question
-	gid = gid*generic.BeaconBlockWithdrawalsArrayMax + indexInWithdrawalsArray
-
-	proof, err := tree.Prove(int(gid))
+	proof, err := tree.Prove(int(GetGeneralizedIndexForWithdrawal(indexInWithdrawalsArray)))
 	if err != nil {
 		return nil, err
 	}
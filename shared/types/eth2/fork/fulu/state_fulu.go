@@ -83,6 +83,16 @@ func GetGeneralizedIndexForSlot() uint64 {
 	return generic.ContainerFieldGindex(getStateChunkSize(), generic.BeaconStateSlotIndex)
 }
 
+func GetGeneralizedIndexForBlockRoots() uint64 {
+	// Classic SSZ Container field gindex (pre-Gloas); block_roots is field index 5.
+	return generic.ContainerFieldGindex(getStateChunkSize(), generic.BeaconStateBlockRootsFieldIndex)
+}
+
+func GetGeneralizedIndexForHistoricalSummaries() uint64 {
+	// Classic SSZ Container field gindex (pre-Gloas); historical_summaries is field index 27.
+	return generic.ContainerFieldGindex(getStateChunkSize(), generic.BeaconStateHistoricalSummariesFieldIndex)
+}
+
 // ValidatorAndSlotProof produces both the validator proof and the slot proof
 // for the state's current slot
 func (state *BeaconState) ValidatorAndSlotProof(validatorIndex uint64) ([][]byte, [][]byte, error) {
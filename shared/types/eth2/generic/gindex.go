@@ -1,6 +1,12 @@
 package generic
 
 import (
+	// Don't get tripped up by the sha256 import
+	// See https://github.com/ethereum/consensus-specs/pull/779
+	"crypto/sha256"
+	"fmt"
+	"math/bits"
+
 	"github.com/rocket-pool/smartnode/shared/math"
 )
 
@@ -60,3 +66,37 @@ func GetGeneralizedIndexForListElement(listRootGindex uint64, capacity uint64, e
 func GetGeneralizedIndexForVectorElement(vectorRootGindex uint64, length uint64, elementIndex uint64) uint64 {
 	return vectorRootGindex*length + elementIndex
 }
+
+// GetRootFromMerkleBranch folds a Merkle branch back up to the root it proves
+// against. branch holds the sibling hashes ordered from the leaf upwards, and
+// gindex is the leaf's generalized index relative to that root (use
+// ConcatGindices to join the steps of a proof that spans several trees). The
+// branch must be exactly as deep as the gindex.
+//
+// Works for both classic and progressive trees, since both are plain binary
+// Merkle trees once the gindex is known.
+func GetRootFromMerkleBranch(leaf [32]byte, branch [][32]byte, gindex uint64) ([32]byte, error) {
+	if gindex < 1 {
+		return [32]byte{}, fmt.Errorf("invalid generalized index %d", gindex)
+	}
+	depth := bits.Len64(gindex) - 1
+	if len(branch) != depth {
+		return [32]byte{}, fmt.Errorf("branch has %d hashes but generalized index %d has a depth of %d", len(branch), gindex, depth)
+	}
+
+	node := leaf
+	var pair [64]byte
+	for _, sibling := range branch {
+		// An odd gindex is a right child, so its sibling is on the left
+		if gindex%2 == 1 {
+			copy(pair[:32], sibling[:])
+			copy(pair[32:], node[:])
+		} else {
+			copy(pair[:32], node[:])
+			copy(pair[32:], sibling[:])
+		}
+		node = sha256.Sum256(pair[:])
+		gindex /= 2
+	}
+	return node, nil
+}
@@ -42,3 +42,58 @@ func TestGetGeneralizedIndexForVectorAndList(t *testing.T) {
 		t.Fatalf("list: got %d want 35", got)
 	}
 }
+
+func TestGetRootFromMerkleBranch(t *testing.T) {
+	header := &BeaconBlockHeader{
+		Slot:          100,
+		ProposerIndex: 7,
+		ParentRoot:    make([]byte, 32),
+		StateRoot:     make([]byte, 32),
+		BodyRoot:      make([]byte, 32),
+	}
+	header.StateRoot[0] = 0x01
+	header.BodyRoot[31] = 0x02
+
+	tree, err := SSZ.GetTree(header)
+	if err != nil {
+		t.Fatalf("GetTree: %v", err)
+	}
+	proof, err := tree.Prove(int(BeaconBlockHeaderStateRootGeneralizedIndex))
+	if err != nil {
+		t.Fatalf("Prove: %v", err)
+	}
+	branch := make([][32]byte, len(proof.Hashes))
+	for i, h := range proof.Hashes {
+		copy(branch[i][:], h)
+	}
+	var leaf, root [32]byte
+	copy(leaf[:], header.StateRoot)
+	copy(root[:], tree.Hash())
+
+	got, err := GetRootFromMerkleBranch(leaf, branch, BeaconBlockHeaderStateRootGeneralizedIndex)
+	if err != nil {
+		t.Fatalf("state root branch: %v", err)
+	}
+	if got != root {
+		t.Fatalf("state root branch: got %x want %x", got, root)
+	}
+
+	// The same branch proves nothing about a different field
+	if got, err := GetRootFromMerkleBranch(leaf, branch, ContainerFieldGindex(5, 4)); err != nil || got == root {
+		t.Fatalf("body root position: expected a different root, got %x (err=%v)", got, err)
+	}
+
+	// A tampered sibling changes the root
+	branch[1][0] ^= 0xff
+	if got, _ := GetRootFromMerkleBranch(leaf, branch, BeaconBlockHeaderStateRootGeneralizedIndex); got == root {
+		t.Fatalf("tampered branch: expected a different root")
+	}
+
+	// Branches that don't match the depth of the gindex are rejected
+	if _, err := GetRootFromMerkleBranch(leaf, branch[:2], BeaconBlockHeaderStateRootGeneralizedIndex); err == nil {
+		t.Fatalf("short branch: expected an error")
+	}
+	if _, err := GetRootFromMerkleBranch(leaf, nil, 0); err == nil {
+		t.Fatalf("gindex 0: expected an error")
+	}
+}